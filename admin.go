@@ -0,0 +1,804 @@
+// +build !without_admin
+
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+/*
+#cgo !libvirt_dlopen pkg-config: libvirt
+#cgo !libvirt_dlopen LDFLAGS: -lvirt-admin
+#include <stdlib.h>
+#include "admin_wrapper.h"
+#include "typedparams_wrapper.h"
+*/
+import "C"
+
+import (
+	"reflect"
+	"sync"
+	"unsafe"
+)
+
+type ClientTransport int
+
+const (
+	CLIENT_TRANS_UNIX = ClientTransport(C.VIR_CLIENT_TRANS_UNIX)
+	CLIENT_TRANS_TCP  = ClientTransport(C.VIR_CLIENT_TRANS_TCP)
+	CLIENT_TRANS_TLS  = ClientTransport(C.VIR_CLIENT_TRANS_TLS)
+)
+
+// AdmConnect is a connection to the administration interface of a
+// libvirt daemon, as used by virt-admin. It allows the daemon's
+// servers, their worker pools and clients, and its logging setup,
+// to be inspected and changed at runtime.
+type AdmConnect struct {
+	ptr C.virAdmConnectPtr
+}
+
+type AdmServer struct {
+	ptr C.virAdmServerPtr
+}
+
+type AdmClient struct {
+	ptr C.virAdmClientPtr
+}
+
+// Only one close callback can be registered per connection, which
+// must be remembered so Unregister can be skipped when there is none
+var admCloseCallbacks = make(map[C.virAdmConnectPtr]int)
+var admCloseCallbacksLock sync.Mutex
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmGetVersion
+func AdmGetVersion() (uint32, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1002017 {
+		return 0, makeNotImplementedError("virAdmGetVersion")
+	}
+
+	var version C.ulonglong
+	var err C.virError
+	ret := C.virAdmGetVersionWrapper(&version, &err)
+	if ret < 0 {
+		return 0, makeError(&err)
+	}
+	return uint32(version), nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectOpen
+func NewAdmConnect(uri string, flags uint32) (*AdmConnect, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1002017 {
+		return nil, makeNotImplementedError("virAdmConnectOpen")
+	}
+
+	var cUri *C.char
+	if uri != "" {
+		cUri = C.CString(uri)
+		defer C.free(unsafe.Pointer(cUri))
+	}
+	var err C.virError
+	ptr := C.virAdmConnectOpenWrapper(cUri, C.uint(flags), &err)
+	if ptr == nil {
+		return nil, makeError(&err)
+	}
+	return &AdmConnect{ptr: ptr}, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectClose
+func (c *AdmConnect) Close() (int, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1002017 {
+		return 0, makeNotImplementedError("virAdmConnectClose")
+	}
+
+	var err C.virError
+	result := int(C.virAdmConnectCloseWrapper(c.ptr, &err))
+	if result == -1 {
+		return result, makeError(&err)
+	}
+	if result == 0 {
+		admCloseCallbacksLock.Lock()
+		delete(admCloseCallbacks, c.ptr)
+		admCloseCallbacksLock.Unlock()
+		c.ptr = nil
+	}
+	return result, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectRef
+func (c *AdmConnect) Ref() error {
+	if C.LIBVIR_VERSION_NUMBER < 1002017 {
+		return makeNotImplementedError("virAdmConnectRef")
+	}
+
+	var err C.virError
+	ret := C.virAdmConnectRefWrapper(c.ptr, &err)
+	if ret == -1 {
+		return makeError(&err)
+	}
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectIsAlive
+func (c *AdmConnect) IsAlive() (bool, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1002017 {
+		return false, makeNotImplementedError("virAdmConnectIsAlive")
+	}
+
+	var err C.virError
+	result := C.virAdmConnectIsAliveWrapper(c.ptr, &err)
+	if result == -1 {
+		return false, makeError(&err)
+	}
+	if result == 1 {
+		return true, nil
+	}
+	return false, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectGetURI
+func (c *AdmConnect) GetURI() (string, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1002017 {
+		return "", makeNotImplementedError("virAdmConnectGetURI")
+	}
+
+	var err C.virError
+	cStr := C.virAdmConnectGetURIWrapper(c.ptr, &err)
+	if cStr == nil {
+		return "", makeError(&err)
+	}
+	uri := C.GoString(cStr)
+	C.free(unsafe.Pointer(cStr))
+	return uri, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectGetLibVersion
+func (c *AdmConnect) GetLibVersion() (uint32, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1003001 {
+		return 0, makeNotImplementedError("virAdmConnectGetLibVersion")
+	}
+
+	var version C.ulonglong
+	var err C.virError
+	ret := C.virAdmConnectGetLibVersionWrapper(c.ptr, &version, &err)
+	if ret < 0 {
+		return 0, makeError(&err)
+	}
+	return uint32(version), nil
+}
+
+type AdmCloseCallback func(conn *AdmConnect, reason ConnectCloseReason)
+
+// Register a close callback for the given connection. Only one
+// callback per connection is allowed. Setting a callback will remove
+// the previous one.
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectRegisterCloseCallback
+func (c *AdmConnect) RegisterCloseCallback(callback AdmCloseCallback) error {
+	if C.LIBVIR_VERSION_NUMBER < 1002017 {
+		return makeNotImplementedError("virAdmConnectRegisterCloseCallback")
+	}
+
+	c.UnregisterCloseCallback()
+	goCallbackId := registerCallbackId(callback)
+	var err C.virError
+	res := C.virAdmConnectRegisterCloseCallbackWrapper(c.ptr, C.long(goCallbackId), &err)
+	if res != 0 {
+		freeCallbackId(goCallbackId)
+		return makeError(&err)
+	}
+	admCloseCallbacksLock.Lock()
+	admCloseCallbacks[c.ptr] = goCallbackId
+	admCloseCallbacksLock.Unlock()
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectUnregisterCloseCallback
+func (c *AdmConnect) UnregisterCloseCallback() error {
+	if C.LIBVIR_VERSION_NUMBER < 1002017 {
+		return makeNotImplementedError("virAdmConnectUnregisterCloseCallback")
+	}
+
+	admCloseCallbacksLock.Lock()
+	defer admCloseCallbacksLock.Unlock()
+	if _, ok := admCloseCallbacks[c.ptr]; !ok {
+		return nil
+	}
+	var err C.virError
+	res := C.virAdmConnectUnregisterCloseCallbackWrapper(c.ptr, &err)
+	if res != 0 {
+		return makeError(&err)
+	}
+	delete(admCloseCallbacks, c.ptr)
+	return nil
+}
+
+//export admCloseCallback
+func admCloseCallback(conn C.virAdmConnectPtr, reason ConnectCloseReason, goCallbackId int) {
+	callbackFunc := getCallbackId(goCallbackId)
+	callback, ok := callbackFunc.(AdmCloseCallback)
+	if !ok {
+		panic("Inappropriate callback type called")
+	}
+	callback(&AdmConnect{ptr: conn}, reason)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectListServers
+func (c *AdmConnect) ListServers(flags uint32) ([]AdmServer, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1003002 {
+		return []AdmServer{}, makeNotImplementedError("virAdmConnectListServers")
+	}
+
+	var cList *C.virAdmServerPtr
+	var err C.virError
+	numServers := C.virAdmConnectListServersWrapper(c.ptr, (**C.virAdmServerPtr)(&cList), C.uint(flags), &err)
+	if numServers == -1 {
+		return []AdmServer{}, makeError(&err)
+	}
+	hdr := reflect.SliceHeader{
+		Data: uintptr(unsafe.Pointer(cList)),
+		Len:  int(numServers),
+		Cap:  int(numServers),
+	}
+	var servers []AdmServer
+	slice := *(*[]C.virAdmServerPtr)(unsafe.Pointer(&hdr))
+	for _, ptr := range slice {
+		servers = append(servers, AdmServer{ptr})
+	}
+	C.free(unsafe.Pointer(cList))
+	return servers, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectLookupServer
+func (c *AdmConnect) LookupServer(name string, flags uint32) (*AdmServer, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1003003 {
+		return nil, makeNotImplementedError("virAdmConnectLookupServer")
+	}
+
+	cName := C.CString(name)
+	defer C.free(unsafe.Pointer(cName))
+	var err C.virError
+	ptr := C.virAdmConnectLookupServerWrapper(c.ptr, cName, C.uint(flags), &err)
+	if ptr == nil {
+		return nil, makeError(&err)
+	}
+	return &AdmServer{ptr: ptr}, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectGetLoggingOutputs
+func (c *AdmConnect) GetLoggingOutputs(flags uint32) (string, error) {
+	if C.LIBVIR_VERSION_NUMBER < 3000000 {
+		return "", makeNotImplementedError("virAdmConnectGetLoggingOutputs")
+	}
+
+	var cOutputs *C.char
+	var err C.virError
+	ret := C.virAdmConnectGetLoggingOutputsWrapper(c.ptr, &cOutputs, C.uint(flags), &err)
+	if ret == -1 {
+		return "", makeError(&err)
+	}
+	outputs := C.GoString(cOutputs)
+	C.free(unsafe.Pointer(cOutputs))
+	return outputs, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectGetLoggingFilters
+func (c *AdmConnect) GetLoggingFilters(flags uint32) (string, error) {
+	if C.LIBVIR_VERSION_NUMBER < 3000000 {
+		return "", makeNotImplementedError("virAdmConnectGetLoggingFilters")
+	}
+
+	var cFilters *C.char
+	var err C.virError
+	ret := C.virAdmConnectGetLoggingFiltersWrapper(c.ptr, &cFilters, C.uint(flags), &err)
+	if ret == -1 {
+		return "", makeError(&err)
+	}
+	// There is no string allocated when no filters are set
+	if cFilters == nil {
+		return "", nil
+	}
+	filters := C.GoString(cFilters)
+	C.free(unsafe.Pointer(cFilters))
+	return filters, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectSetLoggingOutputs
+func (c *AdmConnect) SetLoggingOutputs(outputs string, flags uint32) error {
+	if C.LIBVIR_VERSION_NUMBER < 3000000 {
+		return makeNotImplementedError("virAdmConnectSetLoggingOutputs")
+	}
+
+	var cOutputs *C.char
+	if outputs != "" {
+		cOutputs = C.CString(outputs)
+		defer C.free(unsafe.Pointer(cOutputs))
+	}
+	var err C.virError
+	ret := C.virAdmConnectSetLoggingOutputsWrapper(c.ptr, cOutputs, C.uint(flags), &err)
+	if ret == -1 {
+		return makeError(&err)
+	}
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectSetLoggingFilters
+func (c *AdmConnect) SetLoggingFilters(filters string, flags uint32) error {
+	if C.LIBVIR_VERSION_NUMBER < 3000000 {
+		return makeNotImplementedError("virAdmConnectSetLoggingFilters")
+	}
+
+	var cFilters *C.char
+	if filters != "" {
+		cFilters = C.CString(filters)
+		defer C.free(unsafe.Pointer(cFilters))
+	}
+	var err C.virError
+	ret := C.virAdmConnectSetLoggingFiltersWrapper(c.ptr, cFilters, C.uint(flags), &err)
+	if ret == -1 {
+		return makeError(&err)
+	}
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmConnectSetDaemonTimeout
+func (c *AdmConnect) SetDaemonTimeout(timeout uint, flags uint32) error {
+	if C.LIBVIR_VERSION_NUMBER < 8006000 {
+		return makeNotImplementedError("virAdmConnectSetDaemonTimeout")
+	}
+
+	var err C.virError
+	ret := C.virAdmConnectSetDaemonTimeoutWrapper(c.ptr, C.uint(timeout), C.uint(flags), &err)
+	if ret == -1 {
+		return makeError(&err)
+	}
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmServerFree
+func (s *AdmServer) Free() error {
+	if C.LIBVIR_VERSION_NUMBER < 1003002 {
+		return makeNotImplementedError("virAdmServerFree")
+	}
+
+	var err C.virError
+	ret := C.virAdmServerFreeWrapper(s.ptr, &err)
+	if ret == -1 {
+		return makeError(&err)
+	}
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmServerGetName
+func (s *AdmServer) GetName() (string, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1003002 {
+		return "", makeNotImplementedError("virAdmServerGetName")
+	}
+
+	var err C.virError
+	name := C.virAdmServerGetNameWrapper(s.ptr, &err)
+	if name == nil {
+		return "", makeError(&err)
+	}
+	return C.GoString(name), nil
+}
+
+type AdmServerThreadPoolParameters struct {
+	WorkersMinSet      bool
+	WorkersMin         uint
+	WorkersMaxSet      bool
+	WorkersMax         uint
+	WorkersPrioritySet bool
+	WorkersPriority    uint
+	WorkersFreeSet     bool
+	WorkersFree        uint
+	WorkersCurrentSet  bool
+	WorkersCurrent     uint
+	JobQueueDepthSet   bool
+	JobQueueDepth      uint
+}
+
+func getAdmServerThreadPoolParametersFieldInfo(params *AdmServerThreadPoolParameters) map[string]typedParamsFieldInfo {
+	return map[string]typedParamsFieldInfo{
+		C.VIR_THREADPOOL_WORKERS_MIN: typedParamsFieldInfo{
+			set: &params.WorkersMinSet,
+			ui:  &params.WorkersMin,
+		},
+		C.VIR_THREADPOOL_WORKERS_MAX: typedParamsFieldInfo{
+			set: &params.WorkersMaxSet,
+			ui:  &params.WorkersMax,
+		},
+		C.VIR_THREADPOOL_WORKERS_PRIORITY: typedParamsFieldInfo{
+			set: &params.WorkersPrioritySet,
+			ui:  &params.WorkersPriority,
+		},
+		C.VIR_THREADPOOL_WORKERS_FREE: typedParamsFieldInfo{
+			set: &params.WorkersFreeSet,
+			ui:  &params.WorkersFree,
+		},
+		C.VIR_THREADPOOL_WORKERS_CURRENT: typedParamsFieldInfo{
+			set: &params.WorkersCurrentSet,
+			ui:  &params.WorkersCurrent,
+		},
+		C.VIR_THREADPOOL_JOB_QUEUE_DEPTH: typedParamsFieldInfo{
+			set: &params.JobQueueDepthSet,
+			ui:  &params.JobQueueDepth,
+		},
+	}
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmServerGetThreadPoolParameters
+func (s *AdmServer) GetThreadPoolParameters(flags uint32) (*AdmServerThreadPoolParameters, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1003004 {
+		return nil, makeNotImplementedError("virAdmServerGetThreadPoolParameters")
+	}
+
+	params := &AdmServerThreadPoolParameters{}
+	info := getAdmServerThreadPoolParametersFieldInfo(params)
+
+	var cparams C.virTypedParameterPtr
+	var cnparams C.int
+	var err C.virError
+	ret := C.virAdmServerGetThreadPoolParametersWrapper(s.ptr, &cparams, &cnparams, C.uint(flags), &err)
+	if ret == -1 {
+		return nil, makeError(&err)
+	}
+
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
+
+	_, gerr := typedParamsUnpack(cparams, cnparams, info)
+	if gerr != nil {
+		return nil, gerr
+	}
+
+	return params, nil
+}
+
+// Only the WorkersMin, WorkersMax and WorkersPriority fields can be
+// changed, the rest are reported for information only.
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmServerSetThreadPoolParameters
+func (s *AdmServer) SetThreadPoolParameters(params *AdmServerThreadPoolParameters, flags uint32) error {
+	if C.LIBVIR_VERSION_NUMBER < 1003004 {
+		return makeNotImplementedError("virAdmServerSetThreadPoolParameters")
+	}
+
+	info := getAdmServerThreadPoolParametersFieldInfo(params)
+
+	cparams, cnparams, gerr := typedParamsPackNew(info)
+	if gerr != nil {
+		return gerr
+	}
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
+
+	var err C.virError
+	ret := C.virAdmServerSetThreadPoolParametersWrapper(s.ptr, cparams, cnparams, C.uint(flags), &err)
+	if ret == -1 {
+		return makeError(&err)
+	}
+
+	return nil
+}
+
+type AdmServerClientLimits struct {
+	MaxSet           bool
+	Max              uint
+	CurrentSet       bool
+	Current          uint
+	UnauthMaxSet     bool
+	UnauthMax        uint
+	UnauthCurrentSet bool
+	UnauthCurrent    uint
+}
+
+func getAdmServerClientLimitsFieldInfo(params *AdmServerClientLimits) map[string]typedParamsFieldInfo {
+	return map[string]typedParamsFieldInfo{
+		C.VIR_SERVER_CLIENTS_MAX: typedParamsFieldInfo{
+			set: &params.MaxSet,
+			ui:  &params.Max,
+		},
+		C.VIR_SERVER_CLIENTS_CURRENT: typedParamsFieldInfo{
+			set: &params.CurrentSet,
+			ui:  &params.Current,
+		},
+		C.VIR_SERVER_CLIENTS_UNAUTH_MAX: typedParamsFieldInfo{
+			set: &params.UnauthMaxSet,
+			ui:  &params.UnauthMax,
+		},
+		C.VIR_SERVER_CLIENTS_UNAUTH_CURRENT: typedParamsFieldInfo{
+			set: &params.UnauthCurrentSet,
+			ui:  &params.UnauthCurrent,
+		},
+	}
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmServerGetClientLimits
+func (s *AdmServer) GetClientLimits(flags uint32) (*AdmServerClientLimits, error) {
+	if C.LIBVIR_VERSION_NUMBER < 2000000 {
+		return nil, makeNotImplementedError("virAdmServerGetClientLimits")
+	}
+
+	params := &AdmServerClientLimits{}
+	info := getAdmServerClientLimitsFieldInfo(params)
+
+	var cparams C.virTypedParameterPtr
+	var cnparams C.int
+	var err C.virError
+	ret := C.virAdmServerGetClientLimitsWrapper(s.ptr, &cparams, &cnparams, C.uint(flags), &err)
+	if ret == -1 {
+		return nil, makeError(&err)
+	}
+
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
+
+	_, gerr := typedParamsUnpack(cparams, cnparams, info)
+	if gerr != nil {
+		return nil, gerr
+	}
+
+	return params, nil
+}
+
+// Only the Max and UnauthMax fields can be changed.
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmServerSetClientLimits
+func (s *AdmServer) SetClientLimits(params *AdmServerClientLimits, flags uint32) error {
+	if C.LIBVIR_VERSION_NUMBER < 2000000 {
+		return makeNotImplementedError("virAdmServerSetClientLimits")
+	}
+
+	info := getAdmServerClientLimitsFieldInfo(params)
+
+	cparams, cnparams, gerr := typedParamsPackNew(info)
+	if gerr != nil {
+		return gerr
+	}
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
+
+	var err C.virError
+	ret := C.virAdmServerSetClientLimitsWrapper(s.ptr, cparams, cnparams, C.uint(flags), &err)
+	if ret == -1 {
+		return makeError(&err)
+	}
+
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmServerListClients
+func (s *AdmServer) ListClients(flags uint32) ([]AdmClient, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1003005 {
+		return []AdmClient{}, makeNotImplementedError("virAdmServerListClients")
+	}
+
+	var cList *C.virAdmClientPtr
+	var err C.virError
+	numClients := C.virAdmServerListClientsWrapper(s.ptr, (**C.virAdmClientPtr)(&cList), C.uint(flags), &err)
+	if numClients == -1 {
+		return []AdmClient{}, makeError(&err)
+	}
+	hdr := reflect.SliceHeader{
+		Data: uintptr(unsafe.Pointer(cList)),
+		Len:  int(numClients),
+		Cap:  int(numClients),
+	}
+	var clients []AdmClient
+	slice := *(*[]C.virAdmClientPtr)(unsafe.Pointer(&hdr))
+	for _, ptr := range slice {
+		clients = append(clients, AdmClient{ptr})
+	}
+	C.free(unsafe.Pointer(cList))
+	return clients, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmServerLookupClient
+func (s *AdmServer) LookupClient(id uint64, flags uint32) (*AdmClient, error) {
+	if C.LIBVIR_VERSION_NUMBER < 2000000 {
+		return nil, makeNotImplementedError("virAdmServerLookupClient")
+	}
+
+	var err C.virError
+	ptr := C.virAdmServerLookupClientWrapper(s.ptr, C.ulonglong(id), C.uint(flags), &err)
+	if ptr == nil {
+		return nil, makeError(&err)
+	}
+	return &AdmClient{ptr: ptr}, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmServerUpdateTlsFiles
+func (s *AdmServer) UpdateTlsFiles(flags uint32) error {
+	if C.LIBVIR_VERSION_NUMBER < 6002000 {
+		return makeNotImplementedError("virAdmServerUpdateTlsFiles")
+	}
+
+	var err C.virError
+	ret := C.virAdmServerUpdateTlsFilesWrapper(s.ptr, C.uint(flags), &err)
+	if ret == -1 {
+		return makeError(&err)
+	}
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmClientFree
+func (cl *AdmClient) Free() error {
+	if C.LIBVIR_VERSION_NUMBER < 1003005 {
+		return makeNotImplementedError("virAdmClientFree")
+	}
+
+	var err C.virError
+	ret := C.virAdmClientFreeWrapper(cl.ptr, &err)
+	if ret == -1 {
+		return makeError(&err)
+	}
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmClientGetID
+func (cl *AdmClient) GetID() (uint64, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1003005 {
+		return 0, makeNotImplementedError("virAdmClientGetID")
+	}
+
+	var err C.virError
+	id := C.virAdmClientGetIDWrapper(cl.ptr, &err)
+	if id == ^C.ulonglong(0) {
+		return 0, makeError(&err)
+	}
+	return uint64(id), nil
+}
+
+// GetTimestamp returns the time the client connected, in seconds
+// since the epoch.
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmClientGetTimestamp
+func (cl *AdmClient) GetTimestamp() (int64, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1003005 {
+		return 0, makeNotImplementedError("virAdmClientGetTimestamp")
+	}
+
+	var err C.virError
+	ret := C.virAdmClientGetTimestampWrapper(cl.ptr, &err)
+	if ret == -1 {
+		return 0, makeError(&err)
+	}
+	return int64(ret), nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmClientGetTransport
+func (cl *AdmClient) GetTransport() (ClientTransport, error) {
+	if C.LIBVIR_VERSION_NUMBER < 1003005 {
+		return 0, makeNotImplementedError("virAdmClientGetTransport")
+	}
+
+	var err C.virError
+	ret := C.virAdmClientGetTransportWrapper(cl.ptr, &err)
+	if ret == -1 {
+		return 0, makeError(&err)
+	}
+	return ClientTransport(ret), nil
+}
+
+type AdmClientInfo struct {
+	ReadonlySet              bool
+	Readonly                 bool
+	SocketAddrSet            bool
+	SocketAddr               string
+	SaslUserNameSet          bool
+	SaslUserName             string
+	X509DistinguishedNameSet bool
+	X509DistinguishedName    string
+	UnixUserIDSet            bool
+	UnixUserID               int
+	UnixUserNameSet          bool
+	UnixUserName             string
+	UnixGroupIDSet           bool
+	UnixGroupID              int
+	UnixGroupNameSet         bool
+	UnixGroupName            string
+	UnixProcessIDSet         bool
+	UnixProcessID            int
+	SELinuxContextSet        bool
+	SELinuxContext           string
+}
+
+func getAdmClientInfoFieldInfo(params *AdmClientInfo) map[string]typedParamsFieldInfo {
+	return map[string]typedParamsFieldInfo{
+		C.VIR_CLIENT_INFO_READONLY: typedParamsFieldInfo{
+			set: &params.ReadonlySet,
+			b:   &params.Readonly,
+		},
+		C.VIR_CLIENT_INFO_SOCKET_ADDR: typedParamsFieldInfo{
+			set: &params.SocketAddrSet,
+			s:   &params.SocketAddr,
+		},
+		C.VIR_CLIENT_INFO_SASL_USER_NAME: typedParamsFieldInfo{
+			set: &params.SaslUserNameSet,
+			s:   &params.SaslUserName,
+		},
+		C.VIR_CLIENT_INFO_X509_DISTINGUISHED_NAME: typedParamsFieldInfo{
+			set: &params.X509DistinguishedNameSet,
+			s:   &params.X509DistinguishedName,
+		},
+		C.VIR_CLIENT_INFO_UNIX_USER_ID: typedParamsFieldInfo{
+			set: &params.UnixUserIDSet,
+			i:   &params.UnixUserID,
+		},
+		C.VIR_CLIENT_INFO_UNIX_USER_NAME: typedParamsFieldInfo{
+			set: &params.UnixUserNameSet,
+			s:   &params.UnixUserName,
+		},
+		C.VIR_CLIENT_INFO_UNIX_GROUP_ID: typedParamsFieldInfo{
+			set: &params.UnixGroupIDSet,
+			i:   &params.UnixGroupID,
+		},
+		C.VIR_CLIENT_INFO_UNIX_GROUP_NAME: typedParamsFieldInfo{
+			set: &params.UnixGroupNameSet,
+			s:   &params.UnixGroupName,
+		},
+		C.VIR_CLIENT_INFO_UNIX_PROCESS_ID: typedParamsFieldInfo{
+			set: &params.UnixProcessIDSet,
+			i:   &params.UnixProcessID,
+		},
+		C.VIR_CLIENT_INFO_SELINUX_CONTEXT: typedParamsFieldInfo{
+			set: &params.SELinuxContextSet,
+			s:   &params.SELinuxContext,
+		},
+	}
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmClientGetInfo
+func (cl *AdmClient) GetInfo(flags uint32) (*AdmClientInfo, error) {
+	if C.LIBVIR_VERSION_NUMBER < 2000000 {
+		return nil, makeNotImplementedError("virAdmClientGetInfo")
+	}
+
+	params := &AdmClientInfo{}
+	info := getAdmClientInfoFieldInfo(params)
+
+	var cparams C.virTypedParameterPtr
+	var cnparams C.int
+	var err C.virError
+	ret := C.virAdmClientGetInfoWrapper(cl.ptr, &cparams, &cnparams, C.uint(flags), &err)
+	if ret == -1 {
+		return nil, makeError(&err)
+	}
+
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
+
+	_, gerr := typedParamsUnpack(cparams, cnparams, info)
+	if gerr != nil {
+		return nil, gerr
+	}
+
+	return params, nil
+}
+
+// Close forcefully disconnects the client from the daemon
+// See also https://libvirt.org/html/libvirt-libvirt-admin.html#virAdmClientClose
+func (cl *AdmClient) Close(flags uint32) error {
+	if C.LIBVIR_VERSION_NUMBER < 2000000 {
+		return makeNotImplementedError("virAdmClientClose")
+	}
+
+	var err C.virError
+	ret := C.virAdmClientCloseWrapper(cl.ptr, C.uint(flags), &err)
+	if ret == -1 {
+		return makeError(&err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+#ifndef LIBVIRT_GO_ADMIN_COMPAT_H__
+#define LIBVIRT_GO_ADMIN_COMPAT_H__
+
+/* 1.2.17 */
+
+#if LIBVIR_VERSION_NUMBER < 1002017
+typedef struct _virAdmConnect *virAdmConnectPtr;
+typedef void (*virAdmConnectCloseFunc)(virAdmConnectPtr conn,
+                                       int reason,
+                                       void *opaque);
+#else
+#include <libvirt/libvirt-admin.h>
+#endif
+
+/* 1.3.2 */
+
+#if LIBVIR_VERSION_NUMBER < 1003002
+typedef struct _virAdmServer *virAdmServerPtr;
+#endif
+
+/* 1.3.4 */
+
+#ifndef VIR_THREADPOOL_WORKERS_MIN
+#define VIR_THREADPOOL_WORKERS_MIN "minWorkers"
+#endif
+
+#ifndef VIR_THREADPOOL_WORKERS_MAX
+#define VIR_THREADPOOL_WORKERS_MAX "maxWorkers"
+#endif
+
+#ifndef VIR_THREADPOOL_WORKERS_PRIORITY
+#define VIR_THREADPOOL_WORKERS_PRIORITY "prioWorkers"
+#endif
+
+#ifndef VIR_THREADPOOL_WORKERS_FREE
+#define VIR_THREADPOOL_WORKERS_FREE "freeWorkers"
+#endif
+
+#ifndef VIR_THREADPOOL_WORKERS_CURRENT
+#define VIR_THREADPOOL_WORKERS_CURRENT "nWorkers"
+#endif
+
+#ifndef VIR_THREADPOOL_JOB_QUEUE_DEPTH
+#define VIR_THREADPOOL_JOB_QUEUE_DEPTH "jobQueueDepth"
+#endif
+
+/* 1.3.5 */
+
+#if LIBVIR_VERSION_NUMBER < 1003005
+typedef struct _virAdmClient *virAdmClientPtr;
+#endif
+
+#ifndef VIR_CLIENT_TRANS_UNIX
+#define VIR_CLIENT_TRANS_UNIX 0
+#endif
+
+#ifndef VIR_CLIENT_TRANS_TCP
+#define VIR_CLIENT_TRANS_TCP 1
+#endif
+
+#ifndef VIR_CLIENT_TRANS_TLS
+#define VIR_CLIENT_TRANS_TLS 2
+#endif
+
+/* 2.0.0 */
+
+#ifndef VIR_CLIENT_INFO_READONLY
+#define VIR_CLIENT_INFO_READONLY "readonly"
+#endif
+
+#ifndef VIR_CLIENT_INFO_SOCKET_ADDR
+#define VIR_CLIENT_INFO_SOCKET_ADDR "sock_addr"
+#endif
+
+#ifndef VIR_CLIENT_INFO_SASL_USER_NAME
+#define VIR_CLIENT_INFO_SASL_USER_NAME "sasl_user_name"
+#endif
+
+#ifndef VIR_CLIENT_INFO_X509_DISTINGUISHED_NAME
+#define VIR_CLIENT_INFO_X509_DISTINGUISHED_NAME "tls_x509_dname"
+#endif
+
+#ifndef VIR_CLIENT_INFO_UNIX_USER_ID
+#define VIR_CLIENT_INFO_UNIX_USER_ID "unix_user_id"
+#endif
+
+#ifndef VIR_CLIENT_INFO_UNIX_USER_NAME
+#define VIR_CLIENT_INFO_UNIX_USER_NAME "unix_user_name"
+#endif
+
+#ifndef VIR_CLIENT_INFO_UNIX_GROUP_ID
+#define VIR_CLIENT_INFO_UNIX_GROUP_ID "unix_group_id"
+#endif
+
+#ifndef VIR_CLIENT_INFO_UNIX_GROUP_NAME
+#define VIR_CLIENT_INFO_UNIX_GROUP_NAME "unix_group_name"
+#endif
+
+#ifndef VIR_CLIENT_INFO_UNIX_PROCESS_ID
+#define VIR_CLIENT_INFO_UNIX_PROCESS_ID "unix_process_id"
+#endif
+
+#ifndef VIR_CLIENT_INFO_SELINUX_CONTEXT
+#define VIR_CLIENT_INFO_SELINUX_CONTEXT "selinux_context"
+#endif
+
+#ifndef VIR_SERVER_CLIENTS_MAX
+#define VIR_SERVER_CLIENTS_MAX "nclients_max"
+#endif
+
+#ifndef VIR_SERVER_CLIENTS_CURRENT
+#define VIR_SERVER_CLIENTS_CURRENT "nclients"
+#endif
+
+#ifndef VIR_SERVER_CLIENTS_UNAUTH_MAX
+#define VIR_SERVER_CLIENTS_UNAUTH_MAX "nclients_unauth_max"
+#endif
+
+#ifndef VIR_SERVER_CLIENTS_UNAUTH_CURRENT
+#define VIR_SERVER_CLIENTS_UNAUTH_CURRENT "nclients_unauth"
+#endif
+
+#endif /* LIBVIRT_GO_ADMIN_COMPAT_H__ */
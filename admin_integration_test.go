@@ -0,0 +1,110 @@
+// +build integration,!without_admin
+
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2016 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"testing"
+)
+
+func buildTestAdmConnection(t *testing.T) *AdmConnect {
+	conn, err := NewAdmConnect("libvirtd:///system", 0)
+	if err != nil {
+		conn, err = NewAdmConnect("virtqemud:///system", 0)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+func TestIntegrationAdmConnect(t *testing.T) {
+	conn := buildTestAdmConnection(t)
+	defer conn.Close()
+
+	alive, err := conn.IsAlive()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !alive {
+		t.Fatal("Admin connection is not alive")
+	}
+
+	if _, err := conn.GetURI(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.GetLibVersion(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestIntegrationAdmServers(t *testing.T) {
+	conn := buildTestAdmConnection(t)
+	defer conn.Close()
+
+	servers, err := conn.ListServers(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(servers) == 0 {
+		t.Fatal("Expected at least one server")
+	}
+	for _, srv := range servers {
+		defer srv.Free()
+	}
+
+	name, err := servers[0].GetName()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv, err := conn.LookupServer(name, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer srv.Free()
+
+	params, err := srv.GetThreadPoolParameters(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !params.WorkersMaxSet || params.WorkersMax == 0 {
+		t.Fatal("Expected a non-zero max workers count")
+	}
+
+	clients, err := srv.ListClients(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, client := range clients {
+		if _, err := client.GetID(); err != nil {
+			t.Error(err)
+		}
+		client.Free()
+	}
+}
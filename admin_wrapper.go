@@ -0,0 +1,582 @@
+// +build !without_admin
+
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+/*
+#cgo !libvirt_dlopen pkg-config: libvirt
+// Can't rely on pkg-config for libvirt-admin since it was not
+// installed by older versions
+#cgo !libvirt_dlopen LDFLAGS: -lvirt-admin
+#include <assert.h>
+#include "admin_wrapper.h"
+#include "callbacks_wrapper.h"
+
+extern void admCloseCallback(virAdmConnectPtr, int, long);
+void admCloseCallbackHelper(virAdmConnectPtr conn, int reason, void *opaque)
+{
+    admCloseCallback(conn, reason, (long)opaque);
+}
+
+
+int
+virAdmClientCloseWrapper(virAdmClientPtr client,
+                         unsigned int flags,
+                         virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 2000000
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmClientClose(client, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmClientFreeWrapper(virAdmClientPtr client,
+                        virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003005
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmClientFree(client);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+unsigned long long
+virAdmClientGetIDWrapper(virAdmClientPtr client,
+                         virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003005
+    assert(0); // Caller should have checked version
+#else
+    unsigned long long ret = virAdmClientGetID(client);
+    if (ret == (unsigned long long)-1) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmClientGetInfoWrapper(virAdmClientPtr client,
+                           virTypedParameterPtr *params,
+                           int *nparams,
+                           unsigned int flags,
+                           virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 2000000
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmClientGetInfo(client, params, nparams, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+long long
+virAdmClientGetTimestampWrapper(virAdmClientPtr client,
+                                virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003005
+    assert(0); // Caller should have checked version
+#else
+    long long ret = virAdmClientGetTimestamp(client);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmClientGetTransportWrapper(virAdmClientPtr client,
+                                virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003005
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmClientGetTransport(client);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectCloseWrapper(virAdmConnectPtr conn,
+                          virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1002017
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmConnectClose(conn);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectGetLibVersionWrapper(virAdmConnectPtr conn,
+                                  unsigned long long *libVer,
+                                  virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003001
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmConnectGetLibVersion(conn, libVer);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectGetLoggingFiltersWrapper(virAdmConnectPtr conn,
+                                      char **filters,
+                                      unsigned int flags,
+                                      virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 3000000
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmConnectGetLoggingFilters(conn, filters, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectGetLoggingOutputsWrapper(virAdmConnectPtr conn,
+                                      char **outputs,
+                                      unsigned int flags,
+                                      virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 3000000
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmConnectGetLoggingOutputs(conn, outputs, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+char *
+virAdmConnectGetURIWrapper(virAdmConnectPtr conn,
+                           virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1002017
+    assert(0); // Caller should have checked version
+#else
+    char *ret = virAdmConnectGetURI(conn);
+    if (!ret) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectIsAliveWrapper(virAdmConnectPtr conn,
+                            virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1002017
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmConnectIsAlive(conn);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectListServersWrapper(virAdmConnectPtr conn,
+                                virAdmServerPtr **servers,
+                                unsigned int flags,
+                                virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003002
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmConnectListServers(conn, servers, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+virAdmServerPtr
+virAdmConnectLookupServerWrapper(virAdmConnectPtr conn,
+                                 const char *name,
+                                 unsigned int flags,
+                                 virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003003
+    assert(0); // Caller should have checked version
+#else
+    virAdmServerPtr ret = virAdmConnectLookupServer(conn, name, flags);
+    if (!ret) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+virAdmConnectPtr
+virAdmConnectOpenWrapper(const char *name,
+                         unsigned int flags,
+                         virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1002017
+    assert(0); // Caller should have checked version
+#else
+    virAdmConnectPtr ret = virAdmConnectOpen(name, flags);
+    if (!ret) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectRefWrapper(virAdmConnectPtr conn,
+                        virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1002017
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmConnectRef(conn);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectRegisterCloseCallbackWrapper(virAdmConnectPtr conn,
+                                          long goCallbackId,
+                                          virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1002017
+    assert(0); // Caller should have checked version
+#else
+    void *id = (void*)goCallbackId;
+    int ret = virAdmConnectRegisterCloseCallback(conn, admCloseCallbackHelper, id, freeGoCallbackHelper);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectSetDaemonTimeoutWrapper(virAdmConnectPtr conn,
+                                     unsigned int timeout,
+                                     unsigned int flags,
+                                     virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 8006000
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmConnectSetDaemonTimeout(conn, timeout, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectSetLoggingFiltersWrapper(virAdmConnectPtr conn,
+                                      const char *filters,
+                                      unsigned int flags,
+                                      virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 3000000
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmConnectSetLoggingFilters(conn, filters, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectSetLoggingOutputsWrapper(virAdmConnectPtr conn,
+                                      const char *outputs,
+                                      unsigned int flags,
+                                      virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 3000000
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmConnectSetLoggingOutputs(conn, outputs, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmConnectUnregisterCloseCallbackWrapper(virAdmConnectPtr conn,
+                                            virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1002017
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmConnectUnregisterCloseCallback(conn, admCloseCallbackHelper);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmGetVersionWrapper(unsigned long long *libVer,
+                        virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1002017
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmGetVersion(libVer);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmServerFreeWrapper(virAdmServerPtr srv,
+                        virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003002
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmServerFree(srv);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmServerGetClientLimitsWrapper(virAdmServerPtr srv,
+                                   virTypedParameterPtr *params,
+                                   int *nparams,
+                                   unsigned int flags,
+                                   virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 2000000
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmServerGetClientLimits(srv, params, nparams, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+const char *
+virAdmServerGetNameWrapper(virAdmServerPtr srv,
+                           virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003002
+    assert(0); // Caller should have checked version
+#else
+    const char *ret = virAdmServerGetName(srv);
+    if (!ret) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmServerGetThreadPoolParametersWrapper(virAdmServerPtr srv,
+                                           virTypedParameterPtr *params,
+                                           int *nparams,
+                                           unsigned int flags,
+                                           virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003004
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmServerGetThreadPoolParameters(srv, params, nparams, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmServerListClientsWrapper(virAdmServerPtr srv,
+                               virAdmClientPtr **clients,
+                               unsigned int flags,
+                               virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003005
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmServerListClients(srv, clients, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+virAdmClientPtr
+virAdmServerLookupClientWrapper(virAdmServerPtr srv,
+                                unsigned long long id,
+                                unsigned int flags,
+                                virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 2000000
+    assert(0); // Caller should have checked version
+#else
+    virAdmClientPtr ret = virAdmServerLookupClient(srv, id, flags);
+    if (!ret) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmServerSetClientLimitsWrapper(virAdmServerPtr srv,
+                                   virTypedParameterPtr params,
+                                   int nparams,
+                                   unsigned int flags,
+                                   virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 2000000
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmServerSetClientLimits(srv, params, nparams, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmServerSetThreadPoolParametersWrapper(virAdmServerPtr srv,
+                                           virTypedParameterPtr params,
+                                           int nparams,
+                                           unsigned int flags,
+                                           virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 1003004
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmServerSetThreadPoolParameters(srv, params, nparams, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+int
+virAdmServerUpdateTlsFilesWrapper(virAdmServerPtr srv,
+                                  unsigned int flags,
+                                  virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 6002000
+    assert(0); // Caller should have checked version
+#else
+    int ret = virAdmServerUpdateTlsFiles(srv, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
+*/
+import "C"
@@ -81,6 +81,10 @@ var (
 
 		/* Connect callback typedef */
 		"virConnectCloseFunc",
+		"virAdmConnectCloseFunc",
+
+		/* Called implicitly by virAdmConnectOpen */
+		"virAdmInitialize",
 
 		/* Data free callback typedef */
 		"virFreeCallback",
@@ -112,11 +116,14 @@ var (
 		"virConnectDomainEventWatchdogCallback",
 		"virConnectDomainEventMetadataChangeCallback",
 		"virConnectDomainEventBlockThresholdCallback",
+		"virConnectDomainEventMemoryFailureCallback",
+		"virConnectDomainEventMemoryDeviceSizeChangeCallback",
 		"virConnectDomainQemuMonitorEventCallback",
 
 		/* Network event callback typedefs */
 		"virConnectNetworkEventGenericCallback",
 		"virConnectNetworkEventLifecycleCallback",
+		"virConnectNetworkEventMetadataChangeCallback",
 
 		/* Node device event callback typedefs */
 		"virConnectNodeDeviceEventGenericCallback",
@@ -468,14 +475,17 @@ func TestAPICoverage(t *testing.T) {
 	path := GetAPIPath("libvirt_api", "libvirt")
 	lxcpath := GetAPIPath("libvirt_lxc_api", "libvirt-lxc")
 	qemupath := GetAPIPath("libvirt_qemu_api", "libvirt-qemu")
+	adminpath := GetAPIPath("libvirt_admin_api", "libvirt-admin")
 
 	api := GetAPI(path)
 	lxcapi := GetAPI(lxcpath)
 	qemuapi := GetAPI(qemupath)
+	adminapi := GetAPI(adminpath)
 
 	GetAPISymbols(api, funcs, macros, enums)
 	GetAPISymbols(lxcapi, funcs, macros, enums)
 	GetAPISymbols(qemuapi, funcs, macros, enums)
+	GetAPISymbols(adminapi, funcs, macros, enums)
 
 	SetIgnores(ignoreFuncs, funcs)
 	SetIgnores(ignoreMacros, macros)
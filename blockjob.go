@@ -0,0 +1,363 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// How often a BlockJob checks on its job, in addition to the checks
+// prompted by block job events
+const blockJobPollInterval = time.Second
+
+// BlockJob tracks a block job started by BlockCopyJob, BlockCommitJob,
+// BlockPullJob or BlockRebaseJob, until it ends.
+//
+// Progress is followed through block job events when an event loop is
+// running, and by polling GetBlockJobInfo otherwise. Events for the
+// same job may be delivered twice, as both the disk path and target
+// are watched, and may arrive in different orders across libvirt
+// versions, eg a READY event after the job has already completed or
+// failed. Once a job has ended, later events are ignored.
+//
+// When the job is only followed by polling, a job which ends by itself
+// is reported as completed, since a failure cannot be told apart.
+//
+// The BlockJob holds a reference on the domain and connection, which
+// are released by calling Free.
+type BlockJob struct {
+	dom  *Domain
+	conn *Connect
+	disk string
+
+	callbackIDs []int
+
+	lock     sync.Mutex
+	changed  chan struct{}
+	ready    bool
+	ended    bool
+	status   ConnectDomainEventBlockJobStatus
+	aborting bool
+	gone     int
+}
+
+func newBlockJob(d *Domain, disk string) (*BlockJob, error) {
+	conn, err := d.DomainGetConnect()
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Ref(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	dom := &Domain{}
+	*dom = *d
+
+	job := &BlockJob{
+		dom:     dom,
+		conn:    conn,
+		disk:    disk,
+		changed: make(chan struct{}),
+	}
+
+	// Depending on the libvirt version, either may be unavailable.
+	// The first reports the disk path, the second its target name.
+	if id, err := conn.DomainEventBlockJobRegister(dom, job.event); err == nil {
+		job.callbackIDs = append(job.callbackIDs, id)
+	}
+	if id, err := conn.DomainEventBlockJob2Register(dom, job.event); err == nil {
+		job.callbackIDs = append(job.callbackIDs, id)
+	}
+	return job, nil
+}
+
+// Disk returns the disk the job runs on.
+func (j *BlockJob) Disk() string {
+	return j.disk
+}
+
+// Free deregisters the events of the job, and releases its references
+// on the domain and connection. The job itself keeps running.
+func (j *BlockJob) Free() error {
+	for _, id := range j.callbackIDs {
+		j.conn.DomainEventDeregister(id)
+	}
+	j.callbackIDs = nil
+	err := j.dom.Free()
+	if _, closeErr := j.conn.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+func (j *BlockJob) event(c *Connect, d *Domain, event *DomainEventBlockJob) {
+	if event.Disk != j.disk {
+		return
+	}
+	j.update(func() {
+		switch event.Status {
+		case DOMAIN_BLOCK_JOB_READY:
+			j.ready = true
+		case DOMAIN_BLOCK_JOB_COMPLETED, DOMAIN_BLOCK_JOB_FAILED, DOMAIN_BLOCK_JOB_CANCELED:
+			j.ended = true
+			j.status = event.Status
+		}
+	})
+}
+
+// Runs f with the lock held, unless the job has already ended, and
+// wakes up anyone waiting on the job
+func (j *BlockJob) update(f func()) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+	if j.ended {
+		return
+	}
+	f()
+	close(j.changed)
+	j.changed = make(chan struct{})
+}
+
+func (j *BlockJob) poll() error {
+	info, err := j.dom.GetBlockJobInfo(j.disk, 0)
+	if err != nil {
+		return err
+	}
+
+	if info.Type == DOMAIN_BLOCK_JOB_TYPE_UNKNOWN {
+		j.update(func() {
+			// Allow the final event a little time to arrive, since
+			// it tells whether the job succeeded
+			j.gone++
+			if len(j.callbackIDs) != 0 && j.gone < 2 {
+				return
+			}
+			j.ended = true
+			if j.aborting {
+				j.status = DOMAIN_BLOCK_JOB_CANCELED
+			} else {
+				j.status = DOMAIN_BLOCK_JOB_COMPLETED
+			}
+		})
+		return nil
+	}
+
+	// Until a copy is ready, libvirt keeps Cur below End
+	mirror := info.Type == DOMAIN_BLOCK_JOB_TYPE_COPY || info.Type == DOMAIN_BLOCK_JOB_TYPE_ACTIVE_COMMIT
+	if mirror && info.End != 0 && info.Cur == info.End {
+		j.update(func() {
+			j.ready = true
+		})
+	}
+	return nil
+}
+
+// Waits until done reports true, returning the error done returned
+func (j *BlockJob) wait(ctx context.Context, done func() (bool, error)) error {
+	ticker := time.NewTicker(blockJobPollInterval)
+	defer ticker.Stop()
+
+	// Check at once, in case events are not being delivered
+	if err := j.poll(); err != nil {
+		return err
+	}
+	for {
+		j.lock.Lock()
+		finished, err := done()
+		changed := j.changed
+		j.lock.Unlock()
+		if finished {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		case <-ticker.C:
+			if err := j.poll(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (j *BlockJob) endError() error {
+	switch j.status {
+	case DOMAIN_BLOCK_JOB_FAILED:
+		return Error{
+			Code:    ERR_OPERATION_FAILED,
+			Domain:  FROM_DOMAIN,
+			Message: "Block job on disk " + j.disk + " failed",
+			Level:   ERR_ERROR,
+		}
+	case DOMAIN_BLOCK_JOB_CANCELED:
+		return Error{
+			Code:    ERR_OPERATION_ABORTED,
+			Domain:  FROM_DOMAIN,
+			Message: "Block job on disk " + j.disk + " was cancelled",
+			Level:   ERR_ERROR,
+		}
+	default:
+		return nil
+	}
+}
+
+// Progress returns the current and end positions, and bandwidth of
+// the job, as reported by GetBlockJobInfo.
+func (j *BlockJob) Progress() (*DomainBlockJobInfo, error) {
+	return j.dom.GetBlockJobInfo(j.disk, 0)
+}
+
+// SetSpeed limits the bandwidth of the job, in MiB/s, or in bytes/s
+// with DOMAIN_BLOCK_JOB_SPEED_BANDWIDTH_BYTES.
+func (j *BlockJob) SetSpeed(bandwidth uint64, flags DomainBlockJobSetSpeedFlags) error {
+	return j.dom.BlockJobSetSpeed(j.disk, bandwidth, flags)
+}
+
+// WaitReady waits until a copy or active commit job has mirrored the
+// disk, and is ready to be pivoted. An error is returned if the job
+// ends first.
+func (j *BlockJob) WaitReady(ctx context.Context) error {
+	return j.wait(ctx, j.readyDone)
+}
+
+// Reports whether WaitReady is done, and its result
+func (j *BlockJob) readyDone() (bool, error) {
+	if j.ready && !j.ended {
+		return true, nil
+	}
+	if j.ended {
+		if err := j.endError(); err != nil {
+			return true, err
+		}
+		return true, Error{
+			Code:    ERR_OPERATION_INVALID,
+			Domain:  FROM_DOMAIN,
+			Message: "Block job on disk " + j.disk + " ended without becoming ready",
+			Level:   ERR_ERROR,
+		}
+	}
+	return false, nil
+}
+
+// Wait waits for the job to end by itself, as pull and commit jobs do.
+// An error is returned if the job failed or was cancelled.
+func (j *BlockJob) Wait(ctx context.Context) error {
+	return j.wait(ctx, j.endDone)
+}
+
+// Reports whether Wait is done, and its result
+func (j *BlockJob) endDone() (bool, error) {
+	if j.ended {
+		return true, j.endError()
+	}
+	return false, nil
+}
+
+// Pivot switches the disk over to the copy made by a job which is
+// ready, and waits for the job to end.
+func (j *BlockJob) Pivot(ctx context.Context) error {
+	if err := j.dom.BlockJobAbort(j.disk, DOMAIN_BLOCK_JOB_ABORT_PIVOT|DOMAIN_BLOCK_JOB_ABORT_ASYNC); err != nil {
+		return err
+	}
+	return j.Wait(ctx)
+}
+
+// Cancel stops the job, leaving the disk as it was, and waits for the
+// job to end.
+func (j *BlockJob) Cancel(ctx context.Context) error {
+	j.lock.Lock()
+	j.aborting = true
+	j.lock.Unlock()
+
+	if err := j.dom.BlockJobAbort(j.disk, DOMAIN_BLOCK_JOB_ABORT_ASYNC); err != nil {
+		return err
+	}
+	return j.wait(ctx, j.cancelDone)
+}
+
+// Reports whether Cancel is done, and its result
+func (j *BlockJob) cancelDone() (bool, error) {
+	if j.ended {
+		// Some libvirt versions report a cancelled copy as completed
+		if j.status == DOMAIN_BLOCK_JOB_FAILED {
+			return true, j.endError()
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+func (d *Domain) startBlockJob(ctx context.Context, disk string, start func() error) (*BlockJob, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// Events are watched before starting, so that none are missed
+	job, err := newBlockJob(d, disk)
+	if err != nil {
+		return nil, err
+	}
+	if err := start(); err != nil {
+		job.Free()
+		return nil, err
+	}
+	return job, nil
+}
+
+// BlockCopyJob is BlockCopy returning a BlockJob to track the copy.
+// The ctx only bounds starting the job.
+func (d *Domain) doBlockCopyJob(ctx context.Context, disk string, destxml string, params *DomainBlockCopyParameters, flags DomainBlockCopyFlags) (*BlockJob, error) {
+	return d.startBlockJob(ctx, disk, func() error {
+		return d.BlockCopy(disk, destxml, params, flags)
+	})
+}
+
+// BlockCommitJob is BlockCommit returning a BlockJob to track the
+// commit. The ctx only bounds starting the job.
+func (d *Domain) doBlockCommitJob(ctx context.Context, disk string, base string, top string, bandwidth uint64, flags DomainBlockCommitFlags) (*BlockJob, error) {
+	return d.startBlockJob(ctx, disk, func() error {
+		return d.BlockCommit(disk, base, top, bandwidth, flags)
+	})
+}
+
+// BlockPullJob is BlockPull returning a BlockJob to track the pull.
+// The ctx only bounds starting the job.
+func (d *Domain) doBlockPullJob(ctx context.Context, disk string, bandwidth uint64, flags DomainBlockPullFlags) (*BlockJob, error) {
+	return d.startBlockJob(ctx, disk, func() error {
+		return d.BlockPull(disk, bandwidth, flags)
+	})
+}
+
+// BlockRebaseJob is BlockRebase returning a BlockJob to track the
+// rebase. The ctx only bounds starting the job.
+func (d *Domain) doBlockRebaseJob(ctx context.Context, disk string, base string, bandwidth uint64, flags DomainBlockRebaseFlags) (*BlockJob, error) {
+	return d.startBlockJob(ctx, disk, func() error {
+		return d.BlockRebase(disk, base, bandwidth, flags)
+	})
+}
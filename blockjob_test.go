@@ -0,0 +1,182 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"testing"
+)
+
+func TestBlockJobStartCancelled(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := dom.BlockPullJob(ctx, "vda", 0, 0); err != context.Canceled {
+		t.Fatalf("Expected cancellation, got %v", err)
+	}
+}
+
+func TestBlockJobStartFailed(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	// The domain is not running, so no block job can be started
+	job, err := dom.BlockPullJob(context.Background(), "vda", 0, 0)
+	if err == nil {
+		job.Free()
+		t.Fatal("Expected block pull on inactive domain to fail")
+	}
+}
+
+// Builds a job which is only fed events by the test
+func buildTestBlockJob() *BlockJob {
+	return &BlockJob{
+		disk:    "vda",
+		changed: make(chan struct{}),
+	}
+}
+
+func sendTestBlockJobEvents(job *BlockJob, statuses ...ConnectDomainEventBlockJobStatus) {
+	for _, status := range statuses {
+		job.event(nil, nil, &DomainEventBlockJob{
+			Disk:   job.disk,
+			Type:   DOMAIN_BLOCK_JOB_TYPE_COPY,
+			Status: status,
+		})
+	}
+}
+
+func expectBlockJobError(t *testing.T, what string, err error, code ErrorNumber) {
+	t.Helper()
+	if code == ERR_OK {
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", what, err)
+		}
+		return
+	}
+	if virErr, ok := err.(Error); !ok || virErr.Code != code {
+		t.Errorf("%s: expected error code %d, got %v", what, code, err)
+	}
+}
+
+func TestBlockJobEventOrdering(t *testing.T) {
+	tests := []struct {
+		name     string
+		statuses []ConnectDomainEventBlockJobStatus
+		ended    bool
+		ready    ErrorNumber
+		end      ErrorNumber
+		cancel   ErrorNumber
+	}{
+		{
+			name:     "ready then completed",
+			statuses: []ConnectDomainEventBlockJobStatus{DOMAIN_BLOCK_JOB_READY, DOMAIN_BLOCK_JOB_COMPLETED},
+			ended:    true,
+			ready:    ERR_OPERATION_INVALID,
+			end:      ERR_OK,
+			cancel:   ERR_OK,
+		},
+		{
+			name:     "failed before ready",
+			statuses: []ConnectDomainEventBlockJobStatus{DOMAIN_BLOCK_JOB_FAILED, DOMAIN_BLOCK_JOB_READY},
+			ended:    true,
+			ready:    ERR_OPERATION_FAILED,
+			end:      ERR_OPERATION_FAILED,
+			cancel:   ERR_OPERATION_FAILED,
+		},
+		{
+			name:     "cancelled after ready",
+			statuses: []ConnectDomainEventBlockJobStatus{DOMAIN_BLOCK_JOB_READY, DOMAIN_BLOCK_JOB_CANCELED, DOMAIN_BLOCK_JOB_COMPLETED},
+			ended:    true,
+			ready:    ERR_OPERATION_ABORTED,
+			end:      ERR_OPERATION_ABORTED,
+			cancel:   ERR_OK,
+		},
+		{
+			name:     "duplicate ready",
+			statuses: []ConnectDomainEventBlockJobStatus{DOMAIN_BLOCK_JOB_READY, DOMAIN_BLOCK_JOB_READY},
+			ended:    false,
+			ready:    ERR_OK,
+		},
+	}
+
+	for _, test := range tests {
+		job := buildTestBlockJob()
+		changed := job.changed
+		sendTestBlockJobEvents(job, test.statuses...)
+
+		select {
+		case <-changed:
+		default:
+			t.Errorf("%s: waiters were not woken", test.name)
+		}
+
+		done, err := job.readyDone()
+		if !done {
+			t.Errorf("%s: WaitReady would not return", test.name)
+		}
+		expectBlockJobError(t, test.name+": WaitReady", err, test.ready)
+
+		done, err = job.endDone()
+		if done != test.ended {
+			t.Errorf("%s: Wait done is %v, expected %v", test.name, done, test.ended)
+		}
+		if !test.ended {
+			continue
+		}
+		expectBlockJobError(t, test.name+": Wait", err, test.end)
+
+		done, err = job.cancelDone()
+		if !done {
+			t.Errorf("%s: Cancel would not return", test.name)
+		}
+		expectBlockJobError(t, test.name+": Cancel", err, test.cancel)
+	}
+}
+
+func TestBlockJobOtherDiskEvent(t *testing.T) {
+	job := buildTestBlockJob()
+	job.event(nil, nil, &DomainEventBlockJob{
+		Disk:   "vdb",
+		Type:   DOMAIN_BLOCK_JOB_TYPE_COPY,
+		Status: DOMAIN_BLOCK_JOB_FAILED,
+	})
+	if done, _ := job.endDone(); done {
+		t.Fatal("Job ended by an event for another disk")
+	}
+}
@@ -0,0 +1,270 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// BulkConfig selects the domains a Bulk operates on, and how.
+type BulkConfig struct {
+	// Flags passed to ListAllDomains, eg CONNECT_LIST_DOMAINS_ACTIVE
+	Flags ConnectListAllDomainsFlags
+
+	// Narrows down the domains listed. If nil, every listed
+	// domain is selected
+	Filter func(dom *Domain) (bool, error)
+
+	// Maximum number of domains operated on at once. Defaults to 1
+	Concurrency int
+
+	// Time allowed for the operation on each domain. For Shutdown,
+	// this is how long the guest is given to power off before the
+	// domain is destroyed. Zero means no limit
+	Timeout time.Duration
+
+	// How often the state of a domain is checked while waiting for it
+	// to change, in addition to checks prompted by lifecycle events.
+	// Defaults to one second
+	PollInterval time.Duration
+}
+
+// BulkResult is the outcome of an operation on one domain.
+type BulkResult struct {
+	Name string
+	UUID string
+	// What was done to the domain, eg "shut down" or "destroyed"
+	Action   string
+	Duration time.Duration
+	Err      error
+}
+
+// BulkReport holds a result for each selected domain, in the order
+// ListAllDomains returned them.
+type BulkReport struct {
+	Results []BulkResult
+}
+
+// Failed returns the results which have an error.
+func (r *BulkReport) Failed() []BulkResult {
+	var failed []BulkResult
+	for _, result := range r.Results {
+		if result.Err != nil {
+			failed = append(failed, result)
+		}
+	}
+	return failed
+}
+
+// String formats the report with one domain per line.
+func (r *BulkReport) String() string {
+	var buf strings.Builder
+	for _, result := range r.Results {
+		if result.Err != nil {
+			fmt.Fprintf(&buf, "%s: failed after %s: %s\n", result.Name, result.Duration, result.Err)
+		} else {
+			fmt.Fprintf(&buf, "%s: %s in %s\n", result.Name, result.Action, result.Duration)
+		}
+	}
+	return buf.String()
+}
+
+// Bulk carries out an operation on many domains in parallel, such as
+// shutting down every running domain before host maintenance.
+//
+// Completion of state changes is detected with lifecycle events when
+// an event loop is running, and by polling the domain state otherwise.
+type Bulk struct {
+	conn   *Connect
+	config BulkConfig
+}
+
+// NewBulk creates a Bulk operating on the domains of conn.
+func NewBulk(conn *Connect, config *BulkConfig) *Bulk {
+	b := &Bulk{
+		conn:   conn,
+		config: *config,
+	}
+	if b.config.Concurrency < 1 {
+		b.config.Concurrency = 1
+	}
+	if b.config.PollInterval <= 0 {
+		b.config.PollInterval = time.Second
+	}
+	return b
+}
+
+// Run calls fn for each selected domain, with a context limited by the
+// configured Timeout. The string returned by fn describes what it did.
+// Errors from fn are recorded in the report, while an error is only
+// returned if the domains could not be listed. Once ctx is done, the
+// domains which were not yet started on report ctx.Err().
+func (b *Bulk) Run(ctx context.Context, fn func(ctx context.Context, dom *Domain) (string, error)) (*BulkReport, error) {
+	return b.run(ctx, b.config.Timeout, fn)
+}
+
+// Start starts each selected domain which is not running, eg after
+// listing with CONNECT_LIST_DOMAINS_AUTOSTART. The Concurrency limits
+// how many domains boot at once.
+func (b *Bulk) Start(ctx context.Context) (*BulkReport, error) {
+	return b.run(ctx, b.config.Timeout, func(ctx context.Context, dom *Domain) (string, error) {
+		active, err := dom.IsActive()
+		if err != nil {
+			return "", err
+		}
+		if active {
+			return "already running", nil
+		}
+		if err := dom.Create(); err != nil {
+			return "", err
+		}
+		return "started", nil
+	})
+}
+
+// Shutdown asks each selected domain which is running to shut down,
+// and waits up to the configured Timeout for it to power off. Domains
+// which have not powered off by then, or which fail to handle the
+// shutdown request, are destroyed.
+func (b *Bulk) Shutdown(ctx context.Context) (*BulkReport, error) {
+	watcher := newLifecycleWatcher(b.conn)
+	defer watcher.close()
+
+	return b.run(ctx, 0, func(ctx context.Context, dom *Domain) (string, error) {
+		active, err := dom.IsActive()
+		if err != nil {
+			return "", err
+		}
+		if !active {
+			return "already shut off", nil
+		}
+
+		if err := dom.Shutdown(); err == nil {
+			waitCtx := ctx
+			if b.config.Timeout > 0 {
+				var cancel context.CancelFunc
+				waitCtx, cancel = context.WithTimeout(ctx, b.config.Timeout)
+				defer cancel()
+			}
+			_, err = watcher.waitStatus(waitCtx, dom, b.config.PollInterval, func(status *DomainStatus) bool {
+				return status.State == DOMAIN_SHUTOFF
+			})
+			if err == nil {
+				return "shut down", nil
+			}
+			if ctx.Err() != nil {
+				return "", ctx.Err()
+			}
+		}
+
+		if err := dom.Destroy(); err != nil {
+			if virErr, ok := err.(Error); ok && virErr.Code == ERR_OPERATION_INVALID {
+				// Powered off just before the destroy
+				return "shut down", nil
+			}
+			return "", err
+		}
+		return "destroyed", nil
+	})
+}
+
+func (b *Bulk) run(ctx context.Context, timeout time.Duration, fn func(ctx context.Context, dom *Domain) (string, error)) (*BulkReport, error) {
+	doms, err := b.conn.ListAllDomains(b.config.Flags)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for i := range doms {
+			doms[i].Free()
+		}
+	}()
+
+	report := &BulkReport{}
+	var selected []*Domain
+	for i := range doms {
+		dom := &doms[i]
+		result := BulkResult{}
+		result.Name, _ = dom.GetName()
+		result.UUID, _ = dom.GetUUIDString()
+		if b.config.Filter != nil {
+			ok, err := b.config.Filter(dom)
+			if err != nil {
+				result.Err = err
+				report.Results = append(report.Results, result)
+				selected = append(selected, nil)
+				continue
+			}
+			if !ok {
+				continue
+			}
+		}
+		report.Results = append(report.Results, result)
+		selected = append(selected, dom)
+	}
+
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, b.config.Concurrency)
+	for i, dom := range selected {
+		if dom == nil {
+			continue
+		}
+		if ctx.Err() != nil {
+			report.Results[i].Err = ctx.Err()
+			continue
+		}
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			report.Results[i].Err = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(result *BulkResult, dom *Domain) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+
+			domCtx := ctx
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				domCtx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+			start := time.Now()
+			result.Action, result.Err = fn(domCtx, dom)
+			result.Duration = time.Since(start)
+		}(&report.Results[i], dom)
+	}
+	wg.Wait()
+
+	return report, nil
+}
@@ -0,0 +1,144 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func buildBulkTestDomains(t *testing.T, conn *Connect, count int) map[string]bool {
+	names := make(map[string]bool)
+	for i := 0; i < count; i++ {
+		name := time.Now().String()
+		dom, err := conn.DomainDefineXML(`<domain type="test">
+			<name>` + name + `</name>
+			<memory unit="KiB">8192</memory>
+			<os>
+				<type>hvm</type>
+			</os>
+		</domain>`)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := dom.Create(); err != nil {
+			t.Fatal(err)
+		}
+		dom.Free()
+		names[name] = true
+	}
+	return names
+}
+
+func cleanupBulkTestDomains(conn *Connect, names map[string]bool) {
+	for name := range names {
+		if dom, err := conn.LookupDomainByName(name); err == nil {
+			dom.Destroy()
+			dom.Undefine()
+			dom.Free()
+		}
+	}
+}
+
+func TestBulkShutdown(t *testing.T) {
+	conn := buildTestConnection()
+	defer func() {
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	names := buildBulkTestDomains(t, conn, 3)
+	defer cleanupBulkTestDomains(conn, names)
+
+	bulk := NewBulk(conn, &BulkConfig{
+		Flags: CONNECT_LIST_DOMAINS_ACTIVE,
+		Filter: func(dom *Domain) (bool, error) {
+			name, err := dom.GetName()
+			return names[name], err
+		},
+		Concurrency:  2,
+		Timeout:      10 * time.Second,
+		PollInterval: 10 * time.Millisecond,
+	})
+	report, err := bulk.Shutdown(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Results) != len(names) {
+		t.Fatalf("Expected %d results, got:\n%s", len(names), report)
+	}
+	if failed := report.Failed(); len(failed) != 0 {
+		t.Fatalf("Unexpected failures:\n%s", report)
+	}
+	for _, result := range report.Results {
+		if result.Action != "shut down" {
+			t.Fatalf("Unexpected action for %s: %s", result.Name, result.Action)
+		}
+	}
+
+	report, err = bulk.Start(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Results) != 0 {
+		t.Fatalf("Expected no active domains to start, got:\n%s", report)
+	}
+}
+
+func TestBulkRunCancelled(t *testing.T) {
+	conn := buildTestConnection()
+	defer func() {
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	names := buildBulkTestDomains(t, conn, 2)
+	defer cleanupBulkTestDomains(conn, names)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	bulk := NewBulk(conn, &BulkConfig{
+		Filter: func(dom *Domain) (bool, error) {
+			name, err := dom.GetName()
+			return names[name], err
+		},
+	})
+	report, err := bulk.Run(ctx, func(ctx context.Context, dom *Domain) (string, error) {
+		return "visited", nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, result := range report.Results {
+		if result.Err != context.Canceled {
+			t.Fatalf("Expected cancellation for %s, got %v", result.Name, result.Err)
+		}
+	}
+}
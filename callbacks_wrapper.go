@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include "callbacks_wrapper.h"
 
 extern void freeCallbackId(long);
@@ -0,0 +1,370 @@
+// +build ignore
+
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+// Generates the exported API methods of the object types.
+//
+// Each API method is written as an unexported method whose name is the
+// exported name with a "do" prefix, eg Domain.doCreate implements
+// Domain.Create. For every source file X.go containing such methods,
+// this program writes X_calls.go holding the exported methods, which
+// route each call through the interceptors before running the "do"
+// method. Source files with build tags pass them on to their
+// X_calls.go file.
+//
+// Run with "go generate" after adding or changing any API method.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+var objectTypes = map[string]bool{
+	"Connect":          true,
+	"Domain":           true,
+	"DomainCheckpoint": true,
+	"DomainSnapshot":   true,
+	"Interface":        true,
+	"Network":          true,
+	"NetworkPort":      true,
+	"NodeDevice":       true,
+	"NWFilter":         true,
+	"NWFilterBinding":  true,
+	"Secret":           true,
+	"StoragePool":      true,
+	"StorageVol":       true,
+	"Stream":           true,
+}
+
+const header = `/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+`
+
+type generator struct {
+	fset    *token.FileSet
+	buf     bytes.Buffer
+	imports map[string]bool
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) expr(node ast.Expr) string {
+	ast.Inspect(node, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if pkg, ok := sel.X.(*ast.Ident); ok {
+				g.imports[pkg.Name] = true
+			}
+		}
+		return true
+	})
+
+	var buf bytes.Buffer
+	printer.Fprint(&buf, g.fset, node)
+	return buf.String()
+}
+
+func isAPIMethod(decl *ast.FuncDecl) (string, bool) {
+	if decl.Recv == nil || len(decl.Recv.List) != 1 {
+		return "", false
+	}
+	star, ok := decl.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return "", false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	if !ok || !objectTypes[ident.Name] {
+		return "", false
+	}
+	name := decl.Name.Name
+	if !strings.HasPrefix(name, "do") || len(name) < 3 || !unicode.IsUpper(rune(name[2])) {
+		return "", false
+	}
+	return ident.Name, true
+}
+
+func (g *generator) method(typ string, decl *ast.FuncDecl) error {
+	impl := decl.Name.Name
+	name := impl[2:]
+
+	recv := "obj"
+	if names := decl.Recv.List[0].Names; len(names) == 1 && names[0].Name != "_" {
+		recv = names[0].Name
+	}
+
+	taken := map[string]bool{recv: true}
+	var params, args, callArgs []string
+	for i, field := range decl.Type.Params.List {
+		typstr := g.expr(field.Type)
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{ast.NewIdent(fmt.Sprintf("arg%d", i))}
+		}
+		for j, ident := range names {
+			if ident.Name == "_" {
+				ident = ast.NewIdent(fmt.Sprintf("arg%d_%d", i, j))
+			}
+			if taken[ident.Name] {
+				return fmt.Errorf("%s.%s: duplicate name %s", typ, impl, ident.Name)
+			}
+			taken[ident.Name] = true
+			params = append(params, ident.Name+" "+typstr)
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				args = append(args, ident.Name+"...")
+			} else {
+				args = append(args, ident.Name)
+			}
+			callArgs = append(callArgs, fmt.Sprintf("{%q, %s}", ident.Name, ident.Name))
+		}
+	}
+
+	var results, rets []string
+	errret := ""
+	if decl.Type.Results != nil {
+		i := 0
+		for _, field := range decl.Type.Results.List {
+			typstr := g.expr(field.Type)
+			count := len(field.Names)
+			if count == 0 {
+				count = 1
+			}
+			for j := 0; j < count; j++ {
+				ret := fmt.Sprintf("ret%d", i)
+				if taken[ret] {
+					return fmt.Errorf("%s.%s: duplicate name %s", typ, impl, ret)
+				}
+				results = append(results, typstr)
+				rets = append(rets, ret)
+				if typstr == "error" {
+					errret = ret
+				}
+				i++
+			}
+		}
+	}
+
+	g.printf("\n")
+	if decl.Doc != nil {
+		for _, c := range decl.Doc.List {
+			g.printf("%s\n", c.Text)
+		}
+	}
+	g.printf("func (%s *%s) %s(%s)", recv, typ, name, strings.Join(params, ", "))
+	switch len(results) {
+	case 0:
+		g.printf(" {\n")
+	case 1:
+		g.printf(" %s {\n", results[0])
+	default:
+		g.printf(" (%s) {\n", strings.Join(results, ", "))
+	}
+
+	direct := fmt.Sprintf("%s.%s(%s)", recv, impl, strings.Join(args, ", "))
+
+	g.printf("\tif !callHooksInstalled() {\n")
+	if len(results) == 0 {
+		g.printf("\t\t%s\n\t\treturn\n", direct)
+	} else {
+		g.printf("\t\treturn %s\n", direct)
+	}
+	g.printf("\t}\n")
+
+	for i, ret := range rets {
+		g.printf("\tvar %s %s\n", ret, results[i])
+	}
+
+	call := fmt.Sprintf("&Call{Method: %q, Receiver: %s", typ+"."+name, recv)
+	if len(callArgs) != 0 {
+		call += fmt.Sprintf(", Args: []CallArg{%s}", strings.Join(callArgs, ", "))
+	}
+	call += "}"
+
+	if errret != "" {
+		g.printf("\t%s = runCallHooks(%s, func() error {\n", errret, call)
+	} else {
+		g.printf("\trunCallHooks(%s, func() error {\n", call)
+	}
+	if len(rets) == 0 {
+		g.printf("\t\t%s\n", direct)
+	} else {
+		g.printf("\t\t%s = %s\n", strings.Join(rets, ", "), direct)
+	}
+	if errret != "" {
+		g.printf("\t\treturn %s\n", errret)
+	} else {
+		g.printf("\t\treturn nil\n")
+	}
+	g.printf("\t})\n")
+
+	if len(rets) != 0 {
+		g.printf("\treturn %s\n", strings.Join(rets, ", "))
+	}
+	g.printf("}\n")
+	return nil
+}
+
+func buildTags(src []byte) []string {
+	var tags []string
+	for _, line := range strings.Split(string(src), "\n") {
+		if strings.HasPrefix(line, "// +build") {
+			tags = append(tags, line)
+		} else if strings.HasPrefix(line, "package ") {
+			break
+		}
+	}
+	return tags
+}
+
+func generate(fset *token.FileSet, filename string) error {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+
+	g := &generator{fset: fset, imports: make(map[string]bool)}
+	count := 0
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		typ, ok := isAPIMethod(fn)
+		if !ok {
+			continue
+		}
+		if err := g.method(typ, fn); err != nil {
+			return err
+		}
+		count++
+	}
+
+	outname := strings.TrimSuffix(filename, ".go") + "_calls.go"
+	if count == 0 {
+		os.Remove(outname)
+		return nil
+	}
+
+	var out bytes.Buffer
+	for _, tag := range buildTags(src) {
+		fmt.Fprintf(&out, "%s\n", tag)
+	}
+	if out.Len() != 0 {
+		fmt.Fprintf(&out, "\n")
+	}
+	fmt.Fprintf(&out, "%s\n", header)
+	fmt.Fprintf(&out, "// Code generated by callgen.go from %s. DO NOT EDIT.\n\n", filename)
+	fmt.Fprintf(&out, "package %s\n", file.Name.Name)
+	var imports []string
+	for _, spec := range file.Imports {
+		path := strings.Trim(spec.Path.Value, "\"")
+		name := filepath.Base(path)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if g.imports[name] {
+			imports = append(imports, spec.Path.Value)
+		}
+	}
+	if len(imports) != 0 {
+		fmt.Fprintf(&out, "\nimport (\n\t%s\n)\n", strings.Join(imports, "\n\t"))
+	}
+	out.Write(g.buf.Bytes())
+
+	formatted, err := format.Source(out.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %s", outname, err)
+	}
+	// Keep to the same build tag style as the rest of the package
+	var lines []string
+	for _, line := range strings.Split(string(formatted), "\n") {
+		if !strings.HasPrefix(line, "//go:build ") {
+			lines = append(lines, line)
+		}
+	}
+	return ioutil.WriteFile(outname, []byte(strings.Join(lines, "\n")), 0644)
+}
+
+func main() {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	sort.Strings(files)
+
+	fset := token.NewFileSet()
+	for _, filename := range files {
+		if strings.HasSuffix(filename, "_test.go") ||
+			strings.HasSuffix(filename, "_calls.go") ||
+			filename == "callgen.go" {
+			continue
+		}
+		if err := generate(fset, filename); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+
+	"libvirt.org/libvirt-go/libvirtxml"
+)
+
+// ColdMigrateOptions controls how ColdMigrate moves a domain.
+type ColdMigrateOptions struct {
+	// Shut the domain down with ShutdownAndWait if it is running,
+	// rather than refusing to migrate it
+	Shutdown       bool
+	ShutdownPolicy *DomainShutdownPolicy
+
+	// Name of the destination pool for each source pool. Disks in
+	// pools which are not listed are copied to a pool of the same name
+	PoolMap map[string]string
+
+	// Flags used to define the domain on the destination
+	DefineFlags DomainDefineFlags
+
+	// Flags used to undefine the domain on the source
+	UndefineFlags DomainUndefineFlagsValues
+
+	// Delete the disk volumes on the source once the domain has moved
+	DeleteSourceVolumes bool
+
+	// Copy holes as zeros, as described for StorageVolCopyOptions
+	NoSparse bool
+
+	// Called as each disk is copied, with the target name of the disk
+	Progress func(disk string, copied, total uint64)
+}
+
+// A disk volume copied by ColdMigrate
+type coldMigrateVolume struct {
+	disk string
+	src  *StorageVol
+	dst  *StorageVol
+}
+
+// ColdMigrate moves a shut off domain to the host of dstConn, copying
+// its disks over the libvirt connections, and returns the domain
+// defined on the destination.
+//
+// Each disk must be a volume in a storage pool of the source host,
+// and is copied into the pool given by opts.PoolMap, with the domain
+// definition changed to refer to the copy. CD-ROMs, shareable disks
+// and network disks are not copied, and must be reachable from the
+// destination as they are. NVRAM files are not copied either.
+//
+// Once the domain is defined on the destination, and its disks are
+// checked, it is undefined on the source. If any step fails before
+// then, everything done is undone: copied volumes are deleted, the
+// domain is undefined on the destination, and if it was shut down by
+// ColdMigrate, it is started again on the source. Only a failure to
+// delete the source volumes is reported along with the new domain.
+func ColdMigrate(ctx context.Context, dom *Domain, dstConn *Connect, opts *ColdMigrateOptions) (*Domain, error) {
+	if opts == nil {
+		opts = &ColdMigrateOptions{}
+	}
+
+	srcConn, err := dom.DomainGetConnect()
+	if err != nil {
+		return nil, err
+	}
+	defer srcConn.Close()
+
+	var undo []func()
+	rollback := func() {
+		for i := len(undo) - 1; i >= 0; i-- {
+			undo[i]()
+		}
+	}
+
+	active, err := dom.IsActive()
+	if err != nil {
+		return nil, err
+	}
+	if active {
+		if !opts.Shutdown {
+			return nil, Error{
+				Code:    ERR_OPERATION_INVALID,
+				Domain:  FROM_DOMAIN,
+				Message: "Domain must be shut off for cold migration",
+				Level:   ERR_ERROR,
+			}
+		}
+		if _, err := dom.ShutdownAndWait(ctx, opts.ShutdownPolicy); err != nil {
+			return nil, err
+		}
+		undo = append(undo, func() {
+			dom.Create()
+		})
+	}
+
+	xml, err := dom.GetXMLDesc(DOMAIN_XML_INACTIVE | DOMAIN_XML_MIGRATABLE)
+	if err != nil {
+		rollback()
+		return nil, err
+	}
+	spec := &DomainSpec{}
+	if err := spec.Unmarshal(xml); err != nil {
+		rollback()
+		return nil, err
+	}
+
+	var volumes []*coldMigrateVolume
+	defer func() {
+		for _, vol := range volumes {
+			vol.src.Free()
+			vol.dst.Free()
+		}
+	}()
+
+	if spec.Devices != nil {
+		for i := range spec.Devices.Disks {
+			vol, err := coldMigrateDisk(ctx, srcConn, dstConn, &spec.Devices.Disks[i], opts)
+			if err != nil {
+				rollback()
+				return nil, err
+			}
+			if vol == nil {
+				continue
+			}
+			volumes = append(volumes, vol)
+			undo = append(undo, func() {
+				vol.dst.Delete(0)
+			})
+		}
+	}
+
+	var newDom *Domain
+	if opts.DefineFlags != 0 {
+		newDom, err = dstConn.DefineDomainFlags(spec, opts.DefineFlags)
+	} else {
+		newDom, err = dstConn.DefineDomain(spec)
+	}
+	if err != nil {
+		rollback()
+		return nil, err
+	}
+	undo = append(undo, func() {
+		newDom.Undefine()
+		newDom.Free()
+	})
+
+	if err := verifyColdMigration(newDom, spec); err != nil {
+		rollback()
+		return nil, err
+	}
+
+	if opts.UndefineFlags != 0 {
+		err = dom.UndefineFlags(opts.UndefineFlags)
+	} else {
+		err = dom.Undefine()
+	}
+	if err != nil {
+		rollback()
+		return nil, err
+	}
+
+	if opts.DeleteSourceVolumes {
+		for _, vol := range volumes {
+			if delErr := vol.src.Delete(0); delErr != nil && err == nil {
+				err = delErr
+			}
+		}
+	}
+	return newDom, err
+}
+
+// Copies the volume of a disk to the destination, and points the disk
+// at the copy. Returns nil for disks which are not copied.
+func coldMigrateDisk(ctx context.Context, srcConn, dstConn *Connect, disk *libvirtxml.DomainDisk, opts *ColdMigrateOptions) (*coldMigrateVolume, error) {
+	if disk.Source == nil || disk.Device == "cdrom" || disk.Shareable != nil {
+		return nil, nil
+	}
+
+	var src *StorageVol
+	var err error
+	switch disk.Type {
+	case "", "file", "block":
+		path := disk.Source.File
+		if disk.Type == "block" {
+			path = disk.Source.Dev
+		}
+		if path == "" {
+			return nil, nil
+		}
+		src, err = srcConn.LookupStorageVolByPath(path)
+	case "volume":
+		var pool *StoragePool
+		pool, err = srcConn.LookupStoragePoolByName(disk.Source.Pool)
+		if err == nil {
+			src, err = pool.LookupStorageVolByName(disk.Source.Volume)
+			pool.Free()
+		}
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	vol, err := coldMigrateVolumeCopy(ctx, src, dstConn, disk, opts)
+	if err != nil {
+		src.Free()
+		return nil, err
+	}
+	return vol, nil
+}
+
+func coldMigrateVolumeCopy(ctx context.Context, src *StorageVol, dstConn *Connect, disk *libvirtxml.DomainDisk, opts *ColdMigrateOptions) (*coldMigrateVolume, error) {
+	srcPool, err := src.LookupPoolByVolume()
+	if err != nil {
+		return nil, err
+	}
+	poolName, err := srcPool.GetName()
+	srcPool.Free()
+	if err != nil {
+		return nil, err
+	}
+	if mapped, ok := opts.PoolMap[poolName]; ok {
+		poolName = mapped
+	}
+	dstPool, err := dstConn.LookupStoragePoolByName(poolName)
+	if err != nil {
+		return nil, err
+	}
+	defer dstPool.Free()
+
+	target := ""
+	if disk.Target != nil {
+		target = disk.Target.Dev
+	}
+	copyOpts := &StorageVolCopyOptions{NoSparse: opts.NoSparse}
+	if opts.Progress != nil {
+		copyOpts.Progress = func(copied, total uint64) {
+			opts.Progress(target, copied, total)
+		}
+	}
+	dst, err := CopyVolume(ctx, src, dstPool, copyOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	fail := func(err error) (*coldMigrateVolume, error) {
+		dst.Delete(0)
+		dst.Free()
+		return nil, err
+	}
+	srcInfo, err := src.GetInfo()
+	if err != nil {
+		return fail(err)
+	}
+	dstInfo, err := dst.GetInfo()
+	if err != nil {
+		return fail(err)
+	}
+	if srcInfo.Capacity != dstInfo.Capacity {
+		return fail(fmt.Errorf("Copy of disk %s has capacity %d, expected %d",
+			target, dstInfo.Capacity, srcInfo.Capacity))
+	}
+
+	switch disk.Type {
+	case "volume":
+		name, err := dst.GetName()
+		if err != nil {
+			return fail(err)
+		}
+		disk.Source.Pool = poolName
+		disk.Source.Volume = name
+	default:
+		path, err := dst.GetPath()
+		if err != nil {
+			return fail(err)
+		}
+		if disk.Type == "block" {
+			disk.Source.Dev = path
+		} else {
+			disk.Source.File = path
+		}
+	}
+	return &coldMigrateVolume{disk: target, src: src, dst: dst}, nil
+}
+
+// Checks the domain defined on the destination uses the copied disks
+func verifyColdMigration(dom *Domain, spec *DomainSpec) error {
+	xml, err := dom.GetXMLDesc(DOMAIN_XML_INACTIVE)
+	if err != nil {
+		return err
+	}
+	defined := &DomainSpec{}
+	if err := defined.Unmarshal(xml); err != nil {
+		return err
+	}
+
+	var want, have []libvirtxml.DomainDisk
+	if spec.Devices != nil {
+		want = spec.Devices.Disks
+	}
+	if defined.Devices != nil {
+		have = defined.Devices.Disks
+	}
+	if len(want) != len(have) {
+		return fmt.Errorf("Migrated domain has %d disks, expected %d", len(have), len(want))
+	}
+	for i := range want {
+		if want[i].Source == nil {
+			continue
+		}
+		if have[i].Source == nil ||
+			have[i].Source.File != want[i].Source.File ||
+			have[i].Source.Dev != want[i].Source.Dev ||
+			have[i].Source.Volume != want[i].Source.Volume {
+			return fmt.Errorf("Migrated domain has the wrong source for disk %d", i)
+		}
+	}
+	return nil
+}
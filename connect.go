@@ -35,14 +35,17 @@ import (
 )
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "connect_wrapper.h"
+#include "domain_wrapper.h"
+#include "typedparams_wrapper.h"
 */
 import "C"
 
 func init() {
-	C.virInitialize()
+	var err C.virError
+	C.virInitializeWrapper(&err)
 }
 
 const (
@@ -426,7 +429,7 @@ func NewConnectReadOnly(uri string) (*Connect, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectClose
-func (c *Connect) Close() (int, error) {
+func (c *Connect) doClose() (int, error) {
 	var err C.virError
 	result := int(C.virConnectCloseWrapper(c.ptr, &err))
 	if result == -1 {
@@ -441,7 +444,7 @@ func (c *Connect) Close() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectRef
-func (c *Connect) Ref() error {
+func (c *Connect) doRef() error {
 	var err C.virError
 	ret := C.virConnectRefWrapper(c.ptr, &err)
 	if ret == -1 {
@@ -456,7 +459,7 @@ type CloseCallback func(conn *Connect, reason ConnectCloseReason)
 // callback per connection is allowed. Setting a callback will remove
 // the previous one.
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectRegisterCloseCallback
-func (c *Connect) RegisterCloseCallback(callback CloseCallback) error {
+func (c *Connect) doRegisterCloseCallback(callback CloseCallback) error {
 	c.UnregisterCloseCallback()
 	goCallbackId := registerCallbackId(callback)
 	var err C.virError
@@ -471,7 +474,7 @@ func (c *Connect) RegisterCloseCallback(callback CloseCallback) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectUnregisterCloseCallback
-func (c *Connect) UnregisterCloseCallback() error {
+func (c *Connect) doUnregisterCloseCallback() error {
 	connData := getConnectionData(c)
 	if connData.closeCallbackId == nil {
 		return nil
@@ -557,7 +560,7 @@ func getConnectIdentityFieldInfo(params *ConnectIdentity) map[string]typedParams
 	}
 }
 
-func (c *Connect) SetIdentity(ident *ConnectIdentity, flags uint) error {
+func (c *Connect) doSetIdentity(ident *ConnectIdentity, flags uint) error {
 	if C.LIBVIR_VERSION_NUMBER < 5008000 {
 		return makeNotImplementedError("virConnectSetIdentity")
 	}
@@ -568,7 +571,7 @@ func (c *Connect) SetIdentity(ident *ConnectIdentity, flags uint) error {
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virConnectSetIdentityWrapper(c.ptr, cparams, cnparams, C.uint(flags), &err)
@@ -580,7 +583,7 @@ func (c *Connect) SetIdentity(ident *ConnectIdentity, flags uint) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetCapabilities
-func (c *Connect) GetCapabilities() (string, error) {
+func (c *Connect) doGetCapabilities() (string, error) {
 	var err C.virError
 	str := C.virConnectGetCapabilitiesWrapper(c.ptr, &err)
 	if str == nil {
@@ -592,7 +595,7 @@ func (c *Connect) GetCapabilities() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeGetInfo
-func (c *Connect) GetNodeInfo() (*NodeInfo, error) {
+func (c *Connect) doGetNodeInfo() (*NodeInfo, error) {
 	var cinfo C.virNodeInfo
 	var err C.virError
 	result := C.virNodeGetInfoWrapper(c.ptr, &cinfo, &err)
@@ -616,7 +619,7 @@ func (ni *NodeInfo) GetMaxCPUs() uint32 {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetHostname
-func (c *Connect) GetHostname() (string, error) {
+func (c *Connect) doGetHostname() (string, error) {
 	var err C.virError
 	str := C.virConnectGetHostnameWrapper(c.ptr, &err)
 	if str == nil {
@@ -628,7 +631,7 @@ func (c *Connect) GetHostname() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetLibVersion
-func (c *Connect) GetLibVersion() (uint32, error) {
+func (c *Connect) doGetLibVersion() (uint32, error) {
 	var version C.ulong
 	var err C.virError
 	ret := C.virConnectGetLibVersionWrapper(c.ptr, &version, &err)
@@ -639,7 +642,7 @@ func (c *Connect) GetLibVersion() (uint32, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetType
-func (c *Connect) GetType() (string, error) {
+func (c *Connect) doGetType() (string, error) {
 	var err C.virError
 	str := C.virConnectGetTypeWrapper(c.ptr, &err)
 	if str == nil {
@@ -650,7 +653,7 @@ func (c *Connect) GetType() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectIsAlive
-func (c *Connect) IsAlive() (bool, error) {
+func (c *Connect) doIsAlive() (bool, error) {
 	var err C.virError
 	result := C.virConnectIsAliveWrapper(c.ptr, &err)
 	if result == -1 {
@@ -663,7 +666,7 @@ func (c *Connect) IsAlive() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectIsEncrypted
-func (c *Connect) IsEncrypted() (bool, error) {
+func (c *Connect) doIsEncrypted() (bool, error) {
 	var err C.virError
 	result := C.virConnectIsEncryptedWrapper(c.ptr, &err)
 	if result == -1 {
@@ -676,7 +679,7 @@ func (c *Connect) IsEncrypted() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectIsSecure
-func (c *Connect) IsSecure() (bool, error) {
+func (c *Connect) doIsSecure() (bool, error) {
 	var err C.virError
 	result := C.virConnectIsSecureWrapper(c.ptr, &err)
 	if result == -1 {
@@ -689,7 +692,7 @@ func (c *Connect) IsSecure() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectListDefinedDomains
-func (c *Connect) ListDefinedDomains() ([]string, error) {
+func (c *Connect) doListDefinedDomains() ([]string, error) {
 	var names [1024](*C.char)
 	namesPtr := unsafe.Pointer(&names)
 	var err C.virError
@@ -709,7 +712,7 @@ func (c *Connect) ListDefinedDomains() ([]string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectListDomains
-func (c *Connect) ListDomains() ([]uint32, error) {
+func (c *Connect) doListDomains() ([]uint32, error) {
 	var cDomainsIds [512](uint32)
 	cDomainsPointer := unsafe.Pointer(&cDomainsIds)
 	var err C.virError
@@ -722,7 +725,7 @@ func (c *Connect) ListDomains() ([]uint32, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virConnectListInterfaces
-func (c *Connect) ListInterfaces() ([]string, error) {
+func (c *Connect) doListInterfaces() ([]string, error) {
 	const maxIfaces = 1024
 	var names [maxIfaces](*C.char)
 	namesPtr := unsafe.Pointer(&names)
@@ -743,7 +746,7 @@ func (c *Connect) ListInterfaces() ([]string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virConnectListNetworks
-func (c *Connect) ListNetworks() ([]string, error) {
+func (c *Connect) doListNetworks() ([]string, error) {
 	const maxNets = 1024
 	var names [maxNets](*C.char)
 	namesPtr := unsafe.Pointer(&names)
@@ -764,7 +767,7 @@ func (c *Connect) ListNetworks() ([]string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virConnectListNWFilters
-func (c *Connect) ListNWFilters() ([]string, error) {
+func (c *Connect) doListNWFilters() ([]string, error) {
 	const maxFilters = 1024
 	var names [maxFilters](*C.char)
 	namesPtr := unsafe.Pointer(&names)
@@ -785,7 +788,7 @@ func (c *Connect) ListNWFilters() ([]string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virConnectListStoragePools
-func (c *Connect) ListStoragePools() ([]string, error) {
+func (c *Connect) doListStoragePools() ([]string, error) {
 	const maxPools = 1024
 	var names [maxPools](*C.char)
 	namesPtr := unsafe.Pointer(&names)
@@ -806,7 +809,7 @@ func (c *Connect) ListStoragePools() ([]string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virConnectListSecrets
-func (c *Connect) ListSecrets() ([]string, error) {
+func (c *Connect) doListSecrets() ([]string, error) {
 	const maxSecrets = 1024
 	var uuids [maxSecrets](*C.char)
 	uuidsPtr := unsafe.Pointer(&uuids)
@@ -827,7 +830,7 @@ func (c *Connect) ListSecrets() ([]string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeListDevices
-func (c *Connect) ListDevices(cap string, flags uint32) ([]string, error) {
+func (c *Connect) doListDevices(cap string, flags uint32) ([]string, error) {
 	ccap := C.CString(cap)
 	defer C.free(unsafe.Pointer(ccap))
 	const maxNodeDevices = 1024
@@ -850,17 +853,18 @@ func (c *Connect) ListDevices(cap string, flags uint32) ([]string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainLookupByID
-func (c *Connect) LookupDomainById(id uint32) (*Domain, error) {
+func (c *Connect) doLookupDomainById(id uint32) (*Domain, error) {
 	var err C.virError
 	ptr := C.virDomainLookupByIDWrapper(c.ptr, C.int(id), &err)
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Domain", unsafe.Pointer(ptr))
 	return &Domain{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainLookupByName
-func (c *Connect) LookupDomainByName(id string) (*Domain, error) {
+func (c *Connect) doLookupDomainByName(id string) (*Domain, error) {
 	cName := C.CString(id)
 	defer C.free(unsafe.Pointer(cName))
 	var err C.virError
@@ -868,11 +872,12 @@ func (c *Connect) LookupDomainByName(id string) (*Domain, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Domain", unsafe.Pointer(ptr))
 	return &Domain{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainLookupByUUIDString
-func (c *Connect) LookupDomainByUUIDString(uuid string) (*Domain, error) {
+func (c *Connect) doLookupDomainByUUIDString(uuid string) (*Domain, error) {
 	cUuid := C.CString(uuid)
 	defer C.free(unsafe.Pointer(cUuid))
 	var err C.virError
@@ -880,11 +885,12 @@ func (c *Connect) LookupDomainByUUIDString(uuid string) (*Domain, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Domain", unsafe.Pointer(ptr))
 	return &Domain{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainLookupByUUID
-func (c *Connect) LookupDomainByUUID(uuid []byte) (*Domain, error) {
+func (c *Connect) doLookupDomainByUUID(uuid []byte) (*Domain, error) {
 	if len(uuid) != C.VIR_UUID_BUFLEN {
 		return nil, fmt.Errorf("UUID must be exactly %d bytes in size",
 			int(C.VIR_UUID_BUFLEN))
@@ -898,11 +904,12 @@ func (c *Connect) LookupDomainByUUID(uuid []byte) (*Domain, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Domain", unsafe.Pointer(ptr))
 	return &Domain{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainCreateXML
-func (c *Connect) DomainCreateXML(xmlConfig string, flags DomainCreateFlags) (*Domain, error) {
+func (c *Connect) doDomainCreateXML(xmlConfig string, flags DomainCreateFlags) (*Domain, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -910,11 +917,12 @@ func (c *Connect) DomainCreateXML(xmlConfig string, flags DomainCreateFlags) (*D
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Domain", unsafe.Pointer(ptr))
 	return &Domain{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainCreateXMLWithFiles
-func (c *Connect) DomainCreateXMLWithFiles(xmlConfig string, files []os.File, flags DomainCreateFlags) (*Domain, error) {
+func (c *Connect) doDomainCreateXMLWithFiles(xmlConfig string, files []os.File, flags DomainCreateFlags) (*Domain, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	cfiles := make([]C.int, len(files))
@@ -926,11 +934,12 @@ func (c *Connect) DomainCreateXMLWithFiles(xmlConfig string, files []os.File, fl
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Domain", unsafe.Pointer(ptr))
 	return &Domain{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDefineXML
-func (c *Connect) DomainDefineXML(xmlConfig string) (*Domain, error) {
+func (c *Connect) doDomainDefineXML(xmlConfig string) (*Domain, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -938,11 +947,12 @@ func (c *Connect) DomainDefineXML(xmlConfig string) (*Domain, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Domain", unsafe.Pointer(ptr))
 	return &Domain{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDefineXMLFlags
-func (c *Connect) DomainDefineXMLFlags(xmlConfig string, flags DomainDefineFlags) (*Domain, error) {
+func (c *Connect) doDomainDefineXMLFlags(xmlConfig string, flags DomainDefineFlags) (*Domain, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002012 {
 		return nil, makeNotImplementedError("virDomainDefineXMLFlags")
 	}
@@ -953,11 +963,12 @@ func (c *Connect) DomainDefineXMLFlags(xmlConfig string, flags DomainDefineFlags
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Domain", unsafe.Pointer(ptr))
 	return &Domain{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virConnectListDefinedInterfaces
-func (c *Connect) ListDefinedInterfaces() ([]string, error) {
+func (c *Connect) doListDefinedInterfaces() ([]string, error) {
 	const maxIfaces = 1024
 	var names [maxIfaces](*C.char)
 	namesPtr := unsafe.Pointer(&names)
@@ -978,7 +989,7 @@ func (c *Connect) ListDefinedInterfaces() ([]string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virConnectListDefinedNetworks
-func (c *Connect) ListDefinedNetworks() ([]string, error) {
+func (c *Connect) doListDefinedNetworks() ([]string, error) {
 	const maxNets = 1024
 	var names [maxNets](*C.char)
 	namesPtr := unsafe.Pointer(&names)
@@ -999,7 +1010,7 @@ func (c *Connect) ListDefinedNetworks() ([]string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virConnectListDefinedStoragePools
-func (c *Connect) ListDefinedStoragePools() ([]string, error) {
+func (c *Connect) doListDefinedStoragePools() ([]string, error) {
 	const maxPools = 1024
 	var names [maxPools](*C.char)
 	namesPtr := unsafe.Pointer(&names)
@@ -1020,7 +1031,7 @@ func (c *Connect) ListDefinedStoragePools() ([]string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectNumOfDefinedDomains
-func (c *Connect) NumOfDefinedDomains() (int, error) {
+func (c *Connect) doNumOfDefinedDomains() (int, error) {
 	var err C.virError
 	result := int(C.virConnectNumOfDefinedDomainsWrapper(c.ptr, &err))
 	if result == -1 {
@@ -1030,7 +1041,7 @@ func (c *Connect) NumOfDefinedDomains() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virConnectNumOfDefinedInterfaces
-func (c *Connect) NumOfDefinedInterfaces() (int, error) {
+func (c *Connect) doNumOfDefinedInterfaces() (int, error) {
 	var err C.virError
 	result := int(C.virConnectNumOfDefinedInterfacesWrapper(c.ptr, &err))
 	if result == -1 {
@@ -1040,7 +1051,7 @@ func (c *Connect) NumOfDefinedInterfaces() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virConnectNumOfDefinedNetworks
-func (c *Connect) NumOfDefinedNetworks() (int, error) {
+func (c *Connect) doNumOfDefinedNetworks() (int, error) {
 	var err C.virError
 	result := int(C.virConnectNumOfDefinedNetworksWrapper(c.ptr, &err))
 	if result == -1 {
@@ -1050,7 +1061,7 @@ func (c *Connect) NumOfDefinedNetworks() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virConnectNumOfDefinedStoragePools
-func (c *Connect) NumOfDefinedStoragePools() (int, error) {
+func (c *Connect) doNumOfDefinedStoragePools() (int, error) {
 	var err C.virError
 	result := int(C.virConnectNumOfDefinedStoragePoolsWrapper(c.ptr, &err))
 	if result == -1 {
@@ -1060,7 +1071,7 @@ func (c *Connect) NumOfDefinedStoragePools() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectNumOfDomains
-func (c *Connect) NumOfDomains() (int, error) {
+func (c *Connect) doNumOfDomains() (int, error) {
 	var err C.virError
 	result := int(C.virConnectNumOfDomainsWrapper(c.ptr, &err))
 	if result == -1 {
@@ -1070,7 +1081,7 @@ func (c *Connect) NumOfDomains() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virConnectNumOfStoragePools
-func (c *Connect) NumOfStoragePools() (int, error) {
+func (c *Connect) doNumOfStoragePools() (int, error) {
 	var err C.virError
 	result := int(C.virConnectNumOfStoragePoolsWrapper(c.ptr, &err))
 	if result == -1 {
@@ -1080,7 +1091,7 @@ func (c *Connect) NumOfStoragePools() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virConnectNumOfInterfaces
-func (c *Connect) NumOfInterfaces() (int, error) {
+func (c *Connect) doNumOfInterfaces() (int, error) {
 	var err C.virError
 	result := int(C.virConnectNumOfInterfacesWrapper(c.ptr, &err))
 	if result == -1 {
@@ -1090,7 +1101,7 @@ func (c *Connect) NumOfInterfaces() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virConnectNumOfNetworks
-func (c *Connect) NumOfNetworks() (int, error) {
+func (c *Connect) doNumOfNetworks() (int, error) {
 	var err C.virError
 	result := int(C.virConnectNumOfNetworksWrapper(c.ptr, &err))
 	if result == -1 {
@@ -1100,7 +1111,7 @@ func (c *Connect) NumOfNetworks() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virConnectNumOfNWFilters
-func (c *Connect) NumOfNWFilters() (int, error) {
+func (c *Connect) doNumOfNWFilters() (int, error) {
 	var err C.virError
 	result := int(C.virConnectNumOfNWFiltersWrapper(c.ptr, &err))
 	if result == -1 {
@@ -1110,7 +1121,7 @@ func (c *Connect) NumOfNWFilters() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virConnectNumOfSecrets
-func (c *Connect) NumOfSecrets() (int, error) {
+func (c *Connect) doNumOfSecrets() (int, error) {
 	var err C.virError
 	result := int(C.virConnectNumOfSecretsWrapper(c.ptr, &err))
 	if result == -1 {
@@ -1120,7 +1131,7 @@ func (c *Connect) NumOfSecrets() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeNumOfDevices
-func (c *Connect) NumOfDevices(cap string, flags uint32) (int, error) {
+func (c *Connect) doNumOfDevices(cap string, flags uint32) (int, error) {
 	ccap := C.CString(cap)
 	defer C.free(unsafe.Pointer(ccap))
 	var err C.virError
@@ -1132,7 +1143,7 @@ func (c *Connect) NumOfDevices(cap string, flags uint32) (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkDefineXML
-func (c *Connect) NetworkDefineXML(xmlConfig string) (*Network, error) {
+func (c *Connect) doNetworkDefineXML(xmlConfig string) (*Network, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1140,11 +1151,12 @@ func (c *Connect) NetworkDefineXML(xmlConfig string) (*Network, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Network", unsafe.Pointer(ptr))
 	return &Network{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkCreateXML
-func (c *Connect) NetworkCreateXML(xmlConfig string) (*Network, error) {
+func (c *Connect) doNetworkCreateXML(xmlConfig string) (*Network, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1152,11 +1164,44 @@ func (c *Connect) NetworkCreateXML(xmlConfig string) (*Network, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Network", unsafe.Pointer(ptr))
+	return &Network{ptr: ptr}, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkDefineXMLFlags
+func (c *Connect) doNetworkDefineXMLFlags(xmlConfig string, flags NetworkDefineFlags) (*Network, error) {
+	if C.LIBVIR_VERSION_NUMBER < 7008000 {
+		return nil, makeNotImplementedError("virNetworkDefineXMLFlags")
+	}
+	cXml := C.CString(string(xmlConfig))
+	defer C.free(unsafe.Pointer(cXml))
+	var err C.virError
+	ptr := C.virNetworkDefineXMLFlagsWrapper(c.ptr, cXml, C.uint(flags), &err)
+	if ptr == nil {
+		return nil, makeError(&err)
+	}
+	trackHandle("Network", unsafe.Pointer(ptr))
+	return &Network{ptr: ptr}, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkCreateXMLFlags
+func (c *Connect) doNetworkCreateXMLFlags(xmlConfig string, flags NetworkCreateFlags) (*Network, error) {
+	if C.LIBVIR_VERSION_NUMBER < 7008000 {
+		return nil, makeNotImplementedError("virNetworkCreateXMLFlags")
+	}
+	cXml := C.CString(string(xmlConfig))
+	defer C.free(unsafe.Pointer(cXml))
+	var err C.virError
+	ptr := C.virNetworkCreateXMLFlagsWrapper(c.ptr, cXml, C.uint(flags), &err)
+	if ptr == nil {
+		return nil, makeError(&err)
+	}
+	trackHandle("Network", unsafe.Pointer(ptr))
 	return &Network{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkLookupByName
-func (c *Connect) LookupNetworkByName(name string) (*Network, error) {
+func (c *Connect) doLookupNetworkByName(name string) (*Network, error) {
 	cName := C.CString(name)
 	defer C.free(unsafe.Pointer(cName))
 	var err C.virError
@@ -1164,11 +1209,12 @@ func (c *Connect) LookupNetworkByName(name string) (*Network, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Network", unsafe.Pointer(ptr))
 	return &Network{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkLookupByUUIDString
-func (c *Connect) LookupNetworkByUUIDString(uuid string) (*Network, error) {
+func (c *Connect) doLookupNetworkByUUIDString(uuid string) (*Network, error) {
 	cUuid := C.CString(uuid)
 	defer C.free(unsafe.Pointer(cUuid))
 	var err C.virError
@@ -1176,11 +1222,12 @@ func (c *Connect) LookupNetworkByUUIDString(uuid string) (*Network, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Network", unsafe.Pointer(ptr))
 	return &Network{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkLookupByUUID
-func (c *Connect) LookupNetworkByUUID(uuid []byte) (*Network, error) {
+func (c *Connect) doLookupNetworkByUUID(uuid []byte) (*Network, error) {
 	if len(uuid) != C.VIR_UUID_BUFLEN {
 		return nil, fmt.Errorf("UUID must be exactly %d bytes in size",
 			int(C.VIR_UUID_BUFLEN))
@@ -1194,11 +1241,12 @@ func (c *Connect) LookupNetworkByUUID(uuid []byte) (*Network, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Network", unsafe.Pointer(ptr))
 	return &Network{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectSetKeepAlive
-func (c *Connect) SetKeepAlive(interval int, count uint) error {
+func (c *Connect) doSetKeepAlive(interval int, count uint) error {
 	var err C.virError
 	res := int(C.virConnectSetKeepAliveWrapper(c.ptr, C.int(interval), C.uint(count), &err))
 	switch res {
@@ -1210,7 +1258,7 @@ func (c *Connect) SetKeepAlive(interval int, count uint) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetSysinfo
-func (c *Connect) GetSysinfo(flags uint32) (string, error) {
+func (c *Connect) doGetSysinfo(flags uint32) (string, error) {
 	var err C.virError
 	cStr := C.virConnectGetSysinfoWrapper(c.ptr, C.uint(flags), &err)
 	if cStr == nil {
@@ -1222,7 +1270,7 @@ func (c *Connect) GetSysinfo(flags uint32) (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetURI
-func (c *Connect) GetURI() (string, error) {
+func (c *Connect) doGetURI() (string, error) {
 	var err C.virError
 	cStr := C.virConnectGetURIWrapper(c.ptr, &err)
 	if cStr == nil {
@@ -1234,7 +1282,7 @@ func (c *Connect) GetURI() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetMaxVcpus
-func (c *Connect) GetMaxVcpus(typeAttr string) (int, error) {
+func (c *Connect) doGetMaxVcpus(typeAttr string) (int, error) {
 	var cTypeAttr *C.char
 	if typeAttr != "" {
 		cTypeAttr = C.CString(typeAttr)
@@ -1249,7 +1297,7 @@ func (c *Connect) GetMaxVcpus(typeAttr string) (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceDefineXML
-func (c *Connect) InterfaceDefineXML(xmlConfig string, flags uint32) (*Interface, error) {
+func (c *Connect) doInterfaceDefineXML(xmlConfig string, flags uint32) (*Interface, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1257,11 +1305,12 @@ func (c *Connect) InterfaceDefineXML(xmlConfig string, flags uint32) (*Interface
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Interface", unsafe.Pointer(ptr))
 	return &Interface{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceLookupByName
-func (c *Connect) LookupInterfaceByName(name string) (*Interface, error) {
+func (c *Connect) doLookupInterfaceByName(name string) (*Interface, error) {
 	cName := C.CString(name)
 	defer C.free(unsafe.Pointer(cName))
 	var err C.virError
@@ -1269,11 +1318,12 @@ func (c *Connect) LookupInterfaceByName(name string) (*Interface, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Interface", unsafe.Pointer(ptr))
 	return &Interface{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceLookupByMACString
-func (c *Connect) LookupInterfaceByMACString(mac string) (*Interface, error) {
+func (c *Connect) doLookupInterfaceByMACString(mac string) (*Interface, error) {
 	cName := C.CString(mac)
 	defer C.free(unsafe.Pointer(cName))
 	var err C.virError
@@ -1281,11 +1331,12 @@ func (c *Connect) LookupInterfaceByMACString(mac string) (*Interface, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Interface", unsafe.Pointer(ptr))
 	return &Interface{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolDefineXML
-func (c *Connect) StoragePoolDefineXML(xmlConfig string, flags uint32) (*StoragePool, error) {
+func (c *Connect) doStoragePoolDefineXML(xmlConfig string, flags uint32) (*StoragePool, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1293,11 +1344,12 @@ func (c *Connect) StoragePoolDefineXML(xmlConfig string, flags uint32) (*Storage
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StoragePool", unsafe.Pointer(ptr))
 	return &StoragePool{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolCreateXML
-func (c *Connect) StoragePoolCreateXML(xmlConfig string, flags StoragePoolCreateFlags) (*StoragePool, error) {
+func (c *Connect) doStoragePoolCreateXML(xmlConfig string, flags StoragePoolCreateFlags) (*StoragePool, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1305,11 +1357,12 @@ func (c *Connect) StoragePoolCreateXML(xmlConfig string, flags StoragePoolCreate
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StoragePool", unsafe.Pointer(ptr))
 	return &StoragePool{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolLookupByName
-func (c *Connect) LookupStoragePoolByName(name string) (*StoragePool, error) {
+func (c *Connect) doLookupStoragePoolByName(name string) (*StoragePool, error) {
 	cName := C.CString(name)
 	defer C.free(unsafe.Pointer(cName))
 	var err C.virError
@@ -1317,11 +1370,12 @@ func (c *Connect) LookupStoragePoolByName(name string) (*StoragePool, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StoragePool", unsafe.Pointer(ptr))
 	return &StoragePool{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolLookupByUUIDString
-func (c *Connect) LookupStoragePoolByUUIDString(uuid string) (*StoragePool, error) {
+func (c *Connect) doLookupStoragePoolByUUIDString(uuid string) (*StoragePool, error) {
 	cUuid := C.CString(uuid)
 	defer C.free(unsafe.Pointer(cUuid))
 	var err C.virError
@@ -1329,11 +1383,12 @@ func (c *Connect) LookupStoragePoolByUUIDString(uuid string) (*StoragePool, erro
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StoragePool", unsafe.Pointer(ptr))
 	return &StoragePool{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolLookupByUUID
-func (c *Connect) LookupStoragePoolByUUID(uuid []byte) (*StoragePool, error) {
+func (c *Connect) doLookupStoragePoolByUUID(uuid []byte) (*StoragePool, error) {
 	if len(uuid) != C.VIR_UUID_BUFLEN {
 		return nil, fmt.Errorf("UUID must be exactly %d bytes in size",
 			int(C.VIR_UUID_BUFLEN))
@@ -1347,11 +1402,12 @@ func (c *Connect) LookupStoragePoolByUUID(uuid []byte) (*StoragePool, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StoragePool", unsafe.Pointer(ptr))
 	return &StoragePool{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolLookupByTargetPath
-func (c *Connect) LookupStoragePoolByTargetPath(path string) (*StoragePool, error) {
+func (c *Connect) doLookupStoragePoolByTargetPath(path string) (*StoragePool, error) {
 	if C.LIBVIR_VERSION_NUMBER < 4001000 {
 		return nil, makeNotImplementedError("virStoragePoolLookupByTargetPath")
 	}
@@ -1362,11 +1418,12 @@ func (c *Connect) LookupStoragePoolByTargetPath(path string) (*StoragePool, erro
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StoragePool", unsafe.Pointer(ptr))
 	return &StoragePool{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterDefineXML
-func (c *Connect) NWFilterDefineXML(xmlConfig string) (*NWFilter, error) {
+func (c *Connect) doNWFilterDefineXML(xmlConfig string) (*NWFilter, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1374,11 +1431,12 @@ func (c *Connect) NWFilterDefineXML(xmlConfig string) (*NWFilter, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NWFilter", unsafe.Pointer(ptr))
 	return &NWFilter{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterLookupByName
-func (c *Connect) LookupNWFilterByName(name string) (*NWFilter, error) {
+func (c *Connect) doLookupNWFilterByName(name string) (*NWFilter, error) {
 	cName := C.CString(name)
 	defer C.free(unsafe.Pointer(cName))
 	var err C.virError
@@ -1386,11 +1444,12 @@ func (c *Connect) LookupNWFilterByName(name string) (*NWFilter, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NWFilter", unsafe.Pointer(ptr))
 	return &NWFilter{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterLookupByUUIDString
-func (c *Connect) LookupNWFilterByUUIDString(uuid string) (*NWFilter, error) {
+func (c *Connect) doLookupNWFilterByUUIDString(uuid string) (*NWFilter, error) {
 	cUuid := C.CString(uuid)
 	defer C.free(unsafe.Pointer(cUuid))
 	var err C.virError
@@ -1398,11 +1457,12 @@ func (c *Connect) LookupNWFilterByUUIDString(uuid string) (*NWFilter, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NWFilter", unsafe.Pointer(ptr))
 	return &NWFilter{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterLookupByUUID
-func (c *Connect) LookupNWFilterByUUID(uuid []byte) (*NWFilter, error) {
+func (c *Connect) doLookupNWFilterByUUID(uuid []byte) (*NWFilter, error) {
 	if len(uuid) != C.VIR_UUID_BUFLEN {
 		return nil, fmt.Errorf("UUID must be exactly %d bytes in size",
 			int(C.VIR_UUID_BUFLEN))
@@ -1416,11 +1476,12 @@ func (c *Connect) LookupNWFilterByUUID(uuid []byte) (*NWFilter, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NWFilter", unsafe.Pointer(ptr))
 	return &NWFilter{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterBindingLookupByPortDev
-func (c *Connect) LookupNWFilterBindingByPortDev(name string) (*NWFilterBinding, error) {
+func (c *Connect) doLookupNWFilterBindingByPortDev(name string) (*NWFilterBinding, error) {
 	if C.LIBVIR_VERSION_NUMBER < 4005000 {
 		return nil, makeNotImplementedError("virNWFilterBindingLookupByPortDev")
 	}
@@ -1431,11 +1492,12 @@ func (c *Connect) LookupNWFilterBindingByPortDev(name string) (*NWFilterBinding,
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NWFilterBinding", unsafe.Pointer(ptr))
 	return &NWFilterBinding{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolLookupByKey
-func (c *Connect) LookupStorageVolByKey(key string) (*StorageVol, error) {
+func (c *Connect) doLookupStorageVolByKey(key string) (*StorageVol, error) {
 	cKey := C.CString(key)
 	defer C.free(unsafe.Pointer(cKey))
 	var err C.virError
@@ -1443,11 +1505,12 @@ func (c *Connect) LookupStorageVolByKey(key string) (*StorageVol, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StorageVol", unsafe.Pointer(ptr))
 	return &StorageVol{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolLookupByPath
-func (c *Connect) LookupStorageVolByPath(path string) (*StorageVol, error) {
+func (c *Connect) doLookupStorageVolByPath(path string) (*StorageVol, error) {
 	cPath := C.CString(path)
 	defer C.free(unsafe.Pointer(cPath))
 	var err C.virError
@@ -1455,11 +1518,12 @@ func (c *Connect) LookupStorageVolByPath(path string) (*StorageVol, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StorageVol", unsafe.Pointer(ptr))
 	return &StorageVol{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretDefineXML
-func (c *Connect) SecretDefineXML(xmlConfig string, flags uint32) (*Secret, error) {
+func (c *Connect) doSecretDefineXML(xmlConfig string, flags uint32) (*Secret, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1467,11 +1531,12 @@ func (c *Connect) SecretDefineXML(xmlConfig string, flags uint32) (*Secret, erro
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Secret", unsafe.Pointer(ptr))
 	return &Secret{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretLookupByUUID
-func (c *Connect) LookupSecretByUUID(uuid []byte) (*Secret, error) {
+func (c *Connect) doLookupSecretByUUID(uuid []byte) (*Secret, error) {
 	if len(uuid) != C.VIR_UUID_BUFLEN {
 		return nil, fmt.Errorf("UUID must be exactly %d bytes in size",
 			int(C.VIR_UUID_BUFLEN))
@@ -1485,11 +1550,12 @@ func (c *Connect) LookupSecretByUUID(uuid []byte) (*Secret, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Secret", unsafe.Pointer(ptr))
 	return &Secret{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretLookupByUUIDString
-func (c *Connect) LookupSecretByUUIDString(uuid string) (*Secret, error) {
+func (c *Connect) doLookupSecretByUUIDString(uuid string) (*Secret, error) {
 	cUuid := C.CString(uuid)
 	defer C.free(unsafe.Pointer(cUuid))
 	var err C.virError
@@ -1497,11 +1563,12 @@ func (c *Connect) LookupSecretByUUIDString(uuid string) (*Secret, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Secret", unsafe.Pointer(ptr))
 	return &Secret{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretLookupByUsage
-func (c *Connect) LookupSecretByUsage(usageType SecretUsageType, usageID string) (*Secret, error) {
+func (c *Connect) doLookupSecretByUsage(usageType SecretUsageType, usageID string) (*Secret, error) {
 	cUsageID := C.CString(usageID)
 	defer C.free(unsafe.Pointer(cUsageID))
 	var err C.virError
@@ -1509,11 +1576,12 @@ func (c *Connect) LookupSecretByUsage(usageType SecretUsageType, usageID string)
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Secret", unsafe.Pointer(ptr))
 	return &Secret{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceLookupByName
-func (c *Connect) LookupDeviceByName(id string) (*NodeDevice, error) {
+func (c *Connect) doLookupDeviceByName(id string) (*NodeDevice, error) {
 	cName := C.CString(id)
 	defer C.free(unsafe.Pointer(cName))
 	var err C.virError
@@ -1521,11 +1589,12 @@ func (c *Connect) LookupDeviceByName(id string) (*NodeDevice, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NodeDevice", unsafe.Pointer(ptr))
 	return &NodeDevice{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceLookupSCSIHostByWWN
-func (c *Connect) LookupDeviceSCSIHostByWWN(wwnn, wwpn string, flags uint32) (*NodeDevice, error) {
+func (c *Connect) doLookupDeviceSCSIHostByWWN(wwnn, wwpn string, flags uint32) (*NodeDevice, error) {
 	cWwnn := C.CString(wwnn)
 	cWwpn := C.CString(wwpn)
 	defer C.free(unsafe.Pointer(cWwnn))
@@ -1535,11 +1604,12 @@ func (c *Connect) LookupDeviceSCSIHostByWWN(wwnn, wwpn string, flags uint32) (*N
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NodeDevice", unsafe.Pointer(ptr))
 	return &NodeDevice{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceCreateXML
-func (c *Connect) DeviceCreateXML(xmlConfig string, flags uint32) (*NodeDevice, error) {
+func (c *Connect) doDeviceCreateXML(xmlConfig string, flags uint32) (*NodeDevice, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1547,11 +1617,12 @@ func (c *Connect) DeviceCreateXML(xmlConfig string, flags uint32) (*NodeDevice,
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NodeDevice", unsafe.Pointer(ptr))
 	return &NodeDevice{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virConnectListAllInterfaces
-func (c *Connect) ListAllInterfaces(flags ConnectListAllInterfacesFlags) ([]Interface, error) {
+func (c *Connect) doListAllInterfaces(flags ConnectListAllInterfacesFlags) ([]Interface, error) {
 	var cList *C.virInterfacePtr
 	var err C.virError
 	numIfaces := C.virConnectListAllInterfacesWrapper(c.ptr, (**C.virInterfacePtr)(&cList), C.uint(flags), &err)
@@ -1566,6 +1637,7 @@ func (c *Connect) ListAllInterfaces(flags ConnectListAllInterfacesFlags) ([]Inte
 	var ifaces []Interface
 	slice := *(*[]C.virInterfacePtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("Interface", unsafe.Pointer(ptr))
 		ifaces = append(ifaces, Interface{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -1573,7 +1645,7 @@ func (c *Connect) ListAllInterfaces(flags ConnectListAllInterfacesFlags) ([]Inte
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virConnectListAllNetworks
-func (c *Connect) ListAllNetworks(flags ConnectListAllNetworksFlags) ([]Network, error) {
+func (c *Connect) doListAllNetworks(flags ConnectListAllNetworksFlags) ([]Network, error) {
 	var cList *C.virNetworkPtr
 	var err C.virError
 	numNets := C.virConnectListAllNetworksWrapper(c.ptr, (**C.virNetworkPtr)(&cList), C.uint(flags), &err)
@@ -1588,6 +1660,7 @@ func (c *Connect) ListAllNetworks(flags ConnectListAllNetworksFlags) ([]Network,
 	var nets []Network
 	slice := *(*[]C.virNetworkPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("Network", unsafe.Pointer(ptr))
 		nets = append(nets, Network{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -1595,7 +1668,7 @@ func (c *Connect) ListAllNetworks(flags ConnectListAllNetworksFlags) ([]Network,
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectListAllDomains
-func (c *Connect) ListAllDomains(flags ConnectListAllDomainsFlags) ([]Domain, error) {
+func (c *Connect) doListAllDomains(flags ConnectListAllDomainsFlags) ([]Domain, error) {
 	var cList *C.virDomainPtr
 	var err C.virError
 	numDomains := C.virConnectListAllDomainsWrapper(c.ptr, (**C.virDomainPtr)(&cList), C.uint(flags), &err)
@@ -1610,6 +1683,7 @@ func (c *Connect) ListAllDomains(flags ConnectListAllDomainsFlags) ([]Domain, er
 	var domains []Domain
 	slice := *(*[]C.virDomainPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("Domain", unsafe.Pointer(ptr))
 		domains = append(domains, Domain{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -1617,7 +1691,7 @@ func (c *Connect) ListAllDomains(flags ConnectListAllDomainsFlags) ([]Domain, er
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virConnectListAllNWFilters
-func (c *Connect) ListAllNWFilters(flags uint32) ([]NWFilter, error) {
+func (c *Connect) doListAllNWFilters(flags uint32) ([]NWFilter, error) {
 	var cList *C.virNWFilterPtr
 	var err C.virError
 	numNWFilters := C.virConnectListAllNWFiltersWrapper(c.ptr, (**C.virNWFilterPtr)(&cList), C.uint(flags), &err)
@@ -1632,6 +1706,7 @@ func (c *Connect) ListAllNWFilters(flags uint32) ([]NWFilter, error) {
 	var filters []NWFilter
 	slice := *(*[]C.virNWFilterPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("NWFilter", unsafe.Pointer(ptr))
 		filters = append(filters, NWFilter{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -1639,7 +1714,7 @@ func (c *Connect) ListAllNWFilters(flags uint32) ([]NWFilter, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virConnectListAllNWFilterBindings
-func (c *Connect) ListAllNWFilterBindings(flags uint32) ([]NWFilterBinding, error) {
+func (c *Connect) doListAllNWFilterBindings(flags uint32) ([]NWFilterBinding, error) {
 	var cList *C.virNWFilterBindingPtr
 	if C.LIBVIR_VERSION_NUMBER < 4005000 {
 		return []NWFilterBinding{}, makeNotImplementedError("virConnectListAllNWFilterBindings")
@@ -1657,6 +1732,7 @@ func (c *Connect) ListAllNWFilterBindings(flags uint32) ([]NWFilterBinding, erro
 	var filters []NWFilterBinding
 	slice := *(*[]C.virNWFilterBindingPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("NWFilterBinding", unsafe.Pointer(ptr))
 		filters = append(filters, NWFilterBinding{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -1664,7 +1740,7 @@ func (c *Connect) ListAllNWFilterBindings(flags uint32) ([]NWFilterBinding, erro
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virConnectListAllStoragePools
-func (c *Connect) ListAllStoragePools(flags ConnectListAllStoragePoolsFlags) ([]StoragePool, error) {
+func (c *Connect) doListAllStoragePools(flags ConnectListAllStoragePoolsFlags) ([]StoragePool, error) {
 	var cList *C.virStoragePoolPtr
 	var err C.virError
 	numPools := C.virConnectListAllStoragePoolsWrapper(c.ptr, (**C.virStoragePoolPtr)(&cList), C.uint(flags), &err)
@@ -1679,6 +1755,7 @@ func (c *Connect) ListAllStoragePools(flags ConnectListAllStoragePoolsFlags) ([]
 	var pools []StoragePool
 	slice := *(*[]C.virStoragePoolPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("StoragePool", unsafe.Pointer(ptr))
 		pools = append(pools, StoragePool{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -1686,7 +1763,7 @@ func (c *Connect) ListAllStoragePools(flags ConnectListAllStoragePoolsFlags) ([]
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virConnectListAllSecrets
-func (c *Connect) ListAllSecrets(flags ConnectListAllSecretsFlags) ([]Secret, error) {
+func (c *Connect) doListAllSecrets(flags ConnectListAllSecretsFlags) ([]Secret, error) {
 	var cList *C.virSecretPtr
 	var err C.virError
 	numPools := C.virConnectListAllSecretsWrapper(c.ptr, (**C.virSecretPtr)(&cList), C.uint(flags), &err)
@@ -1701,6 +1778,7 @@ func (c *Connect) ListAllSecrets(flags ConnectListAllSecretsFlags) ([]Secret, er
 	var pools []Secret
 	slice := *(*[]C.virSecretPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("Secret", unsafe.Pointer(ptr))
 		pools = append(pools, Secret{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -1708,7 +1786,7 @@ func (c *Connect) ListAllSecrets(flags ConnectListAllSecretsFlags) ([]Secret, er
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virConnectListAllNodeDevices
-func (c *Connect) ListAllNodeDevices(flags ConnectListAllNodeDeviceFlags) ([]NodeDevice, error) {
+func (c *Connect) doListAllNodeDevices(flags ConnectListAllNodeDeviceFlags) ([]NodeDevice, error) {
 	var cList *C.virNodeDevicePtr
 	var err C.virError
 	numPools := C.virConnectListAllNodeDevicesWrapper(c.ptr, (**C.virNodeDevicePtr)(&cList), C.uint(flags), &err)
@@ -1723,6 +1801,7 @@ func (c *Connect) ListAllNodeDevices(flags ConnectListAllNodeDeviceFlags) ([]Nod
 	var pools []NodeDevice
 	slice := *(*[]C.virNodeDevicePtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("NodeDevice", unsafe.Pointer(ptr))
 		pools = append(pools, NodeDevice{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -1730,7 +1809,7 @@ func (c *Connect) ListAllNodeDevices(flags ConnectListAllNodeDeviceFlags) ([]Nod
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceChangeBegin
-func (c *Connect) InterfaceChangeBegin(flags uint32) error {
+func (c *Connect) doInterfaceChangeBegin(flags uint32) error {
 	var err C.virError
 	ret := C.virInterfaceChangeBeginWrapper(c.ptr, C.uint(flags), &err)
 	if ret == -1 {
@@ -1740,7 +1819,7 @@ func (c *Connect) InterfaceChangeBegin(flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceChangeCommit
-func (c *Connect) InterfaceChangeCommit(flags uint32) error {
+func (c *Connect) doInterfaceChangeCommit(flags uint32) error {
 	var err C.virError
 	ret := C.virInterfaceChangeCommitWrapper(c.ptr, C.uint(flags), &err)
 	if ret == -1 {
@@ -1750,7 +1829,7 @@ func (c *Connect) InterfaceChangeCommit(flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceChangeRollback
-func (c *Connect) InterfaceChangeRollback(flags uint32) error {
+func (c *Connect) doInterfaceChangeRollback(flags uint32) error {
 	var err C.virError
 	ret := C.virInterfaceChangeRollbackWrapper(c.ptr, C.uint(flags), &err)
 	if ret == -1 {
@@ -1760,7 +1839,7 @@ func (c *Connect) InterfaceChangeRollback(flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeAllocPages
-func (c *Connect) AllocPages(pageSizes map[int]int64, startCell int, cellCount uint, flags NodeAllocPagesFlags) (int, error) {
+func (c *Connect) doAllocPages(pageSizes map[int]int64, startCell int, cellCount uint, flags NodeAllocPagesFlags) (int, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002009 {
 		return 0, makeNotImplementedError("virNodeAllocPages")
 	}
@@ -1785,7 +1864,7 @@ func (c *Connect) AllocPages(pageSizes map[int]int64, startCell int, cellCount u
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeGetCPUMap
-func (c *Connect) GetCPUMap(flags uint32) (map[int]bool, uint, error) {
+func (c *Connect) doGetCPUMap(flags uint32) (map[int]bool, uint, error) {
 	var ccpumap *C.uchar
 	var conline C.uint
 	var err C.virError
@@ -1824,7 +1903,7 @@ type NodeCPUStats struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeGetCPUStats
-func (c *Connect) GetCPUStats(cpuNum int, flags uint32) (*NodeCPUStats, error) {
+func (c *Connect) doGetCPUStats(cpuNum int, flags uint32) (*NodeCPUStats, error) {
 	var cnparams C.int
 
 	var err C.virError
@@ -1869,7 +1948,7 @@ func (c *Connect) GetCPUStats(cpuNum int, flags uint32) (*NodeCPUStats, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeGetCellsFreeMemory
-func (c *Connect) GetCellsFreeMemory(startCell int, maxCells int) ([]uint64, error) {
+func (c *Connect) doGetCellsFreeMemory(startCell int, maxCells int) ([]uint64, error) {
 	cmem := make([]C.ulonglong, maxCells)
 	var err C.virError
 	ret := C.virNodeGetCellsFreeMemoryWrapper(c.ptr, (*C.ulonglong)(unsafe.Pointer(&cmem[0])), C.int(startCell), C.int(maxCells), &err)
@@ -1886,7 +1965,7 @@ func (c *Connect) GetCellsFreeMemory(startCell int, maxCells int) ([]uint64, err
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeGetFreeMemory
-func (c *Connect) GetFreeMemory() (uint64, error) {
+func (c *Connect) doGetFreeMemory() (uint64, error) {
 	var err C.virError
 	ret := C.virNodeGetFreeMemoryWrapper(c.ptr, &err)
 	if ret == 0 {
@@ -1897,7 +1976,7 @@ func (c *Connect) GetFreeMemory() (uint64, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeGetFreePages
-func (c *Connect) GetFreePages(pageSizes []uint64, startCell int, maxCells uint, flags uint32) ([]uint64, error) {
+func (c *Connect) doGetFreePages(pageSizes []uint64, startCell int, maxCells uint, flags uint32) ([]uint64, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002006 {
 		return []uint64{}, makeNotImplementedError("virNodeGetFreePages")
 	}
@@ -1980,7 +2059,7 @@ func getMemoryParameterFieldInfo(params *NodeMemoryParameters) map[string]typedP
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeGetMemoryParameters
-func (c *Connect) GetMemoryParameters(flags uint32) (*NodeMemoryParameters, error) {
+func (c *Connect) doGetMemoryParameters(flags uint32) (*NodeMemoryParameters, error) {
 	params := &NodeMemoryParameters{}
 	info := getMemoryParameterFieldInfo(params)
 
@@ -1993,7 +2072,7 @@ func (c *Connect) GetMemoryParameters(flags uint32) (*NodeMemoryParameters, erro
 	}
 
 	cparams := typedParamsNew(cnparams)
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 	ret = C.virNodeGetMemoryParametersWrapper(c.ptr, cparams, &cnparams, C.uint(flags), &err)
 	if ret == -1 {
 		return nil, makeError(&err)
@@ -2019,7 +2098,7 @@ type NodeMemoryStats struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeGetMemoryStats
-func (c *Connect) GetMemoryStats(cellNum int, flags uint32) (*NodeMemoryStats, error) {
+func (c *Connect) doGetMemoryStats(cellNum int, flags uint32) (*NodeMemoryStats, error) {
 	var cnparams C.int
 
 	var err C.virError
@@ -2063,7 +2142,7 @@ type NodeSecurityModel struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeGetSecurityModel
-func (c *Connect) GetSecurityModel() (*NodeSecurityModel, error) {
+func (c *Connect) doGetSecurityModel() (*NodeSecurityModel, error) {
 	var cmodel C.virSecurityModel
 	var err C.virError
 	ret := C.virNodeGetSecurityModelWrapper(c.ptr, &cmodel, &err)
@@ -2078,7 +2157,7 @@ func (c *Connect) GetSecurityModel() (*NodeSecurityModel, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeSetMemoryParameters
-func (c *Connect) SetMemoryParameters(params *NodeMemoryParameters, flags uint32) error {
+func (c *Connect) doSetMemoryParameters(params *NodeMemoryParameters, flags uint32) error {
 	info := getMemoryParameterFieldInfo(params)
 
 	cparams, cnparams, gerr := typedParamsPackNew(info)
@@ -2086,7 +2165,7 @@ func (c *Connect) SetMemoryParameters(params *NodeMemoryParameters, flags uint32
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virNodeSetMemoryParametersWrapper(c.ptr, cparams, cnparams, C.uint(flags), &err)
@@ -2098,7 +2177,7 @@ func (c *Connect) SetMemoryParameters(params *NodeMemoryParameters, flags uint32
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeSuspendForDuration
-func (c *Connect) SuspendForDuration(target NodeSuspendTarget, duration uint64, flags uint32) error {
+func (c *Connect) doSuspendForDuration(target NodeSuspendTarget, duration uint64, flags uint32) error {
 	var err C.virError
 	ret := C.virNodeSuspendForDurationWrapper(c.ptr, C.uint(target), C.ulonglong(duration), C.uint(flags), &err)
 	if ret == -1 {
@@ -2108,7 +2187,7 @@ func (c *Connect) SuspendForDuration(target NodeSuspendTarget, duration uint64,
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSaveImageDefineXML
-func (c *Connect) DomainSaveImageDefineXML(file string, xml string, flags DomainSaveRestoreFlags) error {
+func (c *Connect) doDomainSaveImageDefineXML(file string, xml string, flags DomainSaveRestoreFlags) error {
 	cfile := C.CString(file)
 	defer C.free(unsafe.Pointer(cfile))
 	cxml := C.CString(xml)
@@ -2125,7 +2204,7 @@ func (c *Connect) DomainSaveImageDefineXML(file string, xml string, flags Domain
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSaveImageGetXMLDesc
-func (c *Connect) DomainSaveImageGetXMLDesc(file string, flags DomainSaveImageXMLFlags) (string, error) {
+func (c *Connect) doDomainSaveImageGetXMLDesc(file string, flags DomainSaveImageXMLFlags) (string, error) {
 	cfile := C.CString(file)
 	defer C.free(unsafe.Pointer(cfile))
 
@@ -2142,7 +2221,7 @@ func (c *Connect) DomainSaveImageGetXMLDesc(file string, flags DomainSaveImageXM
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectBaselineCPU
-func (c *Connect) BaselineCPU(xmlCPUs []string, flags ConnectBaselineCPUFlags) (string, error) {
+func (c *Connect) doBaselineCPU(xmlCPUs []string, flags ConnectBaselineCPUFlags) (string, error) {
 	cxmlCPUs := make([]*C.char, len(xmlCPUs))
 	for i := 0; i < len(xmlCPUs); i++ {
 		cxmlCPUs[i] = C.CString(xmlCPUs[i])
@@ -2161,7 +2240,7 @@ func (c *Connect) BaselineCPU(xmlCPUs []string, flags ConnectBaselineCPUFlags) (
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectBaselineHypervisorCPU
-func (c *Connect) BaselineHypervisorCPU(emulator string, arch string, machine string, virttype string, xmlCPUs []string, flags ConnectBaselineCPUFlags) (string, error) {
+func (c *Connect) doBaselineHypervisorCPU(emulator string, arch string, machine string, virttype string, xmlCPUs []string, flags ConnectBaselineCPUFlags) (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 4004000 {
 		return "", makeNotImplementedError("virConnectBaselineHypervisorCPU")
 	}
@@ -2202,7 +2281,7 @@ func (c *Connect) BaselineHypervisorCPU(emulator string, arch string, machine st
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectCompareCPU
-func (c *Connect) CompareCPU(xmlDesc string, flags ConnectCompareCPUFlags) (CPUCompareResult, error) {
+func (c *Connect) doCompareCPU(xmlDesc string, flags ConnectCompareCPUFlags) (CPUCompareResult, error) {
 	cxmlDesc := C.CString(xmlDesc)
 	defer C.free(unsafe.Pointer(cxmlDesc))
 
@@ -2216,7 +2295,7 @@ func (c *Connect) CompareCPU(xmlDesc string, flags ConnectCompareCPUFlags) (CPUC
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectCompareHypervisorCPU
-func (c *Connect) CompareHypervisorCPU(emulator string, arch string, machine string, virttype string, xmlDesc string, flags ConnectCompareCPUFlags) (CPUCompareResult, error) {
+func (c *Connect) doCompareHypervisorCPU(emulator string, arch string, machine string, virttype string, xmlDesc string, flags ConnectCompareCPUFlags) (CPUCompareResult, error) {
 	if C.LIBVIR_VERSION_NUMBER < 4004000 {
 		return CPU_COMPARE_ERROR, makeNotImplementedError("virConnectCompareHypervisorCPU")
 	}
@@ -2252,7 +2331,7 @@ func (c *Connect) CompareHypervisorCPU(emulator string, arch string, machine str
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectDomainXMLFromNative
-func (c *Connect) DomainXMLFromNative(nativeFormat string, nativeConfig string, flags uint32) (string, error) {
+func (c *Connect) doDomainXMLFromNative(nativeFormat string, nativeConfig string, flags uint32) (string, error) {
 	cnativeFormat := C.CString(nativeFormat)
 	defer C.free(unsafe.Pointer(cnativeFormat))
 	cnativeConfig := C.CString(nativeConfig)
@@ -2270,7 +2349,7 @@ func (c *Connect) DomainXMLFromNative(nativeFormat string, nativeConfig string,
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectDomainXMLToNative
-func (c *Connect) DomainXMLToNative(nativeFormat string, domainXml string, flags uint32) (string, error) {
+func (c *Connect) doDomainXMLToNative(nativeFormat string, domainXml string, flags uint32) (string, error) {
 	cnativeFormat := C.CString(nativeFormat)
 	defer C.free(unsafe.Pointer(cnativeFormat))
 	cdomainXml := C.CString(domainXml)
@@ -2288,7 +2367,7 @@ func (c *Connect) DomainXMLToNative(nativeFormat string, domainXml string, flags
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetCPUModelNames
-func (c *Connect) GetCPUModelNames(arch string, flags uint32) ([]string, error) {
+func (c *Connect) doGetCPUModelNames(arch string, flags uint32) ([]string, error) {
 	carch := C.CString(arch)
 	defer C.free(unsafe.Pointer(carch))
 
@@ -2312,7 +2391,7 @@ func (c *Connect) GetCPUModelNames(arch string, flags uint32) ([]string, error)
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectGetDomainCapabilities
-func (c *Connect) GetDomainCapabilities(emulatorbin string, arch string, machine string, virttype string, flags uint32) (string, error) {
+func (c *Connect) doGetDomainCapabilities(emulatorbin string, arch string, machine string, virttype string, flags uint32) (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002007 {
 		return "", makeNotImplementedError("virConnectGetDomainCapabilities")
 	}
@@ -2349,7 +2428,7 @@ func (c *Connect) GetDomainCapabilities(emulatorbin string, arch string, machine
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetVersion
-func (c *Connect) GetVersion() (uint32, error) {
+func (c *Connect) doGetVersion() (uint32, error) {
 	var hvVer C.ulong
 	var err C.virError
 	ret := C.virConnectGetVersionWrapper(c.ptr, &hvVer, &err)
@@ -2361,7 +2440,7 @@ func (c *Connect) GetVersion() (uint32, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virConnectFindStoragePoolSources
-func (c *Connect) FindStoragePoolSources(pooltype string, srcSpec string, flags uint32) (string, error) {
+func (c *Connect) doFindStoragePoolSources(pooltype string, srcSpec string, flags uint32) (string, error) {
 	cpooltype := C.CString(pooltype)
 	defer C.free(unsafe.Pointer(cpooltype))
 	var csrcSpec *C.char
@@ -2381,7 +2460,7 @@ func (c *Connect) FindStoragePoolSources(pooltype string, srcSpec string, flags
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainRestore
-func (c *Connect) DomainRestore(srcFile string) error {
+func (c *Connect) doDomainRestore(srcFile string) error {
 	cPath := C.CString(srcFile)
 	defer C.free(unsafe.Pointer(cPath))
 	var err C.virError
@@ -2392,7 +2471,7 @@ func (c *Connect) DomainRestore(srcFile string) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainRestoreFlags
-func (c *Connect) DomainRestoreFlags(srcFile, xmlConf string, flags DomainSaveRestoreFlags) error {
+func (c *Connect) doDomainRestoreFlags(srcFile, xmlConf string, flags DomainSaveRestoreFlags) error {
 	cPath := C.CString(srcFile)
 	defer C.free(unsafe.Pointer(cPath))
 	var cXmlConf *C.char
@@ -2408,13 +2487,14 @@ func (c *Connect) DomainRestoreFlags(srcFile, xmlConf string, flags DomainSaveRe
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamNew
-func (c *Connect) NewStream(flags StreamFlags) (*Stream, error) {
+func (c *Connect) doNewStream(flags StreamFlags) (*Stream, error) {
 	var err C.virError
 	virStream := C.virStreamNewWrapper(c.ptr, C.uint(flags), &err)
 	if virStream == nil {
 		return nil, makeError(&err)
 	}
 
+	trackHandle("Stream", unsafe.Pointer(virStream))
 	return &Stream{
 		ptr: virStream,
 	}, nil
@@ -2922,7 +3002,7 @@ func getDomainStatsLengthsFieldInfo(params *domainStatsLengths) map[string]typed
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectGetAllDomainStats
-func (c *Connect) GetAllDomainStats(doms []*Domain, statsTypes DomainStatsTypes, flags ConnectGetAllDomainStatsFlags) ([]DomainStats, error) {
+func (c *Connect) doGetAllDomainStats(doms []*Domain, statsTypes DomainStatsTypes, flags ConnectGetAllDomainStatsFlags) ([]DomainStats, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002008 {
 		return []DomainStats{}, makeNotImplementedError("virConnectGetAllDomainStats")
 	}
@@ -3108,7 +3188,9 @@ func (c *Connect) GetAllDomainStats(doms []*Domain, statsTypes DomainStatsTypes,
 	}
 
 	for i := 0; i < len(stats); i++ {
-		C.virDomainRef(stats[i].Domain.ptr)
+		var err C.virError
+		C.virDomainRefWrapper(stats[i].Domain.ptr, &err)
+		trackHandle("Domain", unsafe.Pointer(stats[i].Domain.ptr))
 	}
 
 	return stats, nil
@@ -3147,7 +3229,7 @@ func getNodeSEVFieldInfo(params *NodeSEVParameters) map[string]typedParamsFieldI
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-host.html#virNodeGetSEVInfo
-func (c *Connect) GetSEVInfo(flags uint32) (*NodeSEVParameters, error) {
+func (c *Connect) doGetSEVInfo(flags uint32) (*NodeSEVParameters, error) {
 	if C.LIBVIR_VERSION_NUMBER < 4005000 {
 		return nil, makeNotImplementedError("virNodeGetSEVInfo")
 	}
@@ -3164,7 +3246,7 @@ func (c *Connect) GetSEVInfo(flags uint32) (*NodeSEVParameters, error) {
 		return nil, makeError(&err)
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	_, gerr := typedParamsUnpack(cparams, cnparams, info)
 	if gerr != nil {
@@ -3175,7 +3257,7 @@ func (c *Connect) GetSEVInfo(flags uint32) (*NodeSEVParameters, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virNWFilterBindingCreateXML
-func (c *Connect) NWFilterBindingCreateXML(xmlConfig string, flags uint32) (*NWFilterBinding, error) {
+func (c *Connect) doNWFilterBindingCreateXML(xmlConfig string, flags uint32) (*NWFilterBinding, error) {
 	if C.LIBVIR_VERSION_NUMBER < 4005000 {
 		return nil, makeNotImplementedError("virNWFilterBindingCreateXML")
 	}
@@ -3186,11 +3268,12 @@ func (c *Connect) NWFilterBindingCreateXML(xmlConfig string, flags uint32) (*NWF
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NWFilterBinding", unsafe.Pointer(ptr))
 	return &NWFilterBinding{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virConnectGetStoragePoolCapabilities
-func (c *Connect) GetStoragePoolCapabilities(flags uint32) (string, error) {
+func (c *Connect) doGetStoragePoolCapabilities(flags uint32) (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5002000 {
 		return "", makeNotImplementedError("virConnectGetStoragePoolCapabilities")
 	}
@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <assert.h>
 #include <stdio.h>
 #include "connect_wrapper.h"
@@ -1073,6 +1073,17 @@ virGetVersionWrapper(unsigned long *libVer,
 }
 
 
+int
+virInitializeWrapper(virErrorPtr err)
+{
+    int ret = virInitialize();
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+}
+
+
 int
 virInterfaceChangeBeginWrapper(virConnectPtr conn,
                                unsigned int flags,
@@ -1252,6 +1263,24 @@ virNetworkCreateXMLWrapper(virConnectPtr conn,
 }
 
 
+virNetworkPtr
+virNetworkCreateXMLFlagsWrapper(virConnectPtr conn,
+                                const char *xmlDesc,
+                                unsigned int flags,
+                                virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 7008000
+    assert(0); // Caller should have checked version
+#else
+    virNetworkPtr ret = virNetworkCreateXMLFlags(conn, xmlDesc, flags);
+    if (!ret) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
 virNetworkPtr
 virNetworkDefineXMLWrapper(virConnectPtr conn,
                            const char *xml,
@@ -1265,6 +1294,24 @@ virNetworkDefineXMLWrapper(virConnectPtr conn,
 }
 
 
+virNetworkPtr
+virNetworkDefineXMLFlagsWrapper(virConnectPtr conn,
+                                const char *xml,
+                                unsigned int flags,
+                                virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 7008000
+    assert(0); // Caller should have checked version
+#else
+    virNetworkPtr ret = virNetworkDefineXMLFlags(conn, xml, flags);
+    if (!ret) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
 virNetworkPtr
 virNetworkLookupByNameWrapper(virConnectPtr conn,
                               const char *name,
@@ -29,6 +29,7 @@
 
 #include <libvirt/libvirt.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 #include "connect_compat.h"
 
 void
@@ -446,6 +447,9 @@ virGetVersionWrapper(unsigned long *libVer,
                      unsigned long *typeVer,
                      virErrorPtr err);
 
+int
+virInitializeWrapper(virErrorPtr err);
+
 int
 virInterfaceChangeBeginWrapper(virConnectPtr conn,
                                unsigned int flags,
@@ -513,11 +517,23 @@ virNetworkCreateXMLWrapper(virConnectPtr conn,
                            const char *xmlDesc,
                            virErrorPtr err);
 
+virNetworkPtr
+virNetworkCreateXMLFlagsWrapper(virConnectPtr conn,
+                                const char *xmlDesc,
+                                unsigned int flags,
+                                virErrorPtr err);
+
 virNetworkPtr
 virNetworkDefineXMLWrapper(virConnectPtr conn,
                            const char *xml,
                            virErrorPtr err);
 
+virNetworkPtr
+virNetworkDefineXMLFlagsWrapper(virConnectPtr conn,
+                                const char *xml,
+                                unsigned int flags,
+                                virErrorPtr err);
+
 virNetworkPtr
 virNetworkLookupByNameWrapper(virConnectPtr conn,
                               const char *name,
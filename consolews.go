@@ -0,0 +1,648 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ConsoleWebSocketConfig controls how a ConsoleWebSocketHandler picks
+// and opens the console of a domain, and who may type on it.
+type ConsoleWebSocketConfig struct {
+	// Returns the domain to connect a request to, which the handler
+	// frees, and the name of the console, serial port or channel to
+	// open, or "" for the first console. Defaults to the domain with
+	// the UUID in the 'uuid' query parameter, and the device in the
+	// optional 'dev' query parameter
+	Lookup func(r *http.Request) (*Domain, string, error)
+
+	// Decides whether the request may connect, and whether it may
+	// type on the console or only watch it, before the WebSocket
+	// handshake. An error rejects the request with 403 Forbidden.
+	// Defaults to allowing everyone to type. Where browsers can reach
+	// the handler, this should check the Origin header
+	Authorize func(r *http.Request, dom *Domain) (readOnly bool, err error)
+
+	// Flags for OpenConsole. DOMAIN_CONSOLE_FORCE takes the console
+	// over from any other client, such as virsh console
+	Flags DomainConsoleFlags
+
+	// Called when the writer reports the size of its terminal. A serial
+	// console has no way to pass this on to the guest, so it is left
+	// to the caller, eg to run stty through the guest agent
+	Resize func(dom *Domain, cols, rows int)
+
+	// Connections with no input or output for this long are closed.
+	// Zero disables the timeout
+	IdleTimeout time.Duration
+
+	// Number of bytes of recent output sent to clients as they
+	// connect. Defaults to 64 KiB
+	HistorySize int
+}
+
+// ConsoleWebSocketHandler is an http.Handler which connects WebSocket
+// clients, such as xterm.js, to the text consoles of domains.
+//
+// Output from the console is sent in binary messages. Binary messages
+// from the client are typed on the console, while text messages hold
+// JSON control messages, of which the only one is
+//
+//	{"type": "resize", "cols": 80, "rows": 24}
+//
+// Each console is opened once, however many clients are connected to
+// it. The first client allowed to type becomes the writer, and while
+// it stays connected, the others can only watch. When the writer
+// disconnects, the longest connected client allowed to type takes
+// over, and is sent the text message
+//
+//	{"type": "writer"}
+//
+// The console is closed when the last client disconnects.
+//
+// The console is read and written with stream events, so an event loop
+// implementation must be registered before the connection is opened,
+// and must be running.
+type ConsoleWebSocketHandler struct {
+	conn   *Connect
+	config ConsoleWebSocketConfig
+
+	lock     sync.Mutex
+	sessions map[string]*consoleSession
+}
+
+// Limits on messages from clients, and on output queued for a client
+// before it is treated as too slow and disconnected
+const (
+	consoleMaxMessage   = 64 * 1024
+	consoleMaxPending   = 64 * 1024
+	consoleViewerQueue  = 256
+	consoleReadSize     = 4096
+	consoleHistoryLimit = 64 * 1024
+)
+
+// Sent to a viewer which becomes the writer
+const consoleWriterNotice = `{"type":"writer"}`
+
+// NewConsoleWebSocketHandler creates a handler for the domains of
+// conn. The config may be nil to use the defaults.
+func NewConsoleWebSocketHandler(conn *Connect, config *ConsoleWebSocketConfig) *ConsoleWebSocketHandler {
+	h := &ConsoleWebSocketHandler{
+		conn:     conn,
+		sessions: make(map[string]*consoleSession),
+	}
+	if config != nil {
+		h.config = *config
+	}
+	if h.config.Lookup == nil {
+		h.config.Lookup = h.lookup
+	}
+	if h.config.HistorySize == 0 {
+		h.config.HistorySize = consoleHistoryLimit
+	}
+	return h
+}
+
+func (h *ConsoleWebSocketHandler) lookup(r *http.Request) (*Domain, string, error) {
+	query := r.URL.Query()
+	dom, err := h.conn.LookupDomainByUUIDString(query.Get("uuid"))
+	if err != nil {
+		return nil, "", err
+	}
+	return dom, query.Get("dev"), nil
+}
+
+func (h *ConsoleWebSocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !wsIsUpgrade(r) {
+		http.Error(w, "Expected a WebSocket request", http.StatusBadRequest)
+		return
+	}
+
+	dom, devname, err := h.config.Lookup(r)
+	if err != nil {
+		webSocketLookupError(w, err)
+		return
+	}
+	defer dom.Free()
+
+	readOnly := false
+	if h.config.Authorize != nil {
+		if readOnly, err = h.config.Authorize(r, dom); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+	}
+
+	viewer := &consoleViewer{
+		readOnly: readOnly,
+		out:      make(chan []byte, consoleViewerQueue),
+		active:   make(chan struct{}, 1),
+		promoted: make(chan struct{}, 1),
+	}
+	session, err := h.attach(dom, devname, viewer)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	ws, err := wsUpgrade(w, r, nil)
+	if err != nil {
+		session.removeViewer(viewer)
+		return
+	}
+	viewer.ws = ws
+	viewer.run(session, h.config.IdleTimeout)
+}
+
+// Adds a viewer to the session for the console, opening the console if
+// there is no session yet
+func (h *ConsoleWebSocketHandler) attach(dom *Domain, devname string, viewer *consoleViewer) (*consoleSession, error) {
+	uuid, err := dom.GetUUIDString()
+	if err != nil {
+		return nil, err
+	}
+	key := uuid + "/" + devname
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if session := h.sessions[key]; session != nil && session.addViewer(viewer) {
+		return session, nil
+	}
+	session, err := openConsoleSession(h, key, dom, devname)
+	if err != nil {
+		return nil, err
+	}
+	h.sessions[key] = session
+	session.addViewer(viewer)
+	return session, nil
+}
+
+func (h *ConsoleWebSocketHandler) detach(session *consoleSession) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	if h.sessions[session.key] == session {
+		delete(h.sessions, session.key)
+	}
+}
+
+// The last output of a console, up to a fixed size
+type consoleHistory struct {
+	data  []byte
+	limit int
+}
+
+func (h *consoleHistory) write(p []byte) {
+	if len(p) >= h.limit {
+		h.data = append(h.data[:0], p[len(p)-h.limit:]...)
+		return
+	}
+	if drop := len(h.data) + len(p) - h.limit; drop > 0 {
+		h.data = append(h.data[:0], h.data[drop:]...)
+	}
+	h.data = append(h.data, p...)
+}
+
+func (h *consoleHistory) snapshot() []byte {
+	return append([]byte(nil), h.data...)
+}
+
+// The parts of a Stream used by a consoleSession
+type consoleStream interface {
+	Recv(p []byte) (int, error)
+	Send(p []byte) (int, error)
+	EventUpdateCallback(events StreamEventType) error
+	EventRemoveCallback() error
+	Abort() error
+	Free() error
+}
+
+// A console opened for one or more viewers
+type consoleSession struct {
+	handler *ConsoleWebSocketHandler
+	key     string
+	dom     *Domain
+	stream  consoleStream
+
+	lock    sync.Mutex
+	closed  bool
+	viewers map[*consoleViewer]bool
+	writer  *consoleViewer
+	// Number of viewers added so far, to order them
+	joined  uint64
+	history consoleHistory
+	pending []byte
+
+	// Serialises use of the stream between the event callback and
+	// other goroutines, since it is freed once the session is closed
+	streamLock sync.Mutex
+	released   bool
+	// Whether writable events were last asked for
+	writable bool
+}
+
+const consoleStreamEvents = STREAM_EVENT_READABLE | STREAM_EVENT_ERROR | STREAM_EVENT_HANGUP
+
+func openConsoleSession(h *ConsoleWebSocketHandler, key string, dom *Domain, devname string) (*consoleSession, error) {
+	stream, err := h.conn.NewStream(STREAM_NONBLOCK)
+	if err != nil {
+		return nil, err
+	}
+	if err := dom.OpenConsole(devname, stream, h.config.Flags); err != nil {
+		stream.Free()
+		return nil, err
+	}
+
+	session, err := newConsoleSession(h, key, dom, stream)
+	if err != nil {
+		stream.Abort()
+		stream.Free()
+		return nil, err
+	}
+	if err := stream.EventAddCallback(consoleStreamEvents, session.event); err != nil {
+		stream.Abort()
+		stream.Free()
+		session.dom.Free()
+		return nil, err
+	}
+	return session, nil
+}
+
+// Creates a session for an opened console, taking a reference to dom
+func newConsoleSession(h *ConsoleWebSocketHandler, key string, dom *Domain, stream consoleStream) (*consoleSession, error) {
+	if err := dom.Ref(); err != nil {
+		return nil, err
+	}
+	session := &consoleSession{
+		handler: h,
+		key:     key,
+		dom:     &Domain{},
+		stream:  stream,
+		viewers: make(map[*consoleViewer]bool),
+		history: consoleHistory{limit: h.config.HistorySize},
+	}
+	*session.dom = *dom
+	return session, nil
+}
+
+// Adds a viewer, which first receives the recent output. Returns false
+// if the session is already closed.
+func (s *consoleSession) addViewer(viewer *consoleViewer) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return false
+	}
+	s.joined++
+	viewer.joined = s.joined
+	s.viewers[viewer] = true
+	if s.writer == nil && !viewer.readOnly {
+		s.writer = viewer
+	}
+	if history := s.history.snapshot(); len(history) != 0 {
+		viewer.out <- history
+	}
+	return true
+}
+
+// Removes a viewer, closing the session when none are left
+func (s *consoleSession) removeViewer(viewer *consoleViewer) {
+	s.lock.Lock()
+	if !s.viewers[viewer] {
+		s.lock.Unlock()
+		return
+	}
+	s.dropViewer(viewer)
+	last := len(s.viewers) == 0 && !s.closed
+	if last {
+		s.closed = true
+	}
+	s.lock.Unlock()
+
+	if last {
+		s.release()
+	}
+}
+
+// Disconnects a viewer, handing the console over to the next writer
+// if it was the writer. The caller holds the lock.
+func (s *consoleSession) dropViewer(viewer *consoleViewer) {
+	delete(s.viewers, viewer)
+	close(viewer.out)
+	if s.writer != viewer {
+		return
+	}
+	s.writer = nil
+	for other := range s.viewers {
+		if !other.readOnly && (s.writer == nil || other.joined < s.writer.joined) {
+			s.writer = other
+		}
+	}
+	if s.writer != nil {
+		select {
+		case s.writer.promoted <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Closes the session, disconnecting every viewer
+func (s *consoleSession) close() {
+	s.lock.Lock()
+	if s.closed {
+		s.lock.Unlock()
+		return
+	}
+	s.closed = true
+	for viewer := range s.viewers {
+		close(viewer.out)
+	}
+	s.viewers = nil
+	s.writer = nil
+	s.lock.Unlock()
+
+	s.release()
+}
+
+func (s *consoleSession) release() {
+	s.handler.detach(s)
+	s.streamLock.Lock()
+	s.released = true
+	s.stream.EventRemoveCallback()
+	s.stream.Abort()
+	s.stream.Free()
+	s.streamLock.Unlock()
+	s.dom.Free()
+}
+
+// Returns whether the viewer may type on the console
+func (s *consoleSession) isWriter(viewer *consoleViewer) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.writer == viewer
+}
+
+// Queues input from a viewer, if it is the writer
+func (s *consoleSession) input(viewer *consoleViewer, data []byte) {
+	s.lock.Lock()
+	if s.closed || s.writer != viewer {
+		s.lock.Unlock()
+		return
+	}
+	// Input beyond the limit is dropped, as a terminal would
+	if room := consoleMaxPending - len(s.pending); len(data) > room {
+		data = data[:room]
+	}
+	s.pending = append(s.pending, data...)
+	s.lock.Unlock()
+
+	s.updateEvents()
+}
+
+// Asks for writable events while there is input to send
+func (s *consoleSession) updateEvents() {
+	s.streamLock.Lock()
+	defer s.streamLock.Unlock()
+	if !s.released {
+		s.updateEventsLocked()
+	}
+}
+
+func (s *consoleSession) updateEventsLocked() {
+	s.lock.Lock()
+	closed := s.closed
+	writable := len(s.pending) != 0
+	s.lock.Unlock()
+	if closed || writable == s.writable {
+		return
+	}
+
+	events := consoleStreamEvents
+	if writable {
+		events |= STREAM_EVENT_WRITABLE
+	}
+	if err := s.stream.EventUpdateCallback(events); err == nil {
+		s.writable = writable
+	}
+}
+
+// Returns whether an error from a non-blocking stream means it would
+// have blocked: libvirt reports no error in that case
+func isStreamWouldBlock(err error) bool {
+	virErr, ok := err.(Error)
+	return ok && virErr.Code == ERR_OK
+}
+
+func (s *consoleSession) event(stream *Stream, events StreamEventType) {
+	s.streamLock.Lock()
+	open := !s.released && s.handleEvents(events)
+	released := s.released
+	s.streamLock.Unlock()
+	if !open && !released {
+		s.close()
+	}
+}
+
+// Returns false once the console is closed
+func (s *consoleSession) handleEvents(events StreamEventType) bool {
+	if events&(STREAM_EVENT_ERROR|STREAM_EVENT_HANGUP) != 0 {
+		// Pass on any remaining output before closing
+		s.read()
+		return false
+	}
+	if events&STREAM_EVENT_READABLE != 0 && !s.read() {
+		return false
+	}
+	if events&STREAM_EVENT_WRITABLE != 0 {
+		if !s.write() {
+			return false
+		}
+		s.updateEventsLocked()
+	}
+	return true
+}
+
+// Reads the available output and passes it to the viewers. Returns
+// false once the console is closed.
+func (s *consoleSession) read() bool {
+	for {
+		buf := make([]byte, consoleReadSize)
+		n, err := s.stream.Recv(buf)
+		if err != nil {
+			return isStreamWouldBlock(err)
+		}
+		s.broadcast(buf[:n])
+	}
+}
+
+func (s *consoleSession) broadcast(data []byte) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return
+	}
+	s.history.write(data)
+	for viewer := range s.viewers {
+		select {
+		case viewer.out <- data:
+		default:
+			// The viewer is not keeping up, so disconnect it
+			s.dropViewer(viewer)
+		}
+	}
+}
+
+// Sends as much of the pending input as the stream accepts. Returns
+// false once the console is closed.
+func (s *consoleSession) write() bool {
+	s.lock.Lock()
+	data := s.pending
+	s.lock.Unlock()
+
+	sent := 0
+	for sent < len(data) {
+		n, err := s.stream.Send(data[sent:])
+		if err != nil {
+			if !isStreamWouldBlock(err) {
+				return false
+			}
+			break
+		}
+		sent += n
+	}
+
+	// Input is only appended meanwhile, so drop what was sent
+	s.lock.Lock()
+	s.pending = s.pending[sent:]
+	s.lock.Unlock()
+	return true
+}
+
+// A WebSocket client of a console
+type consoleViewer struct {
+	ws       *wsConn
+	readOnly bool
+	// Order in which the viewer was added to the session
+	joined uint64
+	// Output to send, closed when the viewer is removed
+	out chan []byte
+	// Signalled on input, to reset the idle timeout
+	active chan struct{}
+	// Signalled when the viewer becomes the writer
+	promoted chan struct{}
+}
+
+type consoleControl struct {
+	Type string `json:"type"`
+	Cols int    `json:"cols"`
+	Rows int    `json:"rows"`
+}
+
+// Passes output to the client and input from it until either side
+// closes, or the connection is idle for too long
+func (v *consoleViewer) run(session *consoleSession, idleTimeout time.Duration) {
+	finished := make(chan struct{})
+	go func() {
+		defer close(finished)
+		v.readInput(session)
+	}()
+
+	var timer *time.Timer
+	var timeout <-chan time.Time
+	if idleTimeout > 0 {
+		timer = time.NewTimer(idleTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	resetTimer := func() {
+		if timer != nil {
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(idleTimeout)
+		}
+	}
+
+	reason := ""
+loop:
+	for {
+		select {
+		case data, ok := <-v.out:
+			if !ok {
+				reason = "Console closed"
+				break loop
+			}
+			if _, err := v.ws.Write(data); err != nil {
+				break loop
+			}
+			resetTimer()
+		case <-v.active:
+			resetTimer()
+		case <-v.promoted:
+			if err := v.ws.writeFrame(wsOpText, []byte(consoleWriterNotice)); err != nil {
+				break loop
+			}
+		case <-timeout:
+			reason = "Idle timeout"
+			break loop
+		case <-finished:
+			break loop
+		}
+	}
+
+	v.ws.close(wsCloseGoingAway, reason)
+	<-finished
+	session.removeViewer(v)
+}
+
+func (v *consoleViewer) readInput(session *consoleSession) {
+	config := &session.handler.config
+	for {
+		opcode, data, err := v.ws.readMessage(consoleMaxMessage)
+		if err != nil {
+			return
+		}
+		select {
+		case v.active <- struct{}{}:
+		default:
+		}
+
+		if opcode == wsOpBinary {
+			session.input(v, data)
+			continue
+		}
+		var control consoleControl
+		if err := json.Unmarshal(data, &control); err != nil {
+			continue
+		}
+		if control.Type == "resize" && config.Resize != nil &&
+			control.Cols > 0 && control.Rows > 0 && session.isWriter(v) {
+			config.Resize(session.dom, control.Cols, control.Rows)
+		}
+	}
+}
@@ -0,0 +1,420 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"encoding/binary"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestConsoleHistory(t *testing.T) {
+	history := consoleHistory{limit: 8}
+	history.write([]byte("hello"))
+	if got := string(history.snapshot()); got != "hello" {
+		t.Fatalf("History %q", got)
+	}
+	history.write([]byte(" world"))
+	if got := string(history.snapshot()); got != "lo world" {
+		t.Fatalf("History %q", got)
+	}
+	history.write([]byte("0123456789"))
+	if got := string(history.snapshot()); got != "23456789" {
+		t.Fatalf("History %q", got)
+	}
+}
+
+func TestWebSocketReadMessage(t *testing.T) {
+	type message struct {
+		opcode byte
+		data   string
+		err    error
+	}
+	messages := make(chan message, 3)
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := wsUpgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer ws.close(wsCloseNormal, "")
+		for {
+			opcode, data, err := ws.readMessage(32)
+			messages <- message{opcode, string(data), err}
+			if err != nil {
+				return
+			}
+		}
+	}))
+	defer httpServer.Close()
+
+	conn, reader := dialTestWebSocket(t, httpServer.URL, "")
+	defer conn.Close()
+
+	writeTestFrame(t, conn, wsOpText, []byte(`{"type":`))
+	writeTestFrame(t, conn, 0x80|wsOpContinuation, []byte(`"resize"}`))
+	if msg := <-messages; msg.err != nil || msg.opcode != wsOpText || msg.data != `{"type":"resize"}` {
+		t.Fatalf("Unexpected message %+v", msg)
+	}
+
+	writeTestFrame(t, conn, 0x80|wsOpBinary, []byte("ls\r"))
+	if msg := <-messages; msg.err != nil || msg.opcode != wsOpBinary || msg.data != "ls\r" {
+		t.Fatalf("Unexpected message %+v", msg)
+	}
+
+	writeTestFrame(t, conn, 0x80|wsOpBinary, []byte("this payload is more than 32 bytes"))
+	if msg := <-messages; msg.err != errWebSocketTooBig {
+		t.Fatalf("Expected a message too big error, got %+v", msg)
+	}
+	if op, payload := readTestFrame(t, reader); op != 0x80|wsOpClose ||
+		binary.BigEndian.Uint16(payload) != wsCloseTooBig {
+		t.Fatalf("Expected a close frame, got %#x %q", op, payload)
+	}
+}
+
+func TestConsoleWebSocketHandler(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Destroy()
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+	uuid, err := dom.GetUUIDString()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	readOnly := false
+	handler := NewConsoleWebSocketHandler(conn, &ConsoleWebSocketConfig{
+		Authorize: func(r *http.Request, dom *Domain) (bool, error) {
+			if r.URL.Query().Get("token") != "secret" {
+				return false, Error{Code: ERR_AUTH_FAILED, Message: "Invalid token"}
+			}
+			return readOnly, nil
+		},
+	})
+
+	request := func(query string) int {
+		r := httptest.NewRequest("GET", "/?"+query, nil)
+		r.Header.Set("Connection", "Upgrade")
+		r.Header.Set("Upgrade", "websocket")
+		r.Header.Set("Sec-WebSocket-Version", "13")
+		r.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	if code := request("uuid=00000000-0000-0000-0000-000000000000&token=secret"); code != http.StatusNotFound {
+		t.Errorf("Missing domain gave status %d", code)
+	}
+	if code := request("uuid=" + uuid); code != http.StatusForbidden {
+		t.Errorf("Request without token gave status %d", code)
+	}
+	// The test driver has no consoles to open
+	if code := request("uuid=" + uuid + "&token=secret"); code != http.StatusBadGateway {
+		t.Errorf("Domain without console gave status %d", code)
+	}
+	if len(handler.sessions) != 0 {
+		t.Errorf("Sessions left behind: %d", len(handler.sessions))
+	}
+}
+
+// Stands in for the console stream of a consoleSession
+type testConsoleStream struct {
+	lock sync.Mutex
+	// Output of the console, for the session to read
+	output []byte
+	// Input sent by the session
+	input []byte
+	// Bytes of input accepted before Send would block
+	room   int
+	events StreamEventType
+	freed  bool
+}
+
+func (s *testConsoleStream) Recv(p []byte) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.output) == 0 {
+		return 0, Error{Code: ERR_OK}
+	}
+	n := copy(p, s.output)
+	s.output = s.output[n:]
+	return n, nil
+}
+
+func (s *testConsoleStream) Send(p []byte) (int, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.room == 0 {
+		return 0, Error{Code: ERR_OK}
+	}
+	if len(p) > s.room {
+		p = p[:s.room]
+	}
+	s.input = append(s.input, p...)
+	s.room -= len(p)
+	return len(p), nil
+}
+
+func (s *testConsoleStream) EventUpdateCallback(events StreamEventType) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.events = events
+	return nil
+}
+
+func (s *testConsoleStream) EventRemoveCallback() error {
+	return nil
+}
+
+func (s *testConsoleStream) Abort() error {
+	return nil
+}
+
+func (s *testConsoleStream) Free() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.freed = true
+	return nil
+}
+
+// Feeds output to the session, as the event loop would
+func (s *testConsoleStream) feed(session *consoleSession, data string) {
+	s.lock.Lock()
+	s.output = append(s.output, data...)
+	s.lock.Unlock()
+	session.event(nil, STREAM_EVENT_READABLE)
+}
+
+func buildTestConsoleSession(t *testing.T) (*consoleSession, *testConsoleStream, func()) {
+	dom, conn := buildTestDomain()
+	handler := NewConsoleWebSocketHandler(conn, nil)
+	stream := &testConsoleStream{}
+	session, err := newConsoleSession(handler, "test", dom, stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler.sessions[session.key] = session
+	return session, stream, func() {
+		session.close()
+		dom.Destroy()
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}
+}
+
+func buildTestConsoleViewer(readOnly bool, queue int) *consoleViewer {
+	return &consoleViewer{
+		readOnly: readOnly,
+		out:      make(chan []byte, queue),
+		active:   make(chan struct{}, 1),
+		promoted: make(chan struct{}, 1),
+	}
+}
+
+// Returns the output queued for a viewer, and whether it was removed
+func drainTestConsoleViewer(viewer *consoleViewer) (string, bool) {
+	output := ""
+	for {
+		select {
+		case data, ok := <-viewer.out:
+			if !ok {
+				return output, true
+			}
+			output += string(data)
+		default:
+			return output, false
+		}
+	}
+}
+
+func wasTestConsoleViewerPromoted(viewer *consoleViewer) bool {
+	select {
+	case <-viewer.promoted:
+		return true
+	default:
+		return false
+	}
+}
+
+func TestConsoleSessionStream(t *testing.T) {
+	session, stream, cleanup := buildTestConsoleSession(t)
+	defer cleanup()
+
+	viewer := buildTestConsoleViewer(false, consoleViewerQueue)
+	if !session.addViewer(viewer) {
+		t.Fatal("Session closed")
+	}
+
+	stream.feed(session, "login: ")
+	if output, removed := drainTestConsoleViewer(viewer); output != "login: " || removed {
+		t.Fatalf("Viewer received %q, removed %v", output, removed)
+	}
+
+	// Input waits for the stream to become writable
+	session.input(viewer, []byte("root\r"))
+	if stream.events&STREAM_EVENT_WRITABLE == 0 {
+		t.Fatal("Writable events not requested for input")
+	}
+	stream.room = 2
+	session.event(nil, STREAM_EVENT_WRITABLE)
+	if string(stream.input) != "ro" || stream.events&STREAM_EVENT_WRITABLE == 0 {
+		t.Fatalf("Sent %q with events %#x", stream.input, stream.events)
+	}
+	stream.room = 16
+	session.event(nil, STREAM_EVENT_WRITABLE)
+	if string(stream.input) != "root\r" || stream.events != consoleStreamEvents {
+		t.Fatalf("Sent %q with events %#x", stream.input, stream.events)
+	}
+
+	// Output before a hangup still reaches the viewers
+	stream.lock.Lock()
+	stream.output = []byte("bye\r\n")
+	stream.lock.Unlock()
+	session.event(nil, STREAM_EVENT_HANGUP)
+	if output, removed := drainTestConsoleViewer(viewer); output != "bye\r\n" || !removed {
+		t.Fatalf("Viewer received %q, removed %v", output, removed)
+	}
+	if !stream.freed {
+		t.Error("Stream not freed")
+	}
+	if len(session.handler.sessions) != 0 {
+		t.Error("Session left behind")
+	}
+	if session.addViewer(buildTestConsoleViewer(false, 1)) {
+		t.Error("Viewer added to a closed session")
+	}
+}
+
+func TestConsoleSessionWriter(t *testing.T) {
+	session, stream, cleanup := buildTestConsoleSession(t)
+	defer cleanup()
+
+	spectator := buildTestConsoleViewer(true, consoleViewerQueue)
+	first := buildTestConsoleViewer(false, consoleViewerQueue)
+	second := buildTestConsoleViewer(false, consoleViewerQueue)
+	third := buildTestConsoleViewer(false, consoleViewerQueue)
+	for _, viewer := range []*consoleViewer{spectator, first, second, third} {
+		session.addViewer(viewer)
+	}
+	if session.isWriter(spectator) || !session.isWriter(first) || session.isWriter(second) {
+		t.Fatal("First viewer allowed to type is not the writer")
+	}
+
+	session.input(spectator, []byte("spectator"))
+	session.input(second, []byte("second"))
+	session.input(first, []byte("first"))
+	stream.room = 64
+	session.event(nil, STREAM_EVENT_WRITABLE)
+	if string(stream.input) != "first" {
+		t.Fatalf("Sent %q", stream.input)
+	}
+
+	// The longest connected viewer allowed to type takes over
+	session.removeViewer(first)
+	if !session.isWriter(second) {
+		t.Fatal("Writer not handed over")
+	}
+	if !wasTestConsoleViewerPromoted(second) {
+		t.Error("New writer not notified")
+	}
+	if wasTestConsoleViewerPromoted(spectator) || wasTestConsoleViewerPromoted(third) {
+		t.Error("Other viewers notified")
+	}
+	session.input(second, []byte("second"))
+	session.event(nil, STREAM_EVENT_WRITABLE)
+	if string(stream.input) != "firstsecond" {
+		t.Fatalf("Sent %q", stream.input)
+	}
+
+	session.removeViewer(second)
+	session.removeViewer(third)
+	if session.isWriter(spectator) || wasTestConsoleViewerPromoted(spectator) {
+		t.Error("Read only viewer became the writer")
+	}
+}
+
+func TestConsoleSessionHistory(t *testing.T) {
+	session, stream, cleanup := buildTestConsoleSession(t)
+	defer cleanup()
+
+	first := buildTestConsoleViewer(false, consoleViewerQueue)
+	session.addViewer(first)
+	stream.feed(session, "boot\r\n")
+	stream.feed(session, "login: ")
+
+	second := buildTestConsoleViewer(true, consoleViewerQueue)
+	session.addViewer(second)
+	if output, _ := drainTestConsoleViewer(second); output != "boot\r\nlogin: " {
+		t.Fatalf("Attached viewer received %q", output)
+	}
+	stream.feed(session, "root")
+	if output, _ := drainTestConsoleViewer(second); output != "root" {
+		t.Fatalf("Attached viewer received %q", output)
+	}
+	if output, _ := drainTestConsoleViewer(first); output != "boot\r\nlogin: root" {
+		t.Fatalf("First viewer received %q", output)
+	}
+}
+
+func TestConsoleSessionSlowViewer(t *testing.T) {
+	session, stream, cleanup := buildTestConsoleSession(t)
+	defer cleanup()
+
+	slow := buildTestConsoleViewer(false, 1)
+	spectator := buildTestConsoleViewer(true, consoleViewerQueue)
+	next := buildTestConsoleViewer(false, consoleViewerQueue)
+	for _, viewer := range []*consoleViewer{slow, spectator, next} {
+		session.addViewer(viewer)
+	}
+
+	stream.feed(session, "one")
+	stream.feed(session, "two")
+	if output, removed := drainTestConsoleViewer(slow); output != "one" || !removed {
+		t.Fatalf("Slow viewer received %q, removed %v", output, removed)
+	}
+	if output, removed := drainTestConsoleViewer(next); output != "onetwo" || removed {
+		t.Fatalf("Viewer received %q, removed %v", output, removed)
+	}
+	if !session.isWriter(next) || !wasTestConsoleViewerPromoted(next) {
+		t.Error("Writer not handed over from the slow viewer")
+	}
+
+	// Removing the dropped viewer again is harmless
+	session.removeViewer(slow)
+	if len(session.viewers) != 2 {
+		t.Errorf("Session has %d viewers, expected 2", len(session.viewers))
+	}
+}
@@ -33,6 +33,11 @@
 // libvirtd lacking the API, or if a hypervisor does not support a given feature,
 // so an application can easily handle all scenarios together.
 //
+// When built with the 'libvirt_dlopen' tag, libvirt.so is not linked at build
+// time. Instead each libvirt API is resolved at runtime, the first time it is
+// called. An API missing from the libvirt.so found at runtime will likewise
+// return an error with a code of ERR_NO_SUPPORT.
+//
 // The Go binding is a fairly direct mapping of the underling C API which seeks
 // to maximise the use of the Go type system to allow strong compiler type
 // checking. The following rules describe how APIs/constants are mapped from C
@@ -27,10 +27,11 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "domain_wrapper.h"
 #include "connect_wrapper.h"
+#include "typedparams_wrapper.h"
 */
 import "C"
 
@@ -914,8 +915,34 @@ const (
 	DOMAIN_GET_HOSTNAME_LEASE = DomainGetHostnameFlags(C.VIR_DOMAIN_GET_HOSTNAME_LEASE)
 )
 
+type DomainEventMemoryFailureRecipientType int
+
+const (
+	DOMAIN_EVENT_MEMORY_FAILURE_RECIPIENT_HYPERVISOR = DomainEventMemoryFailureRecipientType(C.VIR_DOMAIN_EVENT_MEMORY_FAILURE_RECIPIENT_HYPERVISOR)
+	DOMAIN_EVENT_MEMORY_FAILURE_RECIPIENT_GUEST      = DomainEventMemoryFailureRecipientType(C.VIR_DOMAIN_EVENT_MEMORY_FAILURE_RECIPIENT_GUEST)
+)
+
+type DomainEventMemoryFailureActionType int
+
+const (
+	DOMAIN_EVENT_MEMORY_FAILURE_ACTION_IGNORE = DomainEventMemoryFailureActionType(C.VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_IGNORE)
+	DOMAIN_EVENT_MEMORY_FAILURE_ACTION_INJECT = DomainEventMemoryFailureActionType(C.VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_INJECT)
+	DOMAIN_EVENT_MEMORY_FAILURE_ACTION_FATAL  = DomainEventMemoryFailureActionType(C.VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_FATAL)
+	DOMAIN_EVENT_MEMORY_FAILURE_ACTION_RESET  = DomainEventMemoryFailureActionType(C.VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_RESET)
+)
+
+type DomainMemoryFailureFlags int
+
+const (
+	DOMAIN_MEMORY_FAILURE_ACTION_REQUIRED = DomainMemoryFailureFlags(C.VIR_DOMAIN_MEMORY_FAILURE_ACTION_REQUIRED)
+	DOMAIN_MEMORY_FAILURE_RECURSIVE       = DomainMemoryFailureFlags(C.VIR_DOMAIN_MEMORY_FAILURE_RECURSIVE)
+)
+
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainFree
-func (d *Domain) Free() error {
+func (d *Domain) doFree() error {
+	if err := trackHandleFree("Domain", unsafe.Pointer(d.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virDomainFreeWrapper(d.ptr, &err)
 	if ret == -1 {
@@ -925,17 +952,18 @@ func (d *Domain) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainRef
-func (c *Domain) Ref() error {
+func (c *Domain) doRef() error {
 	var err C.virError
 	ret := C.virDomainRefWrapper(c.ptr, &err)
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("Domain", unsafe.Pointer(c.ptr))
 	return nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainCreate
-func (d *Domain) Create() error {
+func (d *Domain) doCreate() error {
 	var err C.virError
 	result := C.virDomainCreateWrapper(d.ptr, &err)
 	if result == -1 {
@@ -945,7 +973,7 @@ func (d *Domain) Create() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainCreateWithFlags
-func (d *Domain) CreateWithFlags(flags DomainCreateFlags) error {
+func (d *Domain) doCreateWithFlags(flags DomainCreateFlags) error {
 	var err C.virError
 	result := C.virDomainCreateWithFlagsWrapper(d.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -955,7 +983,7 @@ func (d *Domain) CreateWithFlags(flags DomainCreateFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainCreateWithFiles
-func (d *Domain) CreateWithFiles(files []os.File, flags DomainCreateFlags) error {
+func (d *Domain) doCreateWithFiles(files []os.File, flags DomainCreateFlags) error {
 	cfiles := make([]C.int, len(files))
 	for i := 0; i < len(files); i++ {
 		cfiles[i] = C.int(files[i].Fd())
@@ -969,7 +997,7 @@ func (d *Domain) CreateWithFiles(files []os.File, flags DomainCreateFlags) error
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDestroy
-func (d *Domain) Destroy() error {
+func (d *Domain) doDestroy() error {
 	var err C.virError
 	result := C.virDomainDestroyWrapper(d.ptr, &err)
 	if result == -1 {
@@ -979,7 +1007,7 @@ func (d *Domain) Destroy() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainShutdown
-func (d *Domain) Shutdown() error {
+func (d *Domain) doShutdown() error {
 	var err C.virError
 	result := C.virDomainShutdownWrapper(d.ptr, &err)
 	if result == -1 {
@@ -989,7 +1017,7 @@ func (d *Domain) Shutdown() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainReboot
-func (d *Domain) Reboot(flags DomainRebootFlagValues) error {
+func (d *Domain) doReboot(flags DomainRebootFlagValues) error {
 	var err C.virError
 	result := C.virDomainRebootWrapper(d.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -999,7 +1027,7 @@ func (d *Domain) Reboot(flags DomainRebootFlagValues) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainIsActive
-func (d *Domain) IsActive() (bool, error) {
+func (d *Domain) doIsActive() (bool, error) {
 	var err C.virError
 	result := C.virDomainIsActiveWrapper(d.ptr, &err)
 	if result == -1 {
@@ -1012,7 +1040,7 @@ func (d *Domain) IsActive() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainIsPersistent
-func (d *Domain) IsPersistent() (bool, error) {
+func (d *Domain) doIsPersistent() (bool, error) {
 	var err C.virError
 	result := C.virDomainIsPersistentWrapper(d.ptr, &err)
 	if result == -1 {
@@ -1025,7 +1053,7 @@ func (d *Domain) IsPersistent() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainIsUpdated
-func (d *Domain) IsUpdated() (bool, error) {
+func (d *Domain) doIsUpdated() (bool, error) {
 	var err C.virError
 	result := C.virDomainIsUpdatedWrapper(d.ptr, &err)
 	if result == -1 {
@@ -1038,7 +1066,7 @@ func (d *Domain) IsUpdated() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetAutostart
-func (d *Domain) SetAutostart(autostart bool) error {
+func (d *Domain) doSetAutostart(autostart bool) error {
 	var cAutostart C.int
 	switch autostart {
 	case true:
@@ -1055,7 +1083,7 @@ func (d *Domain) SetAutostart(autostart bool) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetAutostart
-func (d *Domain) GetAutostart() (bool, error) {
+func (d *Domain) doGetAutostart() (bool, error) {
 	var out C.int
 	var err C.virError
 	result := C.virDomainGetAutostartWrapper(d.ptr, (*C.int)(unsafe.Pointer(&out)), &err)
@@ -1071,7 +1099,7 @@ func (d *Domain) GetAutostart() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetBlockInfo
-func (d *Domain) GetBlockInfo(disk string, flag uint) (*DomainBlockInfo, error) {
+func (d *Domain) doGetBlockInfo(disk string, flag uint) (*DomainBlockInfo, error) {
 	var cinfo C.virDomainBlockInfo
 	cDisk := C.CString(disk)
 	defer C.free(unsafe.Pointer(cDisk))
@@ -1089,7 +1117,7 @@ func (d *Domain) GetBlockInfo(disk string, flag uint) (*DomainBlockInfo, error)
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetName
-func (d *Domain) GetName() (string, error) {
+func (d *Domain) doGetName() (string, error) {
 	var err C.virError
 	name := C.virDomainGetNameWrapper(d.ptr, &err)
 	if name == nil {
@@ -1099,7 +1127,7 @@ func (d *Domain) GetName() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetState
-func (d *Domain) GetState() (DomainState, int, error) {
+func (d *Domain) doGetState() (DomainState, int, error) {
 	var cState C.int
 	var cReason C.int
 	var err C.virError
@@ -1114,7 +1142,7 @@ func (d *Domain) GetState() (DomainState, int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetID
-func (d *Domain) GetID() (uint, error) {
+func (d *Domain) doGetID() (uint, error) {
 	var err C.virError
 	id := C.virDomainGetIDWrapper(d.ptr, &err)
 	if id == ^C.uint(0) {
@@ -1124,7 +1152,7 @@ func (d *Domain) GetID() (uint, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetUUID
-func (d *Domain) GetUUID() ([]byte, error) {
+func (d *Domain) doGetUUID() ([]byte, error) {
 	var cUuid [C.VIR_UUID_BUFLEN](byte)
 	cuidPtr := unsafe.Pointer(&cUuid)
 	var err C.virError
@@ -1136,7 +1164,7 @@ func (d *Domain) GetUUID() ([]byte, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetUUIDString
-func (d *Domain) GetUUIDString() (string, error) {
+func (d *Domain) doGetUUIDString() (string, error) {
 	var cUuid [C.VIR_UUID_STRING_BUFLEN](C.char)
 	cuidPtr := unsafe.Pointer(&cUuid)
 	var err C.virError
@@ -1148,7 +1176,7 @@ func (d *Domain) GetUUIDString() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetInfo
-func (d *Domain) GetInfo() (*DomainInfo, error) {
+func (d *Domain) doGetInfo() (*DomainInfo, error) {
 	var cinfo C.virDomainInfo
 	var err C.virError
 	result := C.virDomainGetInfoWrapper(d.ptr, &cinfo, &err)
@@ -1165,7 +1193,7 @@ func (d *Domain) GetInfo() (*DomainInfo, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetXMLDesc
-func (d *Domain) GetXMLDesc(flags DomainXMLFlags) (string, error) {
+func (d *Domain) doGetXMLDesc(flags DomainXMLFlags) (string, error) {
 	var err C.virError
 	result := C.virDomainGetXMLDescWrapper(d.ptr, C.uint(flags), &err)
 	if result == nil {
@@ -1209,7 +1237,7 @@ func getCPUStatsFieldInfo(params *DomainCPUStats) map[string]typedParamsFieldInf
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetCPUStats
-func (d *Domain) GetCPUStats(startCpu int, nCpus uint, flags uint32) ([]DomainCPUStats, error) {
+func (d *Domain) doGetCPUStats(startCpu int, nCpus uint, flags uint32) ([]DomainCPUStats, error) {
 	var err C.virError
 	if nCpus == 0 {
 		if startCpu == -1 {
@@ -1236,7 +1264,7 @@ func (d *Domain) GetCPUStats(startCpu int, nCpus uint, flags uint32) ([]DomainCP
 		cnallocparams = cnparams * C.int(nCpus)
 	}
 	cparams := typedParamsNew(cnallocparams)
-	defer C.virTypedParamsFree(cparams, cnallocparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnallocparams)
 	ret = C.virDomainGetCPUStatsWrapper(d.ptr, cparams, C.uint(cnparams), C.int(startCpu), C.uint(nCpus), C.uint(flags), &err)
 	if ret == -1 {
 		return []DomainCPUStats{}, makeError(&err)
@@ -1308,7 +1336,7 @@ func getInterfaceParameterFieldInfo(params *DomainInterfaceParameters) map[strin
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetInterfaceParameters
-func (d *Domain) GetInterfaceParameters(device string, flags DomainModificationImpact) (*DomainInterfaceParameters, error) {
+func (d *Domain) doGetInterfaceParameters(device string, flags DomainModificationImpact) (*DomainInterfaceParameters, error) {
 	params := &DomainInterfaceParameters{}
 	info := getInterfaceParameterFieldInfo(params)
 
@@ -1323,7 +1351,7 @@ func (d *Domain) GetInterfaceParameters(device string, flags DomainModificationI
 	}
 
 	cparams := typedParamsNew(cnparams)
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 	ret = C.virDomainGetInterfaceParametersWrapper(d.ptr, cdevice, cparams, &cnparams, C.uint(flags), &err)
 	if ret == -1 {
 		return nil, makeError(&err)
@@ -1338,7 +1366,7 @@ func (d *Domain) GetInterfaceParameters(device string, flags DomainModificationI
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetInterfaceParameters
-func (d *Domain) SetInterfaceParameters(device string, params *DomainInterfaceParameters, flags DomainModificationImpact) error {
+func (d *Domain) doSetInterfaceParameters(device string, params *DomainInterfaceParameters, flags DomainModificationImpact) error {
 	info := getInterfaceParameterFieldInfo(params)
 
 	cdevice := C.CString(device)
@@ -1349,7 +1377,7 @@ func (d *Domain) SetInterfaceParameters(device string, params *DomainInterfacePa
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainSetInterfaceParametersWrapper(d.ptr, cdevice, cparams, cnparams, C.uint(flags), &err)
@@ -1361,7 +1389,7 @@ func (d *Domain) SetInterfaceParameters(device string, params *DomainInterfacePa
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetMetadata
-func (d *Domain) GetMetadata(tipus DomainMetadataType, uri string, flags DomainModificationImpact) (string, error) {
+func (d *Domain) doGetMetadata(tipus DomainMetadataType, uri string, flags DomainModificationImpact) (string, error) {
 	var cUri *C.char
 	if uri != "" {
 		cUri = C.CString(uri)
@@ -1379,7 +1407,7 @@ func (d *Domain) GetMetadata(tipus DomainMetadataType, uri string, flags DomainM
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetMetadata
-func (d *Domain) SetMetadata(metaDataType DomainMetadataType, metaDataCont, uriKey, uri string, flags DomainModificationImpact) error {
+func (d *Domain) doSetMetadata(metaDataType DomainMetadataType, metaDataCont, uriKey, uri string, flags DomainModificationImpact) error {
 	var cMetaDataCont *C.char
 	var cUriKey *C.char
 	var cUri *C.char
@@ -1406,7 +1434,7 @@ func (d *Domain) SetMetadata(metaDataType DomainMetadataType, metaDataCont, uriK
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainUndefine
-func (d *Domain) Undefine() error {
+func (d *Domain) doUndefine() error {
 	var err C.virError
 	result := C.virDomainUndefineWrapper(d.ptr, &err)
 	if result == -1 {
@@ -1416,7 +1444,7 @@ func (d *Domain) Undefine() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainUndefineFlags
-func (d *Domain) UndefineFlags(flags DomainUndefineFlagsValues) error {
+func (d *Domain) doUndefineFlags(flags DomainUndefineFlagsValues) error {
 	var err C.virError
 	result := C.virDomainUndefineFlagsWrapper(d.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -1426,7 +1454,7 @@ func (d *Domain) UndefineFlags(flags DomainUndefineFlagsValues) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetMaxMemory
-func (d *Domain) SetMaxMemory(memory uint) error {
+func (d *Domain) doSetMaxMemory(memory uint) error {
 	var err C.virError
 	result := C.virDomainSetMaxMemoryWrapper(d.ptr, C.ulong(memory), &err)
 	if result == -1 {
@@ -1436,7 +1464,7 @@ func (d *Domain) SetMaxMemory(memory uint) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetMemory
-func (d *Domain) SetMemory(memory uint64) error {
+func (d *Domain) doSetMemory(memory uint64) error {
 	var err C.virError
 	result := C.virDomainSetMemoryWrapper(d.ptr, C.ulong(memory), &err)
 	if result == -1 {
@@ -1446,7 +1474,7 @@ func (d *Domain) SetMemory(memory uint64) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetMemoryFlags
-func (d *Domain) SetMemoryFlags(memory uint64, flags DomainMemoryModFlags) error {
+func (d *Domain) doSetMemoryFlags(memory uint64, flags DomainMemoryModFlags) error {
 	var err C.virError
 	result := C.virDomainSetMemoryFlagsWrapper(d.ptr, C.ulong(memory), C.uint(flags), &err)
 	if result == -1 {
@@ -1456,7 +1484,7 @@ func (d *Domain) SetMemoryFlags(memory uint64, flags DomainMemoryModFlags) error
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetMemoryStatsPeriod
-func (d *Domain) SetMemoryStatsPeriod(period int, flags DomainMemoryModFlags) error {
+func (d *Domain) doSetMemoryStatsPeriod(period int, flags DomainMemoryModFlags) error {
 	var err C.virError
 	result := C.virDomainSetMemoryStatsPeriodWrapper(d.ptr, C.int(period), C.uint(flags), &err)
 	if result == -1 {
@@ -1466,7 +1494,7 @@ func (d *Domain) SetMemoryStatsPeriod(period int, flags DomainMemoryModFlags) er
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetVcpus
-func (d *Domain) SetVcpus(vcpu uint) error {
+func (d *Domain) doSetVcpus(vcpu uint) error {
 	var err C.virError
 	result := C.virDomainSetVcpusWrapper(d.ptr, C.uint(vcpu), &err)
 	if result == -1 {
@@ -1476,7 +1504,7 @@ func (d *Domain) SetVcpus(vcpu uint) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetVcpusFlags
-func (d *Domain) SetVcpusFlags(vcpu uint, flags DomainVcpuFlags) error {
+func (d *Domain) doSetVcpusFlags(vcpu uint, flags DomainVcpuFlags) error {
 	var err C.virError
 	result := C.virDomainSetVcpusFlagsWrapper(d.ptr, C.uint(vcpu), C.uint(flags), &err)
 	if result == -1 {
@@ -1486,7 +1514,7 @@ func (d *Domain) SetVcpusFlags(vcpu uint, flags DomainVcpuFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSuspend
-func (d *Domain) Suspend() error {
+func (d *Domain) doSuspend() error {
 	var err C.virError
 	result := C.virDomainSuspendWrapper(d.ptr, &err)
 	if result == -1 {
@@ -1496,7 +1524,7 @@ func (d *Domain) Suspend() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainResume
-func (d *Domain) Resume() error {
+func (d *Domain) doResume() error {
 	var err C.virError
 	result := C.virDomainResumeWrapper(d.ptr, &err)
 	if result == -1 {
@@ -1506,7 +1534,7 @@ func (d *Domain) Resume() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainAbortJob
-func (d *Domain) AbortJob() error {
+func (d *Domain) doAbortJob() error {
 	var err C.virError
 	result := C.virDomainAbortJobWrapper(d.ptr, &err)
 	if result == -1 {
@@ -1516,7 +1544,7 @@ func (d *Domain) AbortJob() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDestroyFlags
-func (d *Domain) DestroyFlags(flags DomainDestroyFlags) error {
+func (d *Domain) doDestroyFlags(flags DomainDestroyFlags) error {
 	var err C.virError
 	result := C.virDomainDestroyFlagsWrapper(d.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -1526,7 +1554,7 @@ func (d *Domain) DestroyFlags(flags DomainDestroyFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainShutdownFlags
-func (d *Domain) ShutdownFlags(flags DomainShutdownFlags) error {
+func (d *Domain) doShutdownFlags(flags DomainShutdownFlags) error {
 	var err C.virError
 	result := C.virDomainShutdownFlagsWrapper(d.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -1536,7 +1564,7 @@ func (d *Domain) ShutdownFlags(flags DomainShutdownFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainAttachDevice
-func (d *Domain) AttachDevice(xml string) error {
+func (d *Domain) doAttachDevice(xml string) error {
 	cXml := C.CString(xml)
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1548,7 +1576,7 @@ func (d *Domain) AttachDevice(xml string) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainAttachDeviceFlags
-func (d *Domain) AttachDeviceFlags(xml string, flags DomainDeviceModifyFlags) error {
+func (d *Domain) doAttachDeviceFlags(xml string, flags DomainDeviceModifyFlags) error {
 	cXml := C.CString(xml)
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1560,7 +1588,7 @@ func (d *Domain) AttachDeviceFlags(xml string, flags DomainDeviceModifyFlags) er
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDetachDevice
-func (d *Domain) DetachDevice(xml string) error {
+func (d *Domain) doDetachDevice(xml string) error {
 	cXml := C.CString(xml)
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1572,7 +1600,7 @@ func (d *Domain) DetachDevice(xml string) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDetachDeviceFlags
-func (d *Domain) DetachDeviceFlags(xml string, flags DomainDeviceModifyFlags) error {
+func (d *Domain) doDetachDeviceFlags(xml string, flags DomainDeviceModifyFlags) error {
 	cXml := C.CString(xml)
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1584,7 +1612,7 @@ func (d *Domain) DetachDeviceFlags(xml string, flags DomainDeviceModifyFlags) er
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDetachDeviceAlias
-func (d *Domain) DetachDeviceAlias(alias string, flags DomainDeviceModifyFlags) error {
+func (d *Domain) doDetachDeviceAlias(alias string, flags DomainDeviceModifyFlags) error {
 	if C.LIBVIR_VERSION_NUMBER < 4004000 {
 		return makeNotImplementedError("virDomainDetachDeviceAlias")
 	}
@@ -1600,7 +1628,7 @@ func (d *Domain) DetachDeviceAlias(alias string, flags DomainDeviceModifyFlags)
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainUpdateDeviceFlags
-func (d *Domain) UpdateDeviceFlags(xml string, flags DomainDeviceModifyFlags) error {
+func (d *Domain) doUpdateDeviceFlags(xml string, flags DomainDeviceModifyFlags) error {
 	cXml := C.CString(xml)
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -1612,7 +1640,7 @@ func (d *Domain) UpdateDeviceFlags(xml string, flags DomainDeviceModifyFlags) er
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainScreenshot
-func (d *Domain) Screenshot(stream *Stream, screen, flags uint32) (string, error) {
+func (d *Domain) doScreenshot(stream *Stream, screen, flags uint32) (string, error) {
 	var err C.virError
 	cType := C.virDomainScreenshotWrapper(d.ptr, stream.ptr, C.uint(screen), C.uint(flags), &err)
 	if cType == nil {
@@ -1625,7 +1653,7 @@ func (d *Domain) Screenshot(stream *Stream, screen, flags uint32) (string, error
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSendKey
-func (d *Domain) SendKey(codeset, holdtime uint, keycodes []uint, flags uint32) error {
+func (d *Domain) doSendKey(codeset, holdtime uint, keycodes []uint, flags uint32) error {
 	var err C.virError
 	result := C.virDomainSendKeyWrapper(d.ptr, C.uint(codeset), C.uint(holdtime), (*C.uint)(unsafe.Pointer(&keycodes[0])), C.int(len(keycodes)), C.uint(flags), &err)
 	if result == -1 {
@@ -1698,7 +1726,7 @@ func getBlockStatsFieldInfo(params *DomainBlockStats) map[string]typedParamsFiel
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBlockStatsFlags
-func (d *Domain) BlockStatsFlags(disk string, flags uint32) (*DomainBlockStats, error) {
+func (d *Domain) doBlockStatsFlags(disk string, flags uint32) (*DomainBlockStats, error) {
 	params := &DomainBlockStats{}
 	info := getBlockStatsFieldInfo(params)
 
@@ -1713,7 +1741,7 @@ func (d *Domain) BlockStatsFlags(disk string, flags uint32) (*DomainBlockStats,
 	}
 
 	cparams := typedParamsNew(cnparams)
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 	ret = C.virDomainBlockStatsFlagsWrapper(d.ptr, cdisk, cparams, &cnparams, C.uint(flags), &err)
 	if ret == -1 {
 		return nil, makeError(&err)
@@ -1728,7 +1756,7 @@ func (d *Domain) BlockStatsFlags(disk string, flags uint32) (*DomainBlockStats,
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBlockStats
-func (d *Domain) BlockStats(path string) (*DomainBlockStats, error) {
+func (d *Domain) doBlockStats(path string) (*DomainBlockStats, error) {
 	cPath := C.CString(path)
 	defer C.free(unsafe.Pointer(cPath))
 
@@ -1775,7 +1803,7 @@ type DomainInterfaceStats struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainInterfaceStats
-func (d *Domain) InterfaceStats(path string) (*DomainInterfaceStats, error) {
+func (d *Domain) doInterfaceStats(path string) (*DomainInterfaceStats, error) {
 	cPath := C.CString(path)
 	defer C.free(unsafe.Pointer(cPath))
 
@@ -1811,7 +1839,7 @@ func (d *Domain) InterfaceStats(path string) (*DomainInterfaceStats, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMemoryStats
-func (d *Domain) MemoryStats(nrStats uint32, flags uint32) ([]DomainMemoryStat, error) {
+func (d *Domain) doMemoryStats(nrStats uint32, flags uint32) ([]DomainMemoryStat, error) {
 	ptr := make([]C.virDomainMemoryStatStruct, nrStats)
 
 	var err C.virError
@@ -1838,7 +1866,7 @@ func (d *Domain) MemoryStats(nrStats uint32, flags uint32) ([]DomainMemoryStat,
 // Contrary to the native C API behaviour, the Go API will
 // acquire a reference on the returned Connect, which must
 // be released by calling Close()
-func (d *Domain) DomainGetConnect() (*Connect, error) {
+func (d *Domain) doDomainGetConnect() (*Connect, error) {
 	var err C.virError
 	ptr := C.virDomainGetConnectWrapper(d.ptr, &err)
 	if ptr == nil {
@@ -1854,10 +1882,14 @@ func (d *Domain) DomainGetConnect() (*Connect, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetVcpus
-func (d *Domain) GetVcpus() ([]DomainVcpuInfo, error) {
+func (d *Domain) doGetVcpus() ([]DomainVcpuInfo, error) {
 	var cnodeinfo C.virNodeInfo
 	var err C.virError
-	ret := C.virNodeGetInfoWrapper(C.virDomainGetConnect(d.ptr), &cnodeinfo, &err)
+	cconn := C.virDomainGetConnectWrapper(d.ptr, &err)
+	if cconn == nil {
+		return []DomainVcpuInfo{}, makeError(&err)
+	}
+	ret := C.virNodeGetInfoWrapper(cconn, &cnodeinfo, &err)
 	if ret == -1 {
 		return []DomainVcpuInfo{}, makeError(&err)
 	}
@@ -1902,7 +1934,7 @@ func (d *Domain) GetVcpus() ([]DomainVcpuInfo, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetVcpusFlags
-func (d *Domain) GetVcpusFlags(flags DomainVcpuFlags) (int32, error) {
+func (d *Domain) doGetVcpusFlags(flags DomainVcpuFlags) (int32, error) {
 	var err C.virError
 	result := C.virDomainGetVcpusFlagsWrapper(d.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -1912,7 +1944,7 @@ func (d *Domain) GetVcpusFlags(flags DomainVcpuFlags) (int32, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainPinVcpu
-func (d *Domain) PinVcpu(vcpu uint, cpuMap []bool) error {
+func (d *Domain) doPinVcpu(vcpu uint, cpuMap []bool) error {
 	maplen := (len(cpuMap) + 7) / 8
 	ccpumap := make([]C.uchar, maplen)
 	for i := 0; i < len(cpuMap); i++ {
@@ -1934,7 +1966,7 @@ func (d *Domain) PinVcpu(vcpu uint, cpuMap []bool) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainPinVcpuFlags
-func (d *Domain) PinVcpuFlags(vcpu uint, cpuMap []bool, flags DomainModificationImpact) error {
+func (d *Domain) doPinVcpuFlags(vcpu uint, cpuMap []bool, flags DomainModificationImpact) error {
 	maplen := (len(cpuMap) + 7) / 8
 	ccpumap := make([]C.uchar, maplen)
 	for i := 0; i < len(cpuMap); i++ {
@@ -1968,7 +2000,7 @@ type DomainInterface struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainInterfaceAddresses
-func (d *Domain) ListAllInterfaceAddresses(src DomainInterfaceAddressesSource) ([]DomainInterface, error) {
+func (d *Domain) doListAllInterfaceAddresses(src DomainInterfaceAddressesSource) ([]DomainInterface, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002014 {
 		return []DomainInterface{}, makeNotImplementedError("virDomainInterfaceAddresses")
 	}
@@ -2009,18 +2041,19 @@ func (d *Domain) ListAllInterfaceAddresses(src DomainInterfaceAddressesSource) (
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotCurrent
-func (d *Domain) SnapshotCurrent(flags uint32) (*DomainSnapshot, error) {
+func (d *Domain) doSnapshotCurrent(flags uint32) (*DomainSnapshot, error) {
 	var err C.virError
 	result := C.virDomainSnapshotCurrentWrapper(d.ptr, C.uint(flags), &err)
 	if result == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("DomainSnapshot", unsafe.Pointer(result))
 	return &DomainSnapshot{ptr: result}, nil
 
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotNum
-func (d *Domain) SnapshotNum(flags DomainSnapshotListFlags) (int, error) {
+func (d *Domain) doSnapshotNum(flags DomainSnapshotListFlags) (int, error) {
 	var err C.virError
 	result := int(C.virDomainSnapshotNumWrapper(d.ptr, C.uint(flags), &err))
 	if result == -1 {
@@ -2030,7 +2063,7 @@ func (d *Domain) SnapshotNum(flags DomainSnapshotListFlags) (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotLookupByName
-func (d *Domain) SnapshotLookupByName(name string, flags uint32) (*DomainSnapshot, error) {
+func (d *Domain) doSnapshotLookupByName(name string, flags uint32) (*DomainSnapshot, error) {
 	cName := C.CString(name)
 	defer C.free(unsafe.Pointer(cName))
 	var err C.virError
@@ -2038,11 +2071,12 @@ func (d *Domain) SnapshotLookupByName(name string, flags uint32) (*DomainSnapsho
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("DomainSnapshot", unsafe.Pointer(ptr))
 	return &DomainSnapshot{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-checkpoint.html#virDomainCheckpointLookupByName
-func (d *Domain) CheckpointLookupByName(name string, flags uint32) (*DomainCheckpoint, error) {
+func (d *Domain) doCheckpointLookupByName(name string, flags uint32) (*DomainCheckpoint, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5006000 {
 		return nil, makeNotImplementedError("virDomainCheckpointLookupByName")
 	}
@@ -2054,11 +2088,12 @@ func (d *Domain) CheckpointLookupByName(name string, flags uint32) (*DomainCheck
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("DomainCheckpoint", unsafe.Pointer(ptr))
 	return &DomainCheckpoint{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotListNames
-func (d *Domain) SnapshotListNames(flags DomainSnapshotListFlags) ([]string, error) {
+func (d *Domain) doSnapshotListNames(flags DomainSnapshotListFlags) ([]string, error) {
 	const maxNames = 1024
 	var names [maxNames](*C.char)
 	namesPtr := unsafe.Pointer(&names)
@@ -2079,7 +2114,7 @@ func (d *Domain) SnapshotListNames(flags DomainSnapshotListFlags) ([]string, err
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainListAllSnapshots
-func (d *Domain) ListAllSnapshots(flags DomainSnapshotListFlags) ([]DomainSnapshot, error) {
+func (d *Domain) doListAllSnapshots(flags DomainSnapshotListFlags) ([]DomainSnapshot, error) {
 	var cList *C.virDomainSnapshotPtr
 	var err C.virError
 	numVols := C.virDomainListAllSnapshotsWrapper(d.ptr, (**C.virDomainSnapshotPtr)(&cList), C.uint(flags), &err)
@@ -2094,6 +2129,7 @@ func (d *Domain) ListAllSnapshots(flags DomainSnapshotListFlags) ([]DomainSnapsh
 	var pools []DomainSnapshot
 	slice := *(*[]C.virDomainSnapshotPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("DomainSnapshot", unsafe.Pointer(ptr))
 		pools = append(pools, DomainSnapshot{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -2101,7 +2137,7 @@ func (d *Domain) ListAllSnapshots(flags DomainSnapshotListFlags) ([]DomainSnapsh
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-checkpoint.html#virDomainListAllCheckpoints
-func (d *Domain) ListAllCheckpoints(flags DomainCheckpointListFlags) ([]DomainCheckpoint, error) {
+func (d *Domain) doListAllCheckpoints(flags DomainCheckpointListFlags) ([]DomainCheckpoint, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5006000 {
 		return []DomainCheckpoint{}, makeNotImplementedError("virDomainListAllCheckpoints")
 	}
@@ -2120,6 +2156,7 @@ func (d *Domain) ListAllCheckpoints(flags DomainCheckpointListFlags) ([]DomainCh
 	var cps []DomainCheckpoint
 	slice := *(*[]C.virDomainCheckpointPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("DomainCheckpoint", unsafe.Pointer(ptr))
 		cps = append(cps, DomainCheckpoint{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -2127,7 +2164,7 @@ func (d *Domain) ListAllCheckpoints(flags DomainCheckpointListFlags) ([]DomainCh
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBlockCommit
-func (d *Domain) BlockCommit(disk string, base string, top string, bandwidth uint64, flags DomainBlockCommitFlags) error {
+func (d *Domain) doBlockCommit(disk string, base string, top string, bandwidth uint64, flags DomainBlockCommitFlags) error {
 	cdisk := C.CString(disk)
 	defer C.free(unsafe.Pointer(cdisk))
 	var cbase *C.char
@@ -2175,7 +2212,7 @@ func getBlockCopyParameterFieldInfo(params *DomainBlockCopyParameters) map[strin
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBlockCopy
-func (d *Domain) BlockCopy(disk string, destxml string, params *DomainBlockCopyParameters, flags DomainBlockCopyFlags) error {
+func (d *Domain) doBlockCopy(disk string, destxml string, params *DomainBlockCopyParameters, flags DomainBlockCopyFlags) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002008 {
 		return makeNotImplementedError("virDomainBlockCopy")
 	}
@@ -2191,7 +2228,7 @@ func (d *Domain) BlockCopy(disk string, destxml string, params *DomainBlockCopyP
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainBlockCopyWrapper(d.ptr, cdisk, cdestxml, cparams, cnparams, C.uint(flags), &err)
@@ -2203,7 +2240,7 @@ func (d *Domain) BlockCopy(disk string, destxml string, params *DomainBlockCopyP
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBlockJobAbort
-func (d *Domain) BlockJobAbort(disk string, flags DomainBlockJobAbortFlags) error {
+func (d *Domain) doBlockJobAbort(disk string, flags DomainBlockJobAbortFlags) error {
 	cdisk := C.CString(disk)
 	defer C.free(unsafe.Pointer(cdisk))
 	var err C.virError
@@ -2215,7 +2252,7 @@ func (d *Domain) BlockJobAbort(disk string, flags DomainBlockJobAbortFlags) erro
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBlockJobSetSpeed
-func (d *Domain) BlockJobSetSpeed(disk string, bandwidth uint64, flags DomainBlockJobSetSpeedFlags) error {
+func (d *Domain) doBlockJobSetSpeed(disk string, bandwidth uint64, flags DomainBlockJobSetSpeedFlags) error {
 	cdisk := C.CString(disk)
 	defer C.free(unsafe.Pointer(cdisk))
 	var err C.virError
@@ -2227,7 +2264,7 @@ func (d *Domain) BlockJobSetSpeed(disk string, bandwidth uint64, flags DomainBlo
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBlockPull
-func (d *Domain) BlockPull(disk string, bandwidth uint64, flags DomainBlockPullFlags) error {
+func (d *Domain) doBlockPull(disk string, bandwidth uint64, flags DomainBlockPullFlags) error {
 	cdisk := C.CString(disk)
 	defer C.free(unsafe.Pointer(cdisk))
 	var err C.virError
@@ -2239,7 +2276,7 @@ func (d *Domain) BlockPull(disk string, bandwidth uint64, flags DomainBlockPullF
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBlockRebase
-func (d *Domain) BlockRebase(disk string, base string, bandwidth uint64, flags DomainBlockRebaseFlags) error {
+func (d *Domain) doBlockRebase(disk string, base string, bandwidth uint64, flags DomainBlockRebaseFlags) error {
 	cdisk := C.CString(disk)
 	defer C.free(unsafe.Pointer(cdisk))
 	var cbase *C.char
@@ -2256,7 +2293,7 @@ func (d *Domain) BlockRebase(disk string, base string, bandwidth uint64, flags D
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBlockResize
-func (d *Domain) BlockResize(disk string, size uint64, flags DomainBlockResizeFlags) error {
+func (d *Domain) doBlockResize(disk string, size uint64, flags DomainBlockResizeFlags) error {
 	cdisk := C.CString(disk)
 	defer C.free(unsafe.Pointer(cdisk))
 	var err C.virError
@@ -2268,7 +2305,7 @@ func (d *Domain) BlockResize(disk string, size uint64, flags DomainBlockResizeFl
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBlockPeek
-func (d *Domain) BlockPeek(disk string, offset uint64, size uint64, flags uint32) ([]byte, error) {
+func (d *Domain) doBlockPeek(disk string, offset uint64, size uint64, flags uint32) ([]byte, error) {
 	cdisk := C.CString(disk)
 	defer C.free(unsafe.Pointer(cdisk))
 	data := make([]byte, size)
@@ -2283,7 +2320,7 @@ func (d *Domain) BlockPeek(disk string, offset uint64, size uint64, flags uint32
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMemoryPeek
-func (d *Domain) MemoryPeek(start uint64, size uint64, flags DomainMemoryFlags) ([]byte, error) {
+func (d *Domain) doMemoryPeek(start uint64, size uint64, flags DomainMemoryFlags) ([]byte, error) {
 	data := make([]byte, size)
 	var err C.virError
 	ret := C.virDomainMemoryPeekWrapper(d.ptr, C.ulonglong(start), C.size_t(size),
@@ -2296,7 +2333,7 @@ func (d *Domain) MemoryPeek(start uint64, size uint64, flags DomainMemoryFlags)
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrate
-func (d *Domain) Migrate(dconn *Connect, flags DomainMigrateFlags, dname string, uri string, bandwidth uint64) (*Domain, error) {
+func (d *Domain) doMigrate(dconn *Connect, flags DomainMigrateFlags, dname string, uri string, bandwidth uint64) (*Domain, error) {
 	var cdname *C.char
 	if dname != "" {
 		cdname = C.CString(dname)
@@ -2314,13 +2351,14 @@ func (d *Domain) Migrate(dconn *Connect, flags DomainMigrateFlags, dname string,
 		return nil, makeError(&err)
 	}
 
+	trackHandle("Domain", unsafe.Pointer(ret))
 	return &Domain{
 		ptr: ret,
 	}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrate2
-func (d *Domain) Migrate2(dconn *Connect, dxml string, flags DomainMigrateFlags, dname string, uri string, bandwidth uint64) (*Domain, error) {
+func (d *Domain) doMigrate2(dconn *Connect, dxml string, flags DomainMigrateFlags, dname string, uri string, bandwidth uint64) (*Domain, error) {
 	var cdxml *C.char
 	if dxml != "" {
 		cdxml = C.CString(dxml)
@@ -2343,6 +2381,7 @@ func (d *Domain) Migrate2(dconn *Connect, dxml string, flags DomainMigrateFlags,
 		return nil, makeError(&err)
 	}
 
+	trackHandle("Domain", unsafe.Pointer(ret))
 	return &Domain{
 		ptr: ret,
 	}, nil
@@ -2469,7 +2508,7 @@ func getMigrateParameterFieldInfo(params *DomainMigrateParameters) map[string]ty
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrate3
-func (d *Domain) Migrate3(dconn *Connect, params *DomainMigrateParameters, flags DomainMigrateFlags) (*Domain, error) {
+func (d *Domain) doMigrate3(dconn *Connect, params *DomainMigrateParameters, flags DomainMigrateFlags) (*Domain, error) {
 
 	info := getMigrateParameterFieldInfo(params)
 	cparams, cnparams, gerr := typedParamsPackNew(info)
@@ -2477,7 +2516,7 @@ func (d *Domain) Migrate3(dconn *Connect, params *DomainMigrateParameters, flags
 		return nil, gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainMigrate3Wrapper(d.ptr, dconn.ptr, cparams, C.uint(cnparams), C.uint(flags), &err)
@@ -2485,13 +2524,14 @@ func (d *Domain) Migrate3(dconn *Connect, params *DomainMigrateParameters, flags
 		return nil, makeError(&err)
 	}
 
+	trackHandle("Domain", unsafe.Pointer(ret))
 	return &Domain{
 		ptr: ret,
 	}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrateToURI
-func (d *Domain) MigrateToURI(duri string, flags DomainMigrateFlags, dname string, bandwidth uint64) error {
+func (d *Domain) doMigrateToURI(duri string, flags DomainMigrateFlags, dname string, bandwidth uint64) error {
 	cduri := C.CString(duri)
 	defer C.free(unsafe.Pointer(cduri))
 
@@ -2511,7 +2551,7 @@ func (d *Domain) MigrateToURI(duri string, flags DomainMigrateFlags, dname strin
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrateToURI2
-func (d *Domain) MigrateToURI2(dconnuri string, miguri string, dxml string, flags DomainMigrateFlags, dname string, bandwidth uint64) error {
+func (d *Domain) doMigrateToURI2(dconnuri string, miguri string, dxml string, flags DomainMigrateFlags, dname string, bandwidth uint64) error {
 	var cdconnuri *C.char
 	if dconnuri != "" {
 		cdconnuri = C.CString(dconnuri)
@@ -2543,7 +2583,7 @@ func (d *Domain) MigrateToURI2(dconnuri string, miguri string, dxml string, flag
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrateToURI3
-func (d *Domain) MigrateToURI3(dconnuri string, params *DomainMigrateParameters, flags DomainMigrateFlags) error {
+func (d *Domain) doMigrateToURI3(dconnuri string, params *DomainMigrateParameters, flags DomainMigrateFlags) error {
 	var cdconnuri *C.char
 	if dconnuri != "" {
 		cdconnuri = C.CString(dconnuri)
@@ -2556,7 +2596,7 @@ func (d *Domain) MigrateToURI3(dconnuri string, params *DomainMigrateParameters,
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainMigrateToURI3Wrapper(d.ptr, cdconnuri, cparams, C.uint(cnparams), C.uint(flags), &err)
@@ -2568,7 +2608,7 @@ func (d *Domain) MigrateToURI3(dconnuri string, params *DomainMigrateParameters,
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrateGetCompressionCache
-func (d *Domain) MigrateGetCompressionCache(flags uint32) (uint64, error) {
+func (d *Domain) doMigrateGetCompressionCache(flags uint32) (uint64, error) {
 	var cacheSize C.ulonglong
 
 	var err C.virError
@@ -2581,7 +2621,7 @@ func (d *Domain) MigrateGetCompressionCache(flags uint32) (uint64, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrateSetCompressionCache
-func (d *Domain) MigrateSetCompressionCache(size uint64, flags uint32) error {
+func (d *Domain) doMigrateSetCompressionCache(size uint64, flags uint32) error {
 	var err C.virError
 	ret := C.virDomainMigrateSetCompressionCacheWrapper(d.ptr, C.ulonglong(size), C.uint(flags), &err)
 	if ret == -1 {
@@ -2592,7 +2632,7 @@ func (d *Domain) MigrateSetCompressionCache(size uint64, flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrateGetMaxSpeed
-func (d *Domain) MigrateGetMaxSpeed(flags DomainMigrateMaxSpeedFlags) (uint64, error) {
+func (d *Domain) doMigrateGetMaxSpeed(flags DomainMigrateMaxSpeedFlags) (uint64, error) {
 	var maxSpeed C.ulong
 
 	var err C.virError
@@ -2605,7 +2645,7 @@ func (d *Domain) MigrateGetMaxSpeed(flags DomainMigrateMaxSpeedFlags) (uint64, e
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrateSetMaxSpeed
-func (d *Domain) MigrateSetMaxSpeed(speed uint64, flags DomainMigrateMaxSpeedFlags) error {
+func (d *Domain) doMigrateSetMaxSpeed(speed uint64, flags DomainMigrateMaxSpeedFlags) error {
 	var err C.virError
 	ret := C.virDomainMigrateSetMaxSpeedWrapper(d.ptr, C.ulong(speed), C.uint(flags), &err)
 	if ret == -1 {
@@ -2616,7 +2656,7 @@ func (d *Domain) MigrateSetMaxSpeed(speed uint64, flags DomainMigrateMaxSpeedFla
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrateSetMaxDowntime
-func (d *Domain) MigrateSetMaxDowntime(downtime uint64, flags uint32) error {
+func (d *Domain) doMigrateSetMaxDowntime(downtime uint64, flags uint32) error {
 	var err C.virError
 	ret := C.virDomainMigrateSetMaxDowntimeWrapper(d.ptr, C.ulonglong(downtime), C.uint(flags), &err)
 	if ret == -1 {
@@ -2627,7 +2667,7 @@ func (d *Domain) MigrateSetMaxDowntime(downtime uint64, flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrateGetMaxDowntime
-func (d *Domain) MigrateGetMaxDowntime(flags uint32) (uint64, error) {
+func (d *Domain) doMigrateGetMaxDowntime(flags uint32) (uint64, error) {
 	var downtimeLen C.ulonglong
 
 	if C.LIBVIR_VERSION_NUMBER < 3007000 {
@@ -2644,7 +2684,7 @@ func (d *Domain) MigrateGetMaxDowntime(flags uint32) (uint64, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainMigrateStartPostCopy
-func (d *Domain) MigrateStartPostCopy(flags uint32) error {
+func (d *Domain) doMigrateStartPostCopy(flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 1003003 {
 		return makeNotImplementedError("virDomainMigrateStartPostCopy")
 	}
@@ -2703,7 +2743,7 @@ func getBlkioParametersFieldInfo(params *DomainBlkioParameters) map[string]typed
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetBlkioParameters
-func (d *Domain) GetBlkioParameters(flags DomainModificationImpact) (*DomainBlkioParameters, error) {
+func (d *Domain) doGetBlkioParameters(flags DomainModificationImpact) (*DomainBlkioParameters, error) {
 	params := &DomainBlkioParameters{}
 	info := getBlkioParametersFieldInfo(params)
 
@@ -2715,7 +2755,7 @@ func (d *Domain) GetBlkioParameters(flags DomainModificationImpact) (*DomainBlki
 	}
 
 	cparams := typedParamsNew(cnparams)
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 	ret = C.virDomainGetBlkioParametersWrapper(d.ptr, cparams, &cnparams, C.uint(flags), &err)
 	if ret == -1 {
 		return nil, makeError(&err)
@@ -2730,7 +2770,7 @@ func (d *Domain) GetBlkioParameters(flags DomainModificationImpact) (*DomainBlki
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetBlkioParameters
-func (d *Domain) SetBlkioParameters(params *DomainBlkioParameters, flags DomainModificationImpact) error {
+func (d *Domain) doSetBlkioParameters(params *DomainBlkioParameters, flags DomainModificationImpact) error {
 	info := getBlkioParametersFieldInfo(params)
 
 	cparams, cnparams, gerr := typedParamsPackNew(info)
@@ -2738,7 +2778,7 @@ func (d *Domain) SetBlkioParameters(params *DomainBlkioParameters, flags DomainM
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainSetBlkioParametersWrapper(d.ptr, cparams, cnparams, C.uint(flags), &err)
@@ -2878,7 +2918,7 @@ func getBlockIoTuneParametersFieldInfo(params *DomainBlockIoTuneParameters) map[
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetBlockIoTune
-func (d *Domain) GetBlockIoTune(disk string, flags DomainModificationImpact) (*DomainBlockIoTuneParameters, error) {
+func (d *Domain) doGetBlockIoTune(disk string, flags DomainModificationImpact) (*DomainBlockIoTuneParameters, error) {
 	cdisk := C.CString(disk)
 	defer C.free(unsafe.Pointer(cdisk))
 
@@ -2893,7 +2933,7 @@ func (d *Domain) GetBlockIoTune(disk string, flags DomainModificationImpact) (*D
 	}
 
 	cparams := typedParamsNew(cnparams)
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 	ret = C.virDomainGetBlockIoTuneWrapper(d.ptr, cdisk, cparams, &cnparams, C.uint(flags), &err)
 	if ret == -1 {
 		return nil, makeError(&err)
@@ -2908,7 +2948,7 @@ func (d *Domain) GetBlockIoTune(disk string, flags DomainModificationImpact) (*D
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetBlockIoTune
-func (d *Domain) SetBlockIoTune(disk string, params *DomainBlockIoTuneParameters, flags DomainModificationImpact) error {
+func (d *Domain) doSetBlockIoTune(disk string, params *DomainBlockIoTuneParameters, flags DomainModificationImpact) error {
 	cdisk := C.CString(disk)
 	defer C.free(unsafe.Pointer(cdisk))
 
@@ -2919,7 +2959,7 @@ func (d *Domain) SetBlockIoTune(disk string, params *DomainBlockIoTuneParameters
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainSetBlockIoTuneWrapper(d.ptr, cdisk, cparams, cnparams, C.uint(flags), &err)
@@ -2938,7 +2978,7 @@ type DomainBlockJobInfo struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetBlockJobInfo
-func (d *Domain) GetBlockJobInfo(disk string, flags DomainBlockJobInfoFlags) (*DomainBlockJobInfo, error) {
+func (d *Domain) doGetBlockJobInfo(disk string, flags DomainBlockJobInfoFlags) (*DomainBlockJobInfo, error) {
 	cdisk := C.CString(disk)
 	defer C.free(unsafe.Pointer(cdisk))
 
@@ -2966,7 +3006,7 @@ type DomainControlInfo struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetControlInfo
-func (d *Domain) GetControlInfo(flags uint32) (*DomainControlInfo, error) {
+func (d *Domain) doGetControlInfo(flags uint32) (*DomainControlInfo, error) {
 
 	var cinfo C.virDomainControlInfo
 
@@ -2989,7 +3029,7 @@ type DomainDiskError struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetDiskErrors
-func (d *Domain) GetDiskErrors(flags uint32) ([]DomainDiskError, error) {
+func (d *Domain) doGetDiskErrors(flags uint32) ([]DomainDiskError, error) {
 	var err C.virError
 	ret := C.virDomainGetDiskErrorsWrapper(d.ptr, nil, 0, 0, &err)
 	if ret == -1 {
@@ -3018,7 +3058,7 @@ func (d *Domain) GetDiskErrors(flags uint32) ([]DomainDiskError, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetHostname
-func (d *Domain) GetHostname(flags DomainGetHostnameFlags) (string, error) {
+func (d *Domain) doGetHostname(flags DomainGetHostnameFlags) (string, error) {
 	var err C.virError
 	ret := C.virDomainGetHostnameWrapper(d.ptr, C.uint(flags), &err)
 	if ret == nil {
@@ -3105,7 +3145,7 @@ type DomainJobInfo struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetJobInfo
-func (d *Domain) GetJobInfo() (*DomainJobInfo, error) {
+func (d *Domain) doGetJobInfo() (*DomainJobInfo, error) {
 	var cinfo C.virDomainJobInfo
 
 	var err C.virError
@@ -3287,7 +3327,7 @@ func getDomainJobInfoFieldInfo(params *DomainJobInfo) map[string]typedParamsFiel
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetJobStats
-func (d *Domain) GetJobStats(flags DomainGetJobStatsFlags) (*DomainJobInfo, error) {
+func (d *Domain) doGetJobStats(flags DomainGetJobStatsFlags) (*DomainJobInfo, error) {
 	var cparams C.virTypedParameterPtr
 	var cnparams C.int
 	var jobtype C.int
@@ -3296,7 +3336,7 @@ func (d *Domain) GetJobStats(flags DomainGetJobStatsFlags) (*DomainJobInfo, erro
 	if ret == -1 {
 		return nil, makeError(&err)
 	}
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	params := DomainJobInfo{}
 	info := getDomainJobInfoFieldInfo(&params)
@@ -3310,7 +3350,7 @@ func (d *Domain) GetJobStats(flags DomainGetJobStatsFlags) (*DomainJobInfo, erro
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetMaxMemory
-func (d *Domain) GetMaxMemory() (uint64, error) {
+func (d *Domain) doGetMaxMemory() (uint64, error) {
 	var err C.virError
 	ret := C.virDomainGetMaxMemoryWrapper(d.ptr, &err)
 	if ret == 0 {
@@ -3321,7 +3361,7 @@ func (d *Domain) GetMaxMemory() (uint64, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetMaxVcpus
-func (d *Domain) GetMaxVcpus() (uint, error) {
+func (d *Domain) doGetMaxVcpus() (uint, error) {
 	var err C.virError
 	ret := C.virDomainGetMaxVcpusWrapper(d.ptr, &err)
 	if ret == -1 {
@@ -3332,7 +3372,7 @@ func (d *Domain) GetMaxVcpus() (uint, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetOSType
-func (d *Domain) GetOSType() (string, error) {
+func (d *Domain) doGetOSType() (string, error) {
 	var err C.virError
 	ret := C.virDomainGetOSTypeWrapper(d.ptr, &err)
 	if ret == nil {
@@ -3377,7 +3417,7 @@ func getDomainMemoryParametersFieldInfo(params *DomainMemoryParameters) map[stri
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetMemoryParameters
-func (d *Domain) GetMemoryParameters(flags DomainModificationImpact) (*DomainMemoryParameters, error) {
+func (d *Domain) doGetMemoryParameters(flags DomainModificationImpact) (*DomainMemoryParameters, error) {
 	params := &DomainMemoryParameters{}
 	info := getDomainMemoryParametersFieldInfo(params)
 
@@ -3389,7 +3429,7 @@ func (d *Domain) GetMemoryParameters(flags DomainModificationImpact) (*DomainMem
 	}
 
 	cparams := typedParamsNew(cnparams)
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 	ret = C.virDomainGetMemoryParametersWrapper(d.ptr, cparams, &cnparams, C.uint(flags), &err)
 	if ret == -1 {
 		return nil, makeError(&err)
@@ -3404,7 +3444,7 @@ func (d *Domain) GetMemoryParameters(flags DomainModificationImpact) (*DomainMem
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetMemoryParameters
-func (d *Domain) SetMemoryParameters(params *DomainMemoryParameters, flags DomainModificationImpact) error {
+func (d *Domain) doSetMemoryParameters(params *DomainMemoryParameters, flags DomainModificationImpact) error {
 	info := getDomainMemoryParametersFieldInfo(params)
 
 	cparams, cnparams, gerr := typedParamsPackNew(info)
@@ -3412,7 +3452,7 @@ func (d *Domain) SetMemoryParameters(params *DomainMemoryParameters, flags Domai
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainSetMemoryParametersWrapper(d.ptr, cparams, cnparams, C.uint(flags), &err)
@@ -3444,7 +3484,7 @@ func getDomainNumaParametersFieldInfo(params *DomainNumaParameters) map[string]t
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetNumaParameters
-func (d *Domain) GetNumaParameters(flags DomainModificationImpact) (*DomainNumaParameters, error) {
+func (d *Domain) doGetNumaParameters(flags DomainModificationImpact) (*DomainNumaParameters, error) {
 	params := &DomainNumaParameters{}
 	info := getDomainNumaParametersFieldInfo(params)
 
@@ -3456,7 +3496,7 @@ func (d *Domain) GetNumaParameters(flags DomainModificationImpact) (*DomainNumaP
 	}
 
 	cparams := typedParamsNew(cnparams)
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 	ret = C.virDomainGetNumaParametersWrapper(d.ptr, cparams, &cnparams, C.uint(flags), &err)
 	if ret == -1 {
 		return nil, makeError(&err)
@@ -3471,7 +3511,7 @@ func (d *Domain) GetNumaParameters(flags DomainModificationImpact) (*DomainNumaP
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetNumaParameters
-func (d *Domain) SetNumaParameters(params *DomainNumaParameters, flags DomainModificationImpact) error {
+func (d *Domain) doSetNumaParameters(params *DomainNumaParameters, flags DomainModificationImpact) error {
 	info := getDomainNumaParametersFieldInfo(params)
 
 	cparams, cnparams, gerr := typedParamsPackNew(info)
@@ -3479,7 +3519,7 @@ func (d *Domain) SetNumaParameters(params *DomainNumaParameters, flags DomainMod
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainSetNumaParametersWrapper(d.ptr, cparams, cnparams, C.uint(flags), &err)
@@ -3633,7 +3673,7 @@ func getDomainPerfEventsFieldInfo(params *DomainPerfEvents) map[string]typedPara
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetPerfEvents
-func (d *Domain) GetPerfEvents(flags DomainModificationImpact) (*DomainPerfEvents, error) {
+func (d *Domain) doGetPerfEvents(flags DomainModificationImpact) (*DomainPerfEvents, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1003003 {
 		return nil, makeNotImplementedError("virDomainGetPerfEvents")
 	}
@@ -3649,7 +3689,7 @@ func (d *Domain) GetPerfEvents(flags DomainModificationImpact) (*DomainPerfEvent
 		return nil, makeError(&err)
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	_, gerr := typedParamsUnpack(cparams, cnparams, info)
 	if gerr != nil {
@@ -3660,7 +3700,7 @@ func (d *Domain) GetPerfEvents(flags DomainModificationImpact) (*DomainPerfEvent
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetPerfEvents
-func (d *Domain) SetPerfEvents(params *DomainPerfEvents, flags DomainModificationImpact) error {
+func (d *Domain) doSetPerfEvents(params *DomainPerfEvents, flags DomainModificationImpact) error {
 	if C.LIBVIR_VERSION_NUMBER < 1003003 {
 		return makeNotImplementedError("virDomainSetPerfEvents")
 	}
@@ -3671,7 +3711,7 @@ func (d *Domain) SetPerfEvents(params *DomainPerfEvents, flags DomainModificatio
 	if gerr != nil {
 		return gerr
 	}
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainSetPerfEventsWrapper(d.ptr, cparams, cnparams, C.uint(flags), &err)
@@ -3776,7 +3816,7 @@ func getDomainSchedulerParametersFieldInfo(params *DomainSchedulerParameters) ma
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetSchedulerParameters
-func (d *Domain) GetSchedulerParameters() (*DomainSchedulerParameters, error) {
+func (d *Domain) doGetSchedulerParameters() (*DomainSchedulerParameters, error) {
 	params := &DomainSchedulerParameters{}
 	info := getDomainSchedulerParametersFieldInfo(params)
 
@@ -3795,7 +3835,7 @@ func (d *Domain) GetSchedulerParameters() (*DomainSchedulerParameters, error) {
 	}
 
 	cparams := typedParamsNew(cnparams)
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 	ret := C.virDomainGetSchedulerParametersWrapper(d.ptr, cparams, &cnparams, &err)
 	if ret == -1 {
 		return nil, makeError(&err)
@@ -3810,7 +3850,7 @@ func (d *Domain) GetSchedulerParameters() (*DomainSchedulerParameters, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetSchedulerParametersFlags
-func (d *Domain) GetSchedulerParametersFlags(flags DomainModificationImpact) (*DomainSchedulerParameters, error) {
+func (d *Domain) doGetSchedulerParametersFlags(flags DomainModificationImpact) (*DomainSchedulerParameters, error) {
 	params := &DomainSchedulerParameters{}
 	info := getDomainSchedulerParametersFieldInfo(params)
 
@@ -3829,7 +3869,7 @@ func (d *Domain) GetSchedulerParametersFlags(flags DomainModificationImpact) (*D
 	}
 
 	cparams := typedParamsNew(cnparams)
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 	ret := C.virDomainGetSchedulerParametersFlagsWrapper(d.ptr, cparams, &cnparams, C.uint(flags), &err)
 	if ret == -1 {
 		return nil, makeError(&err)
@@ -3844,7 +3884,7 @@ func (d *Domain) GetSchedulerParametersFlags(flags DomainModificationImpact) (*D
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetSchedulerParameters
-func (d *Domain) SetSchedulerParameters(params *DomainSchedulerParameters) error {
+func (d *Domain) doSetSchedulerParameters(params *DomainSchedulerParameters) error {
 	info := getDomainSchedulerParametersFieldInfo(params)
 
 	cparams, cnparams, gerr := typedParamsPackNew(info)
@@ -3852,7 +3892,7 @@ func (d *Domain) SetSchedulerParameters(params *DomainSchedulerParameters) error
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainSetSchedulerParametersWrapper(d.ptr, cparams, cnparams, &err)
@@ -3864,7 +3904,7 @@ func (d *Domain) SetSchedulerParameters(params *DomainSchedulerParameters) error
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetSchedulerParametersFlags
-func (d *Domain) SetSchedulerParametersFlags(params *DomainSchedulerParameters, flags DomainModificationImpact) error {
+func (d *Domain) doSetSchedulerParametersFlags(params *DomainSchedulerParameters, flags DomainModificationImpact) error {
 	info := getDomainSchedulerParametersFieldInfo(params)
 
 	cparams, cnparams, gerr := typedParamsPackNew(info)
@@ -3872,7 +3912,7 @@ func (d *Domain) SetSchedulerParametersFlags(params *DomainSchedulerParameters,
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainSetSchedulerParametersFlagsWrapper(d.ptr, cparams, cnparams, C.uint(flags), &err)
@@ -3889,7 +3929,7 @@ type SecurityLabel struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetSecurityLabel
-func (d *Domain) GetSecurityLabel() (*SecurityLabel, error) {
+func (d *Domain) doGetSecurityLabel() (*SecurityLabel, error) {
 	var clabel C.virSecurityLabel
 
 	var err C.virError
@@ -3905,7 +3945,7 @@ func (d *Domain) GetSecurityLabel() (*SecurityLabel, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetSecurityLabelList
-func (d *Domain) GetSecurityLabelList() ([]SecurityLabel, error) {
+func (d *Domain) doGetSecurityLabelList() ([]SecurityLabel, error) {
 	var clabels *C.virSecurityLabel
 
 	var err C.virError
@@ -3928,7 +3968,7 @@ func (d *Domain) GetSecurityLabelList() ([]SecurityLabel, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetTime
-func (d *Domain) GetTime(flags uint32) (int64, uint, error) {
+func (d *Domain) doGetTime(flags uint32) (int64, uint, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002005 {
 		return 0, 0, makeNotImplementedError("virDomainGetTime")
 	}
@@ -3944,7 +3984,7 @@ func (d *Domain) GetTime(flags uint32) (int64, uint, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetTime
-func (d *Domain) SetTime(secs int64, nsecs uint, flags DomainSetTimeFlags) error {
+func (d *Domain) doSetTime(secs int64, nsecs uint, flags DomainSetTimeFlags) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002005 {
 		return makeNotImplementedError("virDomainSetTime")
 	}
@@ -3959,7 +3999,7 @@ func (d *Domain) SetTime(secs int64, nsecs uint, flags DomainSetTimeFlags) error
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetUserPassword
-func (d *Domain) SetUserPassword(user string, password string, flags DomainSetUserPasswordFlags) error {
+func (d *Domain) doSetUserPassword(user string, password string, flags DomainSetUserPasswordFlags) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002015 {
 		return makeNotImplementedError("virDomainSetUserPassword")
 	}
@@ -3979,7 +4019,7 @@ func (d *Domain) SetUserPassword(user string, password string, flags DomainSetUs
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainManagedSave
-func (d *Domain) ManagedSave(flags DomainSaveRestoreFlags) error {
+func (d *Domain) doManagedSave(flags DomainSaveRestoreFlags) error {
 	var err C.virError
 	ret := C.virDomainManagedSaveWrapper(d.ptr, C.uint(flags), &err)
 	if ret == -1 {
@@ -3990,7 +4030,7 @@ func (d *Domain) ManagedSave(flags DomainSaveRestoreFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainHasManagedSaveImage
-func (d *Domain) HasManagedSaveImage(flags uint32) (bool, error) {
+func (d *Domain) doHasManagedSaveImage(flags uint32) (bool, error) {
 	var err C.virError
 	result := C.virDomainHasManagedSaveImageWrapper(d.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -4003,7 +4043,7 @@ func (d *Domain) HasManagedSaveImage(flags uint32) (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainManagedSaveRemove
-func (d *Domain) ManagedSaveRemove(flags uint32) error {
+func (d *Domain) doManagedSaveRemove(flags uint32) error {
 	var err C.virError
 	ret := C.virDomainManagedSaveRemoveWrapper(d.ptr, C.uint(flags), &err)
 	if ret == -1 {
@@ -4014,7 +4054,7 @@ func (d *Domain) ManagedSaveRemove(flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainRename
-func (d *Domain) Rename(name string, flags uint32) error {
+func (d *Domain) doRename(name string, flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002019 {
 		return makeNotImplementedError("virDomainRename")
 	}
@@ -4030,7 +4070,7 @@ func (d *Domain) Rename(name string, flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainReset
-func (d *Domain) Reset(flags uint32) error {
+func (d *Domain) doReset(flags uint32) error {
 	var err C.virError
 	ret := C.virDomainResetWrapper(d.ptr, C.uint(flags), &err)
 	if ret == -1 {
@@ -4041,7 +4081,7 @@ func (d *Domain) Reset(flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSendProcessSignal
-func (d *Domain) SendProcessSignal(pid int64, signum DomainProcessSignal, flags uint32) error {
+func (d *Domain) doSendProcessSignal(pid int64, signum DomainProcessSignal, flags uint32) error {
 	var err C.virError
 	ret := C.virDomainSendProcessSignalWrapper(d.ptr, C.longlong(pid), C.uint(signum), C.uint(flags), &err)
 	if ret == -1 {
@@ -4052,7 +4092,7 @@ func (d *Domain) SendProcessSignal(pid int64, signum DomainProcessSignal, flags
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainInjectNMI
-func (d *Domain) InjectNMI(flags uint32) error {
+func (d *Domain) doInjectNMI(flags uint32) error {
 	var err C.virError
 	ret := C.virDomainInjectNMIWrapper(d.ptr, C.uint(flags), &err)
 	if ret == -1 {
@@ -4063,7 +4103,7 @@ func (d *Domain) InjectNMI(flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainCoreDump
-func (d *Domain) CoreDump(to string, flags DomainCoreDumpFlags) error {
+func (d *Domain) doCoreDump(to string, flags DomainCoreDumpFlags) error {
 	cto := C.CString(to)
 	defer C.free(unsafe.Pointer(cto))
 
@@ -4077,7 +4117,7 @@ func (d *Domain) CoreDump(to string, flags DomainCoreDumpFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainCoreDumpWithFormat
-func (d *Domain) CoreDumpWithFormat(to string, format DomainCoreDumpFormat, flags DomainCoreDumpFlags) error {
+func (d *Domain) doCoreDumpWithFormat(to string, format DomainCoreDumpFormat, flags DomainCoreDumpFlags) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002003 {
 		makeNotImplementedError("virDomainCoreDumpWithFormat")
 	}
@@ -4094,7 +4134,7 @@ func (d *Domain) CoreDumpWithFormat(to string, format DomainCoreDumpFormat, flag
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainHasCurrentSnapshot
-func (d *Domain) HasCurrentSnapshot(flags uint32) (bool, error) {
+func (d *Domain) doHasCurrentSnapshot(flags uint32) (bool, error) {
 	var err C.virError
 	result := C.virDomainHasCurrentSnapshotWrapper(d.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -4107,7 +4147,7 @@ func (d *Domain) HasCurrentSnapshot(flags uint32) (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainFSFreeze
-func (d *Domain) FSFreeze(mounts []string, flags uint32) error {
+func (d *Domain) doFSFreeze(mounts []string, flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002005 {
 		return makeNotImplementedError("virDomainFSFreeze")
 	}
@@ -4129,7 +4169,7 @@ func (d *Domain) FSFreeze(mounts []string, flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainFSThaw
-func (d *Domain) FSThaw(mounts []string, flags uint32) error {
+func (d *Domain) doFSThaw(mounts []string, flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002005 {
 		return makeNotImplementedError("virDomainFSThaw")
 	}
@@ -4151,7 +4191,7 @@ func (d *Domain) FSThaw(mounts []string, flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainFSTrim
-func (d *Domain) FSTrim(mount string, minimum uint64, flags uint32) error {
+func (d *Domain) doFSTrim(mount string, minimum uint64, flags uint32) error {
 	var cmount *C.char
 	if mount != "" {
 		cmount := C.CString(mount)
@@ -4175,7 +4215,7 @@ type DomainFSInfo struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetFSInfo
-func (d *Domain) GetFSInfo(flags uint32) ([]DomainFSInfo, error) {
+func (d *Domain) doGetFSInfo(flags uint32) ([]DomainFSInfo, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002011 {
 		return []DomainFSInfo{}, makeNotImplementedError("virDomainGetFSInfo")
 	}
@@ -4212,7 +4252,7 @@ func (d *Domain) GetFSInfo(flags uint32) ([]DomainFSInfo, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainPMSuspendForDuration
-func (d *Domain) PMSuspendForDuration(target NodeSuspendTarget, duration uint64, flags uint32) error {
+func (d *Domain) doPMSuspendForDuration(target NodeSuspendTarget, duration uint64, flags uint32) error {
 	var err C.virError
 	ret := C.virDomainPMSuspendForDurationWrapper(d.ptr, C.uint(target), C.ulonglong(duration), C.uint(flags), &err)
 	if ret == -1 {
@@ -4223,7 +4263,7 @@ func (d *Domain) PMSuspendForDuration(target NodeSuspendTarget, duration uint64,
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainPMWakeup
-func (d *Domain) PMWakeup(flags uint32) error {
+func (d *Domain) doPMWakeup(flags uint32) error {
 	var err C.virError
 	ret := C.virDomainPMWakeupWrapper(d.ptr, C.uint(flags), &err)
 	if ret == -1 {
@@ -4234,7 +4274,7 @@ func (d *Domain) PMWakeup(flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainAddIOThread
-func (d *Domain) AddIOThread(id uint, flags DomainModificationImpact) error {
+func (d *Domain) doAddIOThread(id uint, flags DomainModificationImpact) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002015 {
 		return makeNotImplementedError("virDomainAddIOThread")
 	}
@@ -4248,7 +4288,7 @@ func (d *Domain) AddIOThread(id uint, flags DomainModificationImpact) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDelIOThread
-func (d *Domain) DelIOThread(id uint, flags DomainModificationImpact) error {
+func (d *Domain) doDelIOThread(id uint, flags DomainModificationImpact) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002015 {
 		return makeNotImplementedError("virDomainDelIOThread")
 	}
@@ -4289,7 +4329,7 @@ func getSetIOThreadParamsFieldInfo(params *DomainSetIOThreadParams) map[string]t
 	}
 }
 
-func (d *Domain) SetIOThreadParams(iothreadid uint, params *DomainSetIOThreadParams, flags DomainModificationImpact) error {
+func (d *Domain) doSetIOThreadParams(iothreadid uint, params *DomainSetIOThreadParams, flags DomainModificationImpact) error {
 	if C.LIBVIR_VERSION_NUMBER < 4010000 {
 		return makeNotImplementedError("virDomainSetIOThreadParams")
 	}
@@ -4300,7 +4340,7 @@ func (d *Domain) SetIOThreadParams(iothreadid uint, params *DomainSetIOThreadPar
 		return gerr
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virDomainSetIOThreadParamsWrapper(d.ptr, C.uint(iothreadid), cparams, cnparams, C.uint(flags), &err)
@@ -4312,10 +4352,14 @@ func (d *Domain) SetIOThreadParams(iothreadid uint, params *DomainSetIOThreadPar
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetEmulatorPinInfo
-func (d *Domain) GetEmulatorPinInfo(flags DomainModificationImpact) ([]bool, error) {
+func (d *Domain) doGetEmulatorPinInfo(flags DomainModificationImpact) ([]bool, error) {
 	var cnodeinfo C.virNodeInfo
 	var err C.virError
-	ret := C.virNodeGetInfoWrapper(C.virDomainGetConnect(d.ptr), &cnodeinfo, &err)
+	cconn := C.virDomainGetConnectWrapper(d.ptr, &err)
+	if cconn == nil {
+		return []bool{}, makeError(&err)
+	}
+	ret := C.virNodeGetInfoWrapper(cconn, &cnodeinfo, &err)
 	if ret == -1 {
 		return []bool{}, makeError(&err)
 	}
@@ -4344,7 +4388,7 @@ type DomainIOThreadInfo struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetIOThreadInfo
-func (d *Domain) GetIOThreadInfo(flags DomainModificationImpact) ([]DomainIOThreadInfo, error) {
+func (d *Domain) doGetIOThreadInfo(flags DomainModificationImpact) ([]DomainIOThreadInfo, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002014 {
 		return []DomainIOThreadInfo{}, makeNotImplementedError("virDomaingetIOThreadInfo")
 	}
@@ -4384,10 +4428,14 @@ func (d *Domain) GetIOThreadInfo(flags DomainModificationImpact) ([]DomainIOThre
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetVcpuPinInfo
-func (d *Domain) GetVcpuPinInfo(flags DomainModificationImpact) ([][]bool, error) {
+func (d *Domain) doGetVcpuPinInfo(flags DomainModificationImpact) ([][]bool, error) {
 	var cnodeinfo C.virNodeInfo
 	var err C.virError
-	ret := C.virNodeGetInfoWrapper(C.virDomainGetConnect(d.ptr), &cnodeinfo, &err)
+	cconn := C.virDomainGetConnectWrapper(d.ptr, &err)
+	if cconn == nil {
+		return [][]bool{}, makeError(&err)
+	}
+	ret := C.virNodeGetInfoWrapper(cconn, &cnodeinfo, &err)
 	if ret == -1 {
 		return [][]bool{}, makeError(&err)
 	}
@@ -4425,7 +4473,7 @@ func (d *Domain) GetVcpuPinInfo(flags DomainModificationImpact) ([][]bool, error
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainPinEmulator
-func (d *Domain) PinEmulator(cpumap []bool, flags DomainModificationImpact) error {
+func (d *Domain) doPinEmulator(cpumap []bool, flags DomainModificationImpact) error {
 
 	maplen := (len(cpumap) + 7) / 8
 	ccpumaps := make([]C.uchar, maplen)
@@ -4448,7 +4496,7 @@ func (d *Domain) PinEmulator(cpumap []bool, flags DomainModificationImpact) erro
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainPinIOThread
-func (d *Domain) PinIOThread(iothreadid uint, cpumap []bool, flags DomainModificationImpact) error {
+func (d *Domain) doPinIOThread(iothreadid uint, cpumap []bool, flags DomainModificationImpact) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002014 {
 		return makeNotImplementedError("virDomainPinIOThread")
 	}
@@ -4474,7 +4522,7 @@ func (d *Domain) PinIOThread(iothreadid uint, cpumap []bool, flags DomainModific
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainOpenChannel
-func (d *Domain) OpenChannel(name string, stream *Stream, flags DomainChannelFlags) error {
+func (d *Domain) doOpenChannel(name string, stream *Stream, flags DomainChannelFlags) error {
 	cname := C.CString(name)
 	defer C.free(unsafe.Pointer(cname))
 
@@ -4488,7 +4536,7 @@ func (d *Domain) OpenChannel(name string, stream *Stream, flags DomainChannelFla
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainOpenConsole
-func (d *Domain) OpenConsole(devname string, stream *Stream, flags DomainConsoleFlags) error {
+func (d *Domain) doOpenConsole(devname string, stream *Stream, flags DomainConsoleFlags) error {
 	var cdevname *C.char
 	if devname != "" {
 		cdevname = C.CString(devname)
@@ -4505,7 +4553,7 @@ func (d *Domain) OpenConsole(devname string, stream *Stream, flags DomainConsole
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainOpenGraphics
-func (d *Domain) OpenGraphics(idx uint, file os.File, flags DomainOpenGraphicsFlags) error {
+func (d *Domain) doOpenGraphics(idx uint, file os.File, flags DomainOpenGraphicsFlags) error {
 	var err C.virError
 	ret := C.virDomainOpenGraphicsWrapper(d.ptr, C.uint(idx), C.int(file.Fd()), C.uint(flags), &err)
 	if ret == -1 {
@@ -4516,7 +4564,7 @@ func (d *Domain) OpenGraphics(idx uint, file os.File, flags DomainOpenGraphicsFl
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainOpenGraphicsFD
-func (d *Domain) OpenGraphicsFD(idx uint, flags DomainOpenGraphicsFlags) (*os.File, error) {
+func (d *Domain) doOpenGraphicsFD(idx uint, flags DomainOpenGraphicsFlags) (*os.File, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002008 {
 		return nil, makeNotImplementedError("virDomainOpenGraphicsFD")
 	}
@@ -4530,7 +4578,7 @@ func (d *Domain) OpenGraphicsFD(idx uint, flags DomainOpenGraphicsFlags) (*os.Fi
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotCreateXML
-func (d *Domain) CreateSnapshotXML(xml string, flags DomainSnapshotCreateFlags) (*DomainSnapshot, error) {
+func (d *Domain) doCreateSnapshotXML(xml string, flags DomainSnapshotCreateFlags) (*DomainSnapshot, error) {
 	cXml := C.CString(xml)
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -4538,11 +4586,12 @@ func (d *Domain) CreateSnapshotXML(xml string, flags DomainSnapshotCreateFlags)
 	if result == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("DomainSnapshot", unsafe.Pointer(result))
 	return &DomainSnapshot{ptr: result}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-checkpoint.html#virDomainCheckpointCreateXML
-func (d *Domain) CreateCheckpointXML(xml string, flags DomainCheckpointCreateFlags) (*DomainCheckpoint, error) {
+func (d *Domain) doCreateCheckpointXML(xml string, flags DomainCheckpointCreateFlags) (*DomainCheckpoint, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5006000 {
 		return nil, makeNotImplementedError("virDomainCheckpointCreateXML")
 	}
@@ -4554,11 +4603,12 @@ func (d *Domain) CreateCheckpointXML(xml string, flags DomainCheckpointCreateFla
 	if result == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("DomainCheckpoint", unsafe.Pointer(result))
 	return &DomainCheckpoint{ptr: result}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSave
-func (d *Domain) Save(destFile string) error {
+func (d *Domain) doSave(destFile string) error {
 	cPath := C.CString(destFile)
 	defer C.free(unsafe.Pointer(cPath))
 	var err C.virError
@@ -4570,7 +4620,7 @@ func (d *Domain) Save(destFile string) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSaveFlags
-func (d *Domain) SaveFlags(destFile string, destXml string, flags DomainSaveRestoreFlags) error {
+func (d *Domain) doSaveFlags(destFile string, destXml string, flags DomainSaveRestoreFlags) error {
 	cDestFile := C.CString(destFile)
 	cDestXml := C.CString(destXml)
 	defer C.free(unsafe.Pointer(cDestXml))
@@ -4667,7 +4717,7 @@ func parseCPUString(cpumapstr string) ([]bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetGuestVcpus
-func (d *Domain) GetGuestVcpus(flags uint32) (*DomainGuestVcpus, error) {
+func (d *Domain) doGetGuestVcpus(flags uint32) (*DomainGuestVcpus, error) {
 	if C.LIBVIR_VERSION_NUMBER < 2000000 {
 		return nil, makeNotImplementedError("virDomainGetGuestVcpus")
 	}
@@ -4684,7 +4734,7 @@ func (d *Domain) GetGuestVcpus(flags uint32) (*DomainGuestVcpus, error) {
 		return nil, makeError(&err)
 	}
 
-	defer C.virTypedParamsFree(cparams, C.int(cnparams))
+	defer C.virTypedParamsFreeWrapper(cparams, C.int(cnparams))
 
 	_, gerr := typedParamsUnpack(cparams, C.int(cnparams), info)
 	if gerr != nil {
@@ -4717,7 +4767,7 @@ func (d *Domain) GetGuestVcpus(flags uint32) (*DomainGuestVcpus, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetGuestVcpus
-func (d *Domain) SetGuestVcpus(cpus []bool, state bool, flags uint32) error {
+func (d *Domain) doSetGuestVcpus(cpus []bool, state bool, flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 2000000 {
 		return makeNotImplementedError("virDomainSetGuestVcpus")
 	}
@@ -4751,7 +4801,7 @@ func (d *Domain) SetGuestVcpus(cpus []bool, state bool, flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetVcpu
-func (d *Domain) SetVcpu(cpus []bool, state bool, flags uint32) error {
+func (d *Domain) doSetVcpu(cpus []bool, state bool, flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 3001000 {
 		return makeNotImplementedError("virDomainSetVcpu")
 	}
@@ -4785,7 +4835,7 @@ func (d *Domain) SetVcpu(cpus []bool, state bool, flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetBlockThreshold
-func (d *Domain) SetBlockThreshold(dev string, threshold uint64, flags uint32) error {
+func (d *Domain) doSetBlockThreshold(dev string, threshold uint64, flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 3002000 {
 		return makeNotImplementedError("virDomainSetBlockThreshold")
 	}
@@ -4802,7 +4852,7 @@ func (d *Domain) SetBlockThreshold(dev string, threshold uint64, flags uint32) e
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainManagedSaveDefineXML
-func (d *Domain) ManagedSaveDefineXML(xml string, flags uint32) error {
+func (d *Domain) doManagedSaveDefineXML(xml string, flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 3007000 {
 		return makeNotImplementedError("virDomainManagedSaveDefineXML")
 	}
@@ -4819,7 +4869,7 @@ func (d *Domain) ManagedSaveDefineXML(xml string, flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainManagedSaveGetXMLDesc
-func (d *Domain) ManagedSaveGetXMLDesc(flags DomainSaveImageXMLFlags) (string, error) {
+func (d *Domain) doManagedSaveGetXMLDesc(flags DomainSaveImageXMLFlags) (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 3007000 {
 		return "", makeNotImplementedError("virDomainManagedSaveGetXMLDesc")
 	}
@@ -4855,7 +4905,7 @@ const (
 )
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetLifecycleAction
-func (d *Domain) SetLifecycleAction(lifecycleType uint32, action uint32, flags uint32) error {
+func (d *Domain) doSetLifecycleAction(lifecycleType uint32, action uint32, flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 3009000 {
 		return makeNotImplementedError("virDomainSetLifecycleAction")
 	}
@@ -4884,7 +4934,7 @@ func getDomainLaunchSecurityFieldInfo(params *DomainLaunchSecurityParameters) ma
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetLaunchSecurityInfo
-func (d *Domain) GetLaunchSecurityInfo(flags uint32) (*DomainLaunchSecurityParameters, error) {
+func (d *Domain) doGetLaunchSecurityInfo(flags uint32) (*DomainLaunchSecurityParameters, error) {
 	if C.LIBVIR_VERSION_NUMBER < 4005000 {
 		return nil, makeNotImplementedError("virDomainGetLaunchSecurityInfo")
 	}
@@ -4901,7 +4951,7 @@ func (d *Domain) GetLaunchSecurityInfo(flags uint32) (*DomainLaunchSecurityParam
 		return nil, makeError(&err)
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	_, gerr := typedParamsUnpack(cparams, cnparams, info)
 	if gerr != nil {
@@ -5143,7 +5193,7 @@ func getDomainGuestInfoLengthsFieldInfo(params *domainGuestInfoLengths) map[stri
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetGuestInfo
-func (d *Domain) GetGuestInfo(types DomainGuestInfoTypes, flags uint32) (*DomainGuestInfo, error) {
+func (d *Domain) doGetGuestInfo(types DomainGuestInfoTypes, flags uint32) (*DomainGuestInfo, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5007000 {
 		return nil, makeNotImplementedError("virDomainGetGuestInfo")
 	}
@@ -5157,7 +5207,7 @@ func (d *Domain) GetGuestInfo(types DomainGuestInfoTypes, flags uint32) (*Domain
 		return nil, makeError(&err)
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	info := DomainGuestInfo{}
 	infoInfo := getDomainGuestInfoFieldInfo(&info)
@@ -5239,7 +5289,7 @@ func (d *Domain) GetGuestInfo(types DomainGuestInfoTypes, flags uint32) (*Domain
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainAgentSetResponseTimeout
-func (d *Domain) AgentSetResponseTimeout(timeout int, flags uint32) error {
+func (d *Domain) doAgentSetResponseTimeout(timeout int, flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 5010000 {
 		return makeNotImplementedError("virDomainAgentSetResponseTimeout")
 	}
@@ -5254,7 +5304,7 @@ func (d *Domain) AgentSetResponseTimeout(timeout int, flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBackupBegin
-func (d *Domain) BackupBegin(backupXML string, checkpointXML string, flags DomainBackupBeginFlags) error {
+func (d *Domain) doBackupBegin(backupXML string, checkpointXML string, flags DomainBackupBeginFlags) error {
 	if C.LIBVIR_VERSION_NUMBER < 6000000 {
 		return makeNotImplementedError("virDomainBackupBegin")
 	}
@@ -5276,7 +5326,7 @@ func (d *Domain) BackupBegin(backupXML string, checkpointXML string, flags Domai
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainBackupGetXMLDesc
-func (d *Domain) BackupGetXMLDesc(flags uint32) (string, error) {
+func (d *Domain) doBackupGetXMLDesc(flags uint32) (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 6000000 {
 		return "", makeNotImplementedError("virDomainBackupGetXMLDesc")
 	}
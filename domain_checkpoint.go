@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "domain_checkpoint_wrapper.h"
 */
@@ -76,11 +76,14 @@ type DomainCheckpoint struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-checkpoint.html#virDomainCheckpointFree
-func (s *DomainCheckpoint) Free() error {
+func (s *DomainCheckpoint) doFree() error {
 	if C.LIBVIR_VERSION_NUMBER < 5006000 {
 		return makeNotImplementedError("virDomainCheckpointFree")
 	}
 
+	if err := trackHandleFree("DomainCheckpoint", unsafe.Pointer(s.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virDomainCheckpointFreeWrapper(s.ptr, &err)
 	if ret == -1 {
@@ -90,7 +93,7 @@ func (s *DomainCheckpoint) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-checkpoint.html#virDomainCheckpointRef
-func (c *DomainCheckpoint) Ref() error {
+func (c *DomainCheckpoint) doRef() error {
 	if C.LIBVIR_VERSION_NUMBER < 5006000 {
 		return makeNotImplementedError("virDomainCheckpointRef")
 	}
@@ -100,11 +103,12 @@ func (c *DomainCheckpoint) Ref() error {
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("DomainCheckpoint", unsafe.Pointer(c.ptr))
 	return nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-checkpoint.html#virDomainCheckpointDelete
-func (s *DomainCheckpoint) Delete(flags DomainCheckpointDeleteFlags) error {
+func (s *DomainCheckpoint) doDelete(flags DomainCheckpointDeleteFlags) error {
 	if C.LIBVIR_VERSION_NUMBER < 5006000 {
 		return makeNotImplementedError("virDomainCheckpointDelete")
 	}
@@ -118,7 +122,7 @@ func (s *DomainCheckpoint) Delete(flags DomainCheckpointDeleteFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-checkpoint.html#virDomainCheckpointGetXMLDesc
-func (s *DomainCheckpoint) GetXMLDesc(flags DomainCheckpointXMLFlags) (string, error) {
+func (s *DomainCheckpoint) doGetXMLDesc(flags DomainCheckpointXMLFlags) (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5006000 {
 		return "", makeNotImplementedError("virDomainCheckpointGetXMLDesc")
 	}
@@ -134,7 +138,7 @@ func (s *DomainCheckpoint) GetXMLDesc(flags DomainCheckpointXMLFlags) (string, e
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-checkpoint.html#virDomainCheckpointGetName
-func (s *DomainCheckpoint) GetName() (string, error) {
+func (s *DomainCheckpoint) doGetName() (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5006000 {
 		return "", makeNotImplementedError("virDomainCheckpointGetName")
 	}
@@ -148,7 +152,7 @@ func (s *DomainCheckpoint) GetName() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-checkpoint.html#virDomainCheckpointGetParent
-func (s *DomainCheckpoint) GetParent(flags uint32) (*DomainCheckpoint, error) {
+func (s *DomainCheckpoint) doGetParent(flags uint32) (*DomainCheckpoint, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5006000 {
 		return nil, makeNotImplementedError("virDomainCheckpointGetParent")
 	}
@@ -158,11 +162,12 @@ func (s *DomainCheckpoint) GetParent(flags uint32) (*DomainCheckpoint, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("DomainCheckpoint", unsafe.Pointer(ptr))
 	return &DomainCheckpoint{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-checkpoint.html#virDomainCheckpointListAllChildren
-func (d *DomainCheckpoint) ListAllChildren(flags DomainCheckpointListFlags) ([]DomainCheckpoint, error) {
+func (d *DomainCheckpoint) doListAllChildren(flags DomainCheckpointListFlags) ([]DomainCheckpoint, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5006000 {
 		return []DomainCheckpoint{}, makeNotImplementedError("virDomainCheckpointListAllChildren")
 	}
@@ -181,6 +186,7 @@ func (d *DomainCheckpoint) ListAllChildren(flags DomainCheckpointListFlags) ([]D
 	var pools []DomainCheckpoint
 	slice := *(*[]C.virDomainCheckpointPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("DomainCheckpoint", unsafe.Pointer(ptr))
 		pools = append(pools, DomainCheckpoint{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -28,6 +28,7 @@
 
 #include <libvirt/libvirt.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 #include "domain_compat.h"
 #include "domain_checkpoint_compat.h"
 
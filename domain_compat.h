@@ -1078,4 +1078,48 @@ struct _virDomainInterface {
 #define VIR_DOMAIN_JOB_ERRMSG "errmsg"
 #endif
 
+/* 6.9.0 */
+
+#ifndef VIR_DOMAIN_EVENT_ID_MEMORY_FAILURE
+#define VIR_DOMAIN_EVENT_ID_MEMORY_FAILURE 25
+#endif
+
+#ifndef VIR_DOMAIN_EVENT_MEMORY_FAILURE_RECIPIENT_HYPERVISOR
+#define VIR_DOMAIN_EVENT_MEMORY_FAILURE_RECIPIENT_HYPERVISOR 0
+#endif
+
+#ifndef VIR_DOMAIN_EVENT_MEMORY_FAILURE_RECIPIENT_GUEST
+#define VIR_DOMAIN_EVENT_MEMORY_FAILURE_RECIPIENT_GUEST 1
+#endif
+
+#ifndef VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_IGNORE
+#define VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_IGNORE 0
+#endif
+
+#ifndef VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_INJECT
+#define VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_INJECT 1
+#endif
+
+#ifndef VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_FATAL
+#define VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_FATAL 2
+#endif
+
+#ifndef VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_RESET
+#define VIR_DOMAIN_EVENT_MEMORY_FAILURE_ACTION_RESET 3
+#endif
+
+#ifndef VIR_DOMAIN_MEMORY_FAILURE_ACTION_REQUIRED
+#define VIR_DOMAIN_MEMORY_FAILURE_ACTION_REQUIRED (1 << 0)
+#endif
+
+#ifndef VIR_DOMAIN_MEMORY_FAILURE_RECURSIVE
+#define VIR_DOMAIN_MEMORY_FAILURE_RECURSIVE (1 << 1)
+#endif
+
+/* 7.9.0 */
+
+#ifndef VIR_DOMAIN_EVENT_ID_MEMORY_DEVICE_SIZE_CHANGE
+#define VIR_DOMAIN_EVENT_ID_MEMORY_DEVICE_SIZE_CHANGE 26
+#endif
+
 #endif /* LIBVIRT_GO_DOMAIN_COMPAT_H__ */
@@ -32,7 +32,7 @@ import (
 )
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include "domain_events_wrapper.h"
 */
 import "C"
@@ -217,6 +217,21 @@ type DomainEventBlockThreshold struct {
 
 type DomainEventBlockThresholdCallback func(c *Connect, d *Domain, event *DomainEventBlockThreshold)
 
+type DomainEventMemoryFailure struct {
+	Recipient DomainEventMemoryFailureRecipientType
+	Action    DomainEventMemoryFailureActionType
+	Flags     DomainMemoryFailureFlags
+}
+
+type DomainEventMemoryFailureCallback func(c *Connect, d *Domain, event *DomainEventMemoryFailure)
+
+type DomainEventMemoryDeviceSizeChange struct {
+	Alias string
+	Size  uint64
+}
+
+type DomainEventMemoryDeviceSizeChangeCallback func(c *Connect, d *Domain, event *DomainEventMemoryDeviceSizeChange)
+
 //export domainEventLifecycleCallback
 func domainEventLifecycleCallback(c C.virConnectPtr, d C.virDomainPtr,
 	event int, detail int,
@@ -958,7 +973,44 @@ func domainEventBlockThresholdCallback(c C.virConnectPtr, d C.virDomainPtr, dev
 
 }
 
-func (c *Connect) DomainEventLifecycleRegister(dom *Domain, callback DomainEventLifecycleCallback) (int, error) {
+//export domainEventMemoryFailureCallback
+func domainEventMemoryFailureCallback(c C.virConnectPtr, d C.virDomainPtr, recipient int, action int, flags C.uint, goCallbackId int) {
+	domain := &Domain{ptr: d}
+	connection := &Connect{ptr: c}
+
+	eventDetails := &DomainEventMemoryFailure{
+		Recipient: DomainEventMemoryFailureRecipientType(recipient),
+		Action:    DomainEventMemoryFailureActionType(action),
+		Flags:     DomainMemoryFailureFlags(flags),
+	}
+	callbackFunc := getCallbackId(goCallbackId)
+	callback, ok := callbackFunc.(DomainEventMemoryFailureCallback)
+	if !ok {
+		panic("Inappropriate callback type called")
+	}
+	callback(connection, domain, eventDetails)
+
+}
+
+//export domainEventMemoryDeviceSizeChangeCallback
+func domainEventMemoryDeviceSizeChangeCallback(c C.virConnectPtr, d C.virDomainPtr, alias *C.char, size C.ulonglong, goCallbackId int) {
+	domain := &Domain{ptr: d}
+	connection := &Connect{ptr: c}
+
+	eventDetails := &DomainEventMemoryDeviceSizeChange{
+		Alias: C.GoString(alias),
+		Size:  uint64(size),
+	}
+	callbackFunc := getCallbackId(goCallbackId)
+	callback, ok := callbackFunc.(DomainEventMemoryDeviceSizeChangeCallback)
+	if !ok {
+		panic("Inappropriate callback type called")
+	}
+	callback(connection, domain, eventDetails)
+
+}
+
+func (c *Connect) doDomainEventLifecycleRegister(dom *Domain, callback DomainEventLifecycleCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventLifecycleCallbackHelper)
@@ -978,7 +1030,7 @@ func (c *Connect) DomainEventLifecycleRegister(dom *Domain, callback DomainEvent
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventRebootRegister(dom *Domain, callback DomainEventGenericCallback) (int, error) {
+func (c *Connect) doDomainEventRebootRegister(dom *Domain, callback DomainEventGenericCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventGenericCallbackHelper)
@@ -998,7 +1050,7 @@ func (c *Connect) DomainEventRebootRegister(dom *Domain, callback DomainEventGen
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventRTCChangeRegister(dom *Domain, callback DomainEventRTCChangeCallback) (int, error) {
+func (c *Connect) doDomainEventRTCChangeRegister(dom *Domain, callback DomainEventRTCChangeCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventRTCChangeCallbackHelper)
@@ -1018,7 +1070,7 @@ func (c *Connect) DomainEventRTCChangeRegister(dom *Domain, callback DomainEvent
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventWatchdogRegister(dom *Domain, callback DomainEventWatchdogCallback) (int, error) {
+func (c *Connect) doDomainEventWatchdogRegister(dom *Domain, callback DomainEventWatchdogCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventWatchdogCallbackHelper)
@@ -1038,7 +1090,7 @@ func (c *Connect) DomainEventWatchdogRegister(dom *Domain, callback DomainEventW
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventIOErrorRegister(dom *Domain, callback DomainEventIOErrorCallback) (int, error) {
+func (c *Connect) doDomainEventIOErrorRegister(dom *Domain, callback DomainEventIOErrorCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventIOErrorCallbackHelper)
@@ -1058,7 +1110,7 @@ func (c *Connect) DomainEventIOErrorRegister(dom *Domain, callback DomainEventIO
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventGraphicsRegister(dom *Domain, callback DomainEventGraphicsCallback) (int, error) {
+func (c *Connect) doDomainEventGraphicsRegister(dom *Domain, callback DomainEventGraphicsCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventGraphicsCallbackHelper)
@@ -1078,7 +1130,7 @@ func (c *Connect) DomainEventGraphicsRegister(dom *Domain, callback DomainEventG
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventIOErrorReasonRegister(dom *Domain, callback DomainEventIOErrorReasonCallback) (int, error) {
+func (c *Connect) doDomainEventIOErrorReasonRegister(dom *Domain, callback DomainEventIOErrorReasonCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventIOErrorReasonCallbackHelper)
@@ -1098,7 +1150,7 @@ func (c *Connect) DomainEventIOErrorReasonRegister(dom *Domain, callback DomainE
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventControlErrorRegister(dom *Domain, callback DomainEventGenericCallback) (int, error) {
+func (c *Connect) doDomainEventControlErrorRegister(dom *Domain, callback DomainEventGenericCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventGenericCallbackHelper)
@@ -1118,7 +1170,7 @@ func (c *Connect) DomainEventControlErrorRegister(dom *Domain, callback DomainEv
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventBlockJobRegister(dom *Domain, callback DomainEventBlockJobCallback) (int, error) {
+func (c *Connect) doDomainEventBlockJobRegister(dom *Domain, callback DomainEventBlockJobCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventBlockJobCallbackHelper)
@@ -1138,7 +1190,7 @@ func (c *Connect) DomainEventBlockJobRegister(dom *Domain, callback DomainEventB
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventDiskChangeRegister(dom *Domain, callback DomainEventDiskChangeCallback) (int, error) {
+func (c *Connect) doDomainEventDiskChangeRegister(dom *Domain, callback DomainEventDiskChangeCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventDiskChangeCallbackHelper)
@@ -1158,7 +1210,7 @@ func (c *Connect) DomainEventDiskChangeRegister(dom *Domain, callback DomainEven
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventTrayChangeRegister(dom *Domain, callback DomainEventTrayChangeCallback) (int, error) {
+func (c *Connect) doDomainEventTrayChangeRegister(dom *Domain, callback DomainEventTrayChangeCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventTrayChangeCallbackHelper)
@@ -1178,7 +1230,7 @@ func (c *Connect) DomainEventTrayChangeRegister(dom *Domain, callback DomainEven
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventPMWakeupRegister(dom *Domain, callback DomainEventPMWakeupCallback) (int, error) {
+func (c *Connect) doDomainEventPMWakeupRegister(dom *Domain, callback DomainEventPMWakeupCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventPMWakeupCallbackHelper)
@@ -1198,7 +1250,7 @@ func (c *Connect) DomainEventPMWakeupRegister(dom *Domain, callback DomainEventP
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventPMSuspendRegister(dom *Domain, callback DomainEventPMSuspendCallback) (int, error) {
+func (c *Connect) doDomainEventPMSuspendRegister(dom *Domain, callback DomainEventPMSuspendCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventPMSuspendCallbackHelper)
@@ -1218,7 +1270,7 @@ func (c *Connect) DomainEventPMSuspendRegister(dom *Domain, callback DomainEvent
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventBalloonChangeRegister(dom *Domain, callback DomainEventBalloonChangeCallback) (int, error) {
+func (c *Connect) doDomainEventBalloonChangeRegister(dom *Domain, callback DomainEventBalloonChangeCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventBalloonChangeCallbackHelper)
@@ -1238,7 +1290,7 @@ func (c *Connect) DomainEventBalloonChangeRegister(dom *Domain, callback DomainE
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventPMSuspendDiskRegister(dom *Domain, callback DomainEventPMSuspendDiskCallback) (int, error) {
+func (c *Connect) doDomainEventPMSuspendDiskRegister(dom *Domain, callback DomainEventPMSuspendDiskCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventPMSuspendDiskCallbackHelper)
@@ -1258,7 +1310,7 @@ func (c *Connect) DomainEventPMSuspendDiskRegister(dom *Domain, callback DomainE
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventDeviceRemovedRegister(dom *Domain, callback DomainEventDeviceRemovedCallback) (int, error) {
+func (c *Connect) doDomainEventDeviceRemovedRegister(dom *Domain, callback DomainEventDeviceRemovedCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventDeviceRemovedCallbackHelper)
@@ -1278,7 +1330,7 @@ func (c *Connect) DomainEventDeviceRemovedRegister(dom *Domain, callback DomainE
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventBlockJob2Register(dom *Domain, callback DomainEventBlockJobCallback) (int, error) {
+func (c *Connect) doDomainEventBlockJob2Register(dom *Domain, callback DomainEventBlockJobCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventBlockJobCallbackHelper)
@@ -1298,7 +1350,7 @@ func (c *Connect) DomainEventBlockJob2Register(dom *Domain, callback DomainEvent
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventTunableRegister(dom *Domain, callback DomainEventTunableCallback) (int, error) {
+func (c *Connect) doDomainEventTunableRegister(dom *Domain, callback DomainEventTunableCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventTunableCallbackHelper)
@@ -1318,7 +1370,7 @@ func (c *Connect) DomainEventTunableRegister(dom *Domain, callback DomainEventTu
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventAgentLifecycleRegister(dom *Domain, callback DomainEventAgentLifecycleCallback) (int, error) {
+func (c *Connect) doDomainEventAgentLifecycleRegister(dom *Domain, callback DomainEventAgentLifecycleCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventAgentLifecycleCallbackHelper)
@@ -1338,7 +1390,7 @@ func (c *Connect) DomainEventAgentLifecycleRegister(dom *Domain, callback Domain
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventDeviceAddedRegister(dom *Domain, callback DomainEventDeviceAddedCallback) (int, error) {
+func (c *Connect) doDomainEventDeviceAddedRegister(dom *Domain, callback DomainEventDeviceAddedCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventDeviceAddedCallbackHelper)
@@ -1358,7 +1410,7 @@ func (c *Connect) DomainEventDeviceAddedRegister(dom *Domain, callback DomainEve
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventMigrationIterationRegister(dom *Domain, callback DomainEventMigrationIterationCallback) (int, error) {
+func (c *Connect) doDomainEventMigrationIterationRegister(dom *Domain, callback DomainEventMigrationIterationCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventMigrationIterationCallbackHelper)
@@ -1378,7 +1430,7 @@ func (c *Connect) DomainEventMigrationIterationRegister(dom *Domain, callback Do
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventJobCompletedRegister(dom *Domain, callback DomainEventJobCompletedCallback) (int, error) {
+func (c *Connect) doDomainEventJobCompletedRegister(dom *Domain, callback DomainEventJobCompletedCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventJobCompletedCallbackHelper)
@@ -1398,7 +1450,7 @@ func (c *Connect) DomainEventJobCompletedRegister(dom *Domain, callback DomainEv
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventDeviceRemovalFailedRegister(dom *Domain, callback DomainEventDeviceRemovalFailedCallback) (int, error) {
+func (c *Connect) doDomainEventDeviceRemovalFailedRegister(dom *Domain, callback DomainEventDeviceRemovalFailedCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventDeviceRemovalFailedCallbackHelper)
@@ -1418,7 +1470,7 @@ func (c *Connect) DomainEventDeviceRemovalFailedRegister(dom *Domain, callback D
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventMetadataChangeRegister(dom *Domain, callback DomainEventMetadataChangeCallback) (int, error) {
+func (c *Connect) doDomainEventMetadataChangeRegister(dom *Domain, callback DomainEventMetadataChangeCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventMetadataChangeCallbackHelper)
@@ -1438,7 +1490,7 @@ func (c *Connect) DomainEventMetadataChangeRegister(dom *Domain, callback Domain
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventBlockThresholdRegister(dom *Domain, callback DomainEventBlockThresholdCallback) (int, error) {
+func (c *Connect) doDomainEventBlockThresholdRegister(dom *Domain, callback DomainEventBlockThresholdCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.domainEventBlockThresholdCallbackHelper)
@@ -1458,7 +1510,47 @@ func (c *Connect) DomainEventBlockThresholdRegister(dom *Domain, callback Domain
 	return int(ret), nil
 }
 
-func (c *Connect) DomainEventDeregister(callbackId int) error {
+func (c *Connect) doDomainEventMemoryFailureRegister(dom *Domain, callback DomainEventMemoryFailureCallback) (int, error) {
+	goCallBackId := registerCallbackId(callback)
+
+	callbackPtr := unsafe.Pointer(C.domainEventMemoryFailureCallbackHelper)
+	var cdom C.virDomainPtr
+	if dom != nil {
+		cdom = dom.ptr
+	}
+	var err C.virError
+	ret := C.virConnectDomainEventRegisterAnyWrapper(c.ptr, cdom,
+		C.VIR_DOMAIN_EVENT_ID_MEMORY_FAILURE,
+		C.virConnectDomainEventGenericCallback(callbackPtr),
+		C.long(goCallBackId), &err)
+	if ret == -1 {
+		freeCallbackId(goCallBackId)
+		return 0, makeError(&err)
+	}
+	return int(ret), nil
+}
+
+func (c *Connect) doDomainEventMemoryDeviceSizeChangeRegister(dom *Domain, callback DomainEventMemoryDeviceSizeChangeCallback) (int, error) {
+	goCallBackId := registerCallbackId(callback)
+
+	callbackPtr := unsafe.Pointer(C.domainEventMemoryDeviceSizeChangeCallbackHelper)
+	var cdom C.virDomainPtr
+	if dom != nil {
+		cdom = dom.ptr
+	}
+	var err C.virError
+	ret := C.virConnectDomainEventRegisterAnyWrapper(c.ptr, cdom,
+		C.VIR_DOMAIN_EVENT_ID_MEMORY_DEVICE_SIZE_CHANGE,
+		C.virConnectDomainEventGenericCallback(callbackPtr),
+		C.long(goCallBackId), &err)
+	if ret == -1 {
+		freeCallbackId(goCallBackId)
+		return 0, makeError(&err)
+	}
+	return int(ret), nil
+}
+
+func (c *Connect) doDomainEventDeregister(callbackId int) error {
 	// Deregister the callback
 	var err C.virError
 	ret := int(C.virConnectDomainEventDeregisterAnyWrapper(c.ptr, C.int(callbackId), &err))
@@ -1631,3 +1723,38 @@ func (e DomainEventBalloonChange) String() string {
 func (e DomainEventDeviceRemoved) String() string {
 	return fmt.Sprintf("Device %q removed ", e.DevAlias)
 }
+
+func (e DomainEventMemoryFailure) String() string {
+	var recipient string
+	switch e.Recipient {
+	case DOMAIN_EVENT_MEMORY_FAILURE_RECIPIENT_HYPERVISOR:
+		recipient = "hypervisor"
+	case DOMAIN_EVENT_MEMORY_FAILURE_RECIPIENT_GUEST:
+		recipient = "guest"
+	default:
+		recipient = "unknown"
+	}
+
+	var action string
+	switch e.Action {
+	case DOMAIN_EVENT_MEMORY_FAILURE_ACTION_IGNORE:
+		action = "ignore"
+	case DOMAIN_EVENT_MEMORY_FAILURE_ACTION_INJECT:
+		action = "inject"
+	case DOMAIN_EVENT_MEMORY_FAILURE_ACTION_FATAL:
+		action = "fatal"
+	case DOMAIN_EVENT_MEMORY_FAILURE_ACTION_RESET:
+		action = "reset"
+	default:
+		action = "unknown"
+	}
+
+	return fmt.Sprintf("Memory failure recipient=%q action=%q action-required=%t recursive=%t",
+		recipient, action,
+		e.Flags&DOMAIN_MEMORY_FAILURE_ACTION_REQUIRED != 0,
+		e.Flags&DOMAIN_MEMORY_FAILURE_RECURSIVE != 0)
+}
+
+func (e DomainEventMemoryDeviceSizeChange) String() string {
+	return fmt.Sprintf("Memory device %q size change %d", e.Alias, e.Size)
+}
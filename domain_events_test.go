@@ -119,3 +119,69 @@ func TestDomainEventRegister(t *testing.T) {
 	}
 	goCallbackLock.Unlock()
 }
+
+func TestDomainEventMemoryFailureString(t *testing.T) {
+	event := DomainEventMemoryFailure{
+		Recipient: DOMAIN_EVENT_MEMORY_FAILURE_RECIPIENT_GUEST,
+		Action:    DOMAIN_EVENT_MEMORY_FAILURE_ACTION_INJECT,
+		Flags:     DOMAIN_MEMORY_FAILURE_ACTION_REQUIRED,
+	}
+	eventString := fmt.Sprintf("%s", event)
+	expected := "Memory failure recipient=\"guest\" action=\"inject\" action-required=true recursive=false"
+	if eventString != expected {
+		t.Errorf("event == %q, expected %q", eventString, expected)
+	}
+}
+
+func TestDomainEventMemoryDeviceSizeChangeString(t *testing.T) {
+	event := DomainEventMemoryDeviceSizeChange{
+		Alias: "virtiomem0",
+		Size:  1048576,
+	}
+	eventString := fmt.Sprintf("%s", event)
+	expected := "Memory device \"virtiomem0\" size change 1048576"
+	if eventString != expected {
+		t.Errorf("event == %q, expected %q", eventString, expected)
+	}
+}
+
+func TestDomainEventMemoryRegister(t *testing.T) {
+	conn := buildTestConnection()
+	defer func() {
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	failureId, err := conn.DomainEventMemoryFailureRegister(nil,
+		func(c *Connect, d *Domain, event *DomainEventMemoryFailure) {})
+	if err != nil {
+		lverr, ok := err.(Error)
+		if ok && lverr.Code == ERR_INVALID_ARG {
+			return
+		}
+		t.Fatal(err)
+	}
+	if err := conn.DomainEventDeregister(failureId); err != nil {
+		t.Fatalf("Event deregistration failed with: %v", err)
+	}
+
+	sizeId, err := conn.DomainEventMemoryDeviceSizeChangeRegister(nil,
+		func(c *Connect, d *Domain, event *DomainEventMemoryDeviceSizeChange) {})
+	if err != nil {
+		lverr, ok := err.(Error)
+		if ok && lverr.Code == ERR_INVALID_ARG {
+			return
+		}
+		t.Fatal(err)
+	}
+	if err := conn.DomainEventDeregister(sizeId); err != nil {
+		t.Fatalf("Event deregistration failed with: %v", err)
+	}
+
+	goCallbackLock.Lock()
+	if len(goCallbacks) > 0 {
+		t.Errorf("goCallbacks entry wasn't removed: %+v", goCallbacks)
+	}
+	goCallbackLock.Unlock()
+}
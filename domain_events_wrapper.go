@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include "domain_events_wrapper.h"
 #include "callbacks_wrapper.h"
 #include <stdint.h>
@@ -225,6 +225,27 @@ void domainEventBlockThresholdCallbackHelper(virConnectPtr conn,
     domainEventBlockThresholdCallback(conn, dom, dev, path, threshold, excess, (int)(intptr_t)opaque);
 }
 
+extern void domainEventMemoryFailureCallback(virConnectPtr, virDomainPtr, int, int, unsigned int, int);
+void domainEventMemoryFailureCallbackHelper(virConnectPtr conn,
+					    virDomainPtr dom,
+					    int recipient,
+					    int action,
+					    unsigned int flags,
+					    void *opaque)
+{
+    domainEventMemoryFailureCallback(conn, dom, recipient, action, flags, (int)(intptr_t)opaque);
+}
+
+extern void domainEventMemoryDeviceSizeChangeCallback(virConnectPtr, virDomainPtr, const char *, unsigned long long, int);
+void domainEventMemoryDeviceSizeChangeCallbackHelper(virConnectPtr conn,
+						     virDomainPtr dom,
+						     const char *alias,
+						     unsigned long long size,
+						     void *opaque)
+{
+    domainEventMemoryDeviceSizeChangeCallback(conn, dom, alias, size, (int)(intptr_t)opaque);
+}
+
 int
 virConnectDomainEventRegisterAnyWrapper(virConnectPtr c,
                                         virDomainPtr d,
@@ -29,6 +29,7 @@
 
 #include <libvirt/libvirt.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 #include "domain_compat.h"
 
 void
@@ -191,6 +192,21 @@ domainEventBlockThresholdCallbackHelper(virConnectPtr conn,
                                         unsigned long long excess,
                                         void *opaque);
 
+void
+domainEventMemoryFailureCallbackHelper(virConnectPtr conn,
+                                       virDomainPtr dom,
+                                       int recipient,
+                                       int action,
+                                       unsigned int flags,
+                                       void *opaque);
+
+void
+domainEventMemoryDeviceSizeChangeCallbackHelper(virConnectPtr conn,
+                                                virDomainPtr dom,
+                                                const char *alias,
+                                                unsigned long long size,
+                                                void *opaque);
+
 int
 virConnectDomainEventRegisterAnyWrapper(virConnectPtr c,
                                         virDomainPtr d,
@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "domain_snapshot_wrapper.h"
 */
@@ -97,7 +97,10 @@ type DomainSnapshot struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotFree
-func (s *DomainSnapshot) Free() error {
+func (s *DomainSnapshot) doFree() error {
+	if err := trackHandleFree("DomainSnapshot", unsafe.Pointer(s.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virDomainSnapshotFreeWrapper(s.ptr, &err)
 	if ret == -1 {
@@ -107,17 +110,18 @@ func (s *DomainSnapshot) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotRef
-func (c *DomainSnapshot) Ref() error {
+func (c *DomainSnapshot) doRef() error {
 	var err C.virError
 	ret := C.virDomainSnapshotRefWrapper(c.ptr, &err)
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("DomainSnapshot", unsafe.Pointer(c.ptr))
 	return nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotDelete
-func (s *DomainSnapshot) Delete(flags DomainSnapshotDeleteFlags) error {
+func (s *DomainSnapshot) doDelete(flags DomainSnapshotDeleteFlags) error {
 	var err C.virError
 	result := C.virDomainSnapshotDeleteWrapper(s.ptr, C.uint(flags), &err)
 	if result != 0 {
@@ -127,7 +131,7 @@ func (s *DomainSnapshot) Delete(flags DomainSnapshotDeleteFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainRevertToSnapshot
-func (s *DomainSnapshot) RevertToSnapshot(flags DomainSnapshotRevertFlags) error {
+func (s *DomainSnapshot) doRevertToSnapshot(flags DomainSnapshotRevertFlags) error {
 	var err C.virError
 	result := C.virDomainRevertToSnapshotWrapper(s.ptr, C.uint(flags), &err)
 	if result != 0 {
@@ -137,7 +141,7 @@ func (s *DomainSnapshot) RevertToSnapshot(flags DomainSnapshotRevertFlags) error
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotIsCurrent
-func (s *DomainSnapshot) IsCurrent(flags uint32) (bool, error) {
+func (s *DomainSnapshot) doIsCurrent(flags uint32) (bool, error) {
 	var err C.virError
 	result := C.virDomainSnapshotIsCurrentWrapper(s.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -150,7 +154,7 @@ func (s *DomainSnapshot) IsCurrent(flags uint32) (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotHasMetadata
-func (s *DomainSnapshot) HasMetadata(flags uint32) (bool, error) {
+func (s *DomainSnapshot) doHasMetadata(flags uint32) (bool, error) {
 	var err C.virError
 	result := C.virDomainSnapshotHasMetadataWrapper(s.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -163,7 +167,7 @@ func (s *DomainSnapshot) HasMetadata(flags uint32) (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotGetXMLDesc
-func (s *DomainSnapshot) GetXMLDesc(flags DomainSnapshotXMLFlags) (string, error) {
+func (s *DomainSnapshot) doGetXMLDesc(flags DomainSnapshotXMLFlags) (string, error) {
 	var err C.virError
 	result := C.virDomainSnapshotGetXMLDescWrapper(s.ptr, C.uint(flags), &err)
 	if result == nil {
@@ -175,7 +179,7 @@ func (s *DomainSnapshot) GetXMLDesc(flags DomainSnapshotXMLFlags) (string, error
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotGetName
-func (s *DomainSnapshot) GetName() (string, error) {
+func (s *DomainSnapshot) doGetName() (string, error) {
 	var err C.virError
 	name := C.virDomainSnapshotGetNameWrapper(s.ptr, &err)
 	if name == nil {
@@ -185,17 +189,18 @@ func (s *DomainSnapshot) GetName() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotGetParent
-func (s *DomainSnapshot) GetParent(flags uint32) (*DomainSnapshot, error) {
+func (s *DomainSnapshot) doGetParent(flags uint32) (*DomainSnapshot, error) {
 	var err C.virError
 	ptr := C.virDomainSnapshotGetParentWrapper(s.ptr, C.uint(flags), &err)
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("DomainSnapshot", unsafe.Pointer(ptr))
 	return &DomainSnapshot{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotNumChildren
-func (s *DomainSnapshot) NumChildren(flags DomainSnapshotListFlags) (int, error) {
+func (s *DomainSnapshot) doNumChildren(flags DomainSnapshotListFlags) (int, error) {
 	var err C.virError
 	result := int(C.virDomainSnapshotNumChildrenWrapper(s.ptr, C.uint(flags), &err))
 	if result == -1 {
@@ -205,7 +210,7 @@ func (s *DomainSnapshot) NumChildren(flags DomainSnapshotListFlags) (int, error)
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotListChildrenNames
-func (s *DomainSnapshot) ListChildrenNames(flags DomainSnapshotListFlags) ([]string, error) {
+func (s *DomainSnapshot) doListChildrenNames(flags DomainSnapshotListFlags) ([]string, error) {
 	const maxNames = 1024
 	var names [maxNames](*C.char)
 	namesPtr := unsafe.Pointer(&names)
@@ -226,7 +231,7 @@ func (s *DomainSnapshot) ListChildrenNames(flags DomainSnapshotListFlags) ([]str
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-domain-snapshot.html#virDomainSnapshotListAllChildren
-func (d *DomainSnapshot) ListAllChildren(flags DomainSnapshotListFlags) ([]DomainSnapshot, error) {
+func (d *DomainSnapshot) doListAllChildren(flags DomainSnapshotListFlags) ([]DomainSnapshot, error) {
 	var cList *C.virDomainSnapshotPtr
 	var err C.virError
 	numVols := C.virDomainSnapshotListAllChildrenWrapper(d.ptr, (**C.virDomainSnapshotPtr)(&cList), C.uint(flags), &err)
@@ -241,6 +246,7 @@ func (d *DomainSnapshot) ListAllChildren(flags DomainSnapshotListFlags) ([]Domai
 	var pools []DomainSnapshot
 	slice := *(*[]C.virDomainSnapshotPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("DomainSnapshot", unsafe.Pointer(ptr))
 		pools = append(pools, DomainSnapshot{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -27,10 +27,13 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <libvirt/libvirt.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 #include "error_compat.h"
+#include "error_wrapper.h"
+#include "connect_wrapper.h"
 
 void ignoreErrorFunc(void *userData, virErrorPtr error) {
      // no-op
@@ -43,8 +46,9 @@ import (
 )
 
 func init() {
-	C.virSetErrorFunc(nil, (C.virErrorFunc)(C.ignoreErrorFunc))
-	C.virInitialize()
+	C.virSetErrorFuncWrapper(nil, (C.virErrorFunc)(C.ignoreErrorFunc))
+	var err C.virError
+	C.virInitializeWrapper(&err)
 }
 
 type ErrorLevel int
@@ -636,7 +640,7 @@ func makeError(err *C.virError) Error {
 		Message: C.GoString(err.message),
 		Level:   ErrorLevel(err.level),
 	}
-	C.virResetError(err)
+	C.virResetErrorWrapper(err)
 	return ret
 }
 
@@ -27,9 +27,10 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdint.h>
 #include "events_wrapper.h"
+#include "connect_wrapper.h"
 */
 import "C"
 
@@ -45,7 +46,9 @@ const (
 // See also https://libvirt.org/html/libvirt-libvirt-event.html#virEventRegisterDefaultImpl
 func EventRegisterDefaultImpl() error {
 	var err C.virError
-	C.virInitialize()
+	if i := int(C.virInitializeWrapper(&err)); i != 0 {
+		return makeError(&err)
+	}
 	if i := int(C.virEventRegisterDefaultImplWrapper(&err)); i != 0 {
 		return makeError(&err)
 	}
@@ -90,7 +93,7 @@ func EventAddHandle(fd int, events EventHandleType, callback EventHandleCallback
 
 // See also https://libvirt.org/html/libvirt-libvirt-event.html#virEventUpdateHandle
 func EventUpdateHandle(watch int, events EventHandleType) {
-	C.virEventUpdateHandle((C.int)(watch), (C.int)(events))
+	C.virEventUpdateHandleWrapper((C.int)(watch), (C.int)(events))
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-event.html#virEventRemoveHandle
@@ -133,7 +136,7 @@ func EventAddTimeout(freq int, callback EventTimeoutCallback) (int, error) {
 
 // See also https://libvirt.org/html/libvirt-libvirt-event.html#virEventUpdateTimeout
 func EventUpdateTimeout(timer int, freq int) {
-	C.virEventUpdateTimeout((C.int)(timer), (C.int)(freq))
+	C.virEventUpdateTimeoutWrapper((C.int)(timer), (C.int)(freq))
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-event.html#virEventRemoveTimeout
@@ -189,7 +192,8 @@ var eventLoopImpl EventLoop
 // See also https://libvirt.org/html/libvirt-libvirt-event.html#virEventRegisterImpl
 func EventRegisterImpl(impl EventLoop) {
 	eventLoopImpl = impl
-	C.virInitialize()
+	var err C.virError
+	C.virInitializeWrapper(&err)
 	C.virEventRegisterImplWrapper()
 }
 
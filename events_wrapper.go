@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdint.h>
 #include <stdlib.h>
 #include "events_wrapper.h"
@@ -189,5 +189,21 @@ virEventRunDefaultImplWrapper(virErrorPtr err)
 }
 
 
+void
+virEventUpdateHandleWrapper(int watch,
+                            int events)
+{
+    virEventUpdateHandle(watch, events);
+}
+
+
+void
+virEventUpdateTimeoutWrapper(int timer,
+                             int timeout)
+{
+    virEventUpdateTimeout(timer, timeout);
+}
+
+
 */
 import "C"
@@ -29,6 +29,7 @@
 
 #include <libvirt/libvirt.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 
 void
 virEventRegisterImplWrapper(void);
@@ -78,5 +79,13 @@ virEventRemoveTimeoutWrapper(int timer,
 int
 virEventRunDefaultImplWrapper(virErrorPtr err);
 
+void
+virEventUpdateHandleWrapper(int watch,
+                            int events);
+
+void
+virEventUpdateTimeoutWrapper(int timer,
+                             int timeout);
+
 
 #endif /* LIBVIRT_GO_EVENTS_WRAPPER_H__ */
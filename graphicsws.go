@@ -0,0 +1,192 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// GraphicsWebSocketConfig controls how a GraphicsWebSocketHandler
+// picks and opens the graphics of a domain.
+type GraphicsWebSocketConfig struct {
+	// Returns the domain to connect a request to, which the handler
+	// frees, and the index of its graphics device. Defaults to the
+	// domain with the UUID in the 'uuid' query parameter, and the
+	// index in the optional 'index' query parameter
+	Lookup func(r *http.Request) (*Domain, uint, error)
+
+	// Decides whether the request may connect, before the WebSocket
+	// handshake. An error rejects the request with 403 Forbidden.
+	// Where browsers can reach the handler, this should check the
+	// Origin header
+	Authorize func(r *http.Request, dom *Domain) error
+
+	// Flags for OpenGraphicsFD. With DOMAIN_OPEN_GRAPHICS_SKIPAUTH
+	// the client is not asked for the VNC or SPICE password, so
+	// Authorize must check access instead
+	Flags DomainOpenGraphicsFlags
+
+	// How often the domain is checked for having shut down, in
+	// addition to checks prompted by lifecycle events. Defaults to
+	// five seconds
+	PollInterval time.Duration
+}
+
+// GraphicsWebSocketHandler is an http.Handler which connects WebSocket
+// clients, such as noVNC, to the VNC or SPICE server of a domain. The
+// bytes of the graphics protocol are carried in binary messages, and
+// the connection is closed when the domain shuts down.
+type GraphicsWebSocketHandler struct {
+	conn    *Connect
+	config  GraphicsWebSocketConfig
+	watcher *lifecycleWatcher
+}
+
+// NewGraphicsWebSocketHandler creates a handler for the domains of
+// conn. The config may be nil to use the defaults.
+func NewGraphicsWebSocketHandler(conn *Connect, config *GraphicsWebSocketConfig) *GraphicsWebSocketHandler {
+	h := &GraphicsWebSocketHandler{
+		conn:    conn,
+		watcher: newLifecycleWatcher(conn),
+	}
+	if config != nil {
+		h.config = *config
+	}
+	if h.config.Lookup == nil {
+		h.config.Lookup = h.lookup
+	}
+	if h.config.PollInterval == 0 {
+		h.config.PollInterval = 5 * time.Second
+	}
+	return h
+}
+
+// Close stops watching for domains shutting down. Connections which
+// are open are not closed.
+func (h *GraphicsWebSocketHandler) Close() {
+	h.watcher.close()
+}
+
+func (h *GraphicsWebSocketHandler) lookup(r *http.Request) (*Domain, uint, error) {
+	query := r.URL.Query()
+	index := uint64(0)
+	if value := query.Get("index"); value != "" {
+		var err error
+		if index, err = strconv.ParseUint(value, 10, 32); err != nil {
+			return nil, 0, Error{
+				Code:    ERR_INVALID_ARG,
+				Domain:  FROM_DOMAIN,
+				Message: "Invalid graphics index '" + value + "'",
+				Level:   ERR_ERROR,
+			}
+		}
+	}
+	dom, err := h.conn.LookupDomainByUUIDString(query.Get("uuid"))
+	if err != nil {
+		return nil, 0, err
+	}
+	return dom, uint(index), nil
+}
+
+// Sends the HTTP error matching an error from looking up a domain
+func webSocketLookupError(w http.ResponseWriter, err error) {
+	if virErr, ok := err.(Error); ok {
+		switch virErr.Code {
+		case ERR_NO_DOMAIN, ERR_INVALID_ARG:
+			http.Error(w, virErr.Message, http.StatusNotFound)
+			return
+		}
+	}
+	http.Error(w, err.Error(), http.StatusInternalServerError)
+}
+
+// Returns a context which is done once the domain is no longer running,
+// and a function to stop watching the domain
+func watchDomainShutdown(watcher *lifecycleWatcher, dom *Domain, pollInterval time.Duration) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		_, err := watcher.waitStatus(ctx, dom, pollInterval, func(status *DomainStatus) bool {
+			return status.State == DOMAIN_SHUTOFF || status.State == DOMAIN_CRASHED
+		})
+		if err == nil {
+			cancel()
+		}
+	}()
+	return ctx, func() {
+		cancel()
+		<-stopped
+	}
+}
+
+func (h *GraphicsWebSocketHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !wsIsUpgrade(r) {
+		http.Error(w, "Expected a WebSocket request", http.StatusBadRequest)
+		return
+	}
+
+	dom, index, err := h.config.Lookup(r)
+	if err != nil {
+		webSocketLookupError(w, err)
+		return
+	}
+	defer dom.Free()
+
+	if h.config.Authorize != nil {
+		if err := h.config.Authorize(r, dom); err != nil {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+	}
+
+	file, err := dom.OpenGraphicsFD(index, h.config.Flags)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	peer, err := net.FileConn(file)
+	file.Close()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// noVNC asks for the 'binary' subprotocol in older versions
+	ws, err := wsUpgrade(w, r, []string{"binary"})
+	if err != nil {
+		peer.Close()
+		return
+	}
+
+	ctx, stop := watchDomainShutdown(h.watcher, dom, h.config.PollInterval)
+	defer stop()
+	ws.bridge(ctx, peer, func() { peer.Close() }, "Domain shut down")
+}
@@ -0,0 +1,260 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"syscall"
+	"testing"
+)
+
+// Connects to a WebSocket server, returning the connection and the
+// reader positioned after the handshake response
+func dialTestWebSocket(t *testing.T, url string, protocol string) (net.Conn, *bufio.Reader) {
+	addr := strings.TrimPrefix(url, "http://")
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	request := "GET /?uuid=x HTTP/1.1\r\n" +
+		"Host: " + addr + "\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Key: dGhlIHNhbXBsZSBub25jZQ==\r\n" +
+		"Sec-WebSocket-Version: 13\r\n"
+	if protocol != "" {
+		request += "Sec-WebSocket-Protocol: " + protocol + "\r\n"
+	}
+	if _, err := conn.Write([]byte(request + "\r\n")); err != nil {
+		t.Fatal(err)
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("Handshake status %d", resp.StatusCode)
+	}
+	// The example key and accept value from RFC 6455
+	if accept := resp.Header.Get("Sec-WebSocket-Accept"); accept != "s3pPLMBiTxaQ9kYGzzhZRbK+xOo=" {
+		t.Fatalf("Unexpected accept value %q", accept)
+	}
+	if got := resp.Header.Get("Sec-WebSocket-Protocol"); got != protocol {
+		t.Fatalf("Subprotocol %q, expected %q", got, protocol)
+	}
+	return conn, reader
+}
+
+// Sends a masked frame, as clients must
+func writeTestFrame(t *testing.T, conn net.Conn, header byte, payload []byte) {
+	mask := []byte{0x12, 0x34, 0x56, 0x78}
+	frame := []byte{header, 0x80 | byte(len(payload))}
+	frame = append(frame, mask...)
+	for i, b := range payload {
+		frame = append(frame, b^mask[i%4])
+	}
+	if _, err := conn.Write(frame); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestFrame(t *testing.T, reader *bufio.Reader) (byte, []byte) {
+	var header [2]byte
+	if _, err := io.ReadFull(reader, header[:]); err != nil {
+		t.Fatal(err)
+	}
+	length := int(header[1] & 0x7f)
+	if length == 126 {
+		var ext [2]byte
+		if _, err := io.ReadFull(reader, ext[:]); err != nil {
+			t.Fatal(err)
+		}
+		length = int(binary.BigEndian.Uint16(ext[:]))
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(reader, payload); err != nil {
+		t.Fatal(err)
+	}
+	return header[0], payload
+}
+
+func TestWebSocketBridge(t *testing.T) {
+	fds, err := syscall.Socketpair(syscall.AF_UNIX, syscall.SOCK_STREAM, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// One end stands in for the graphics server, the other for the
+	// file returned by OpenGraphicsFD
+	serverFile := os.NewFile(uintptr(fds[0]), "server")
+	server, err := net.FileConn(serverFile)
+	serverFile.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer server.Close()
+	peerFile := os.NewFile(uintptr(fds[1]), "peer")
+	peer, err := net.FileConn(peerFile)
+	peerFile.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	finished := make(chan struct{})
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer close(finished)
+		ws, err := wsUpgrade(w, r, []string{"binary"})
+		if err != nil {
+			peer.Close()
+			return
+		}
+		ws.bridge(context.Background(), peer, func() { peer.Close() }, "")
+	}))
+	defer httpServer.Close()
+
+	conn, reader := dialTestWebSocket(t, httpServer.URL, "binary")
+	defer conn.Close()
+
+	// A message in two fragments, with a ping between them
+	writeTestFrame(t, conn, wsOpBinary, []byte("RFB "))
+	writeTestFrame(t, conn, 0x80|wsOpPing, []byte("ping"))
+	writeTestFrame(t, conn, 0x80|wsOpContinuation, []byte("003.008\n"))
+	data := make([]byte, 12)
+	if _, err := io.ReadFull(server, data); err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "RFB 003.008\n" {
+		t.Fatalf("Server received %q", data)
+	}
+	if op, payload := readTestFrame(t, reader); op != 0x80|wsOpPong || string(payload) != "ping" {
+		t.Fatalf("Expected a pong, got %#x %q", op, payload)
+	}
+
+	large := bytes.Repeat([]byte("x"), 1000)
+	if _, err := server.Write(large); err != nil {
+		t.Fatal(err)
+	}
+	var received []byte
+	for len(received) < len(large) {
+		op, payload := readTestFrame(t, reader)
+		if op != 0x80|wsOpBinary {
+			t.Fatalf("Unexpected frame %#x", op)
+		}
+		received = append(received, payload...)
+	}
+	if !bytes.Equal(received, large) {
+		t.Fatal("Client received different data")
+	}
+
+	// Closing the server end closes the WebSocket
+	server.Close()
+	if op, payload := readTestFrame(t, reader); op != 0x80|wsOpClose ||
+		binary.BigEndian.Uint16(payload) != wsCloseNormal {
+		t.Fatalf("Expected a close frame, got %#x %q", op, payload)
+	}
+	<-finished
+}
+
+func TestWebSocketUnmaskedFrame(t *testing.T) {
+	finished := make(chan error, 1)
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := wsUpgrade(w, r, nil)
+		if err != nil {
+			finished <- err
+			return
+		}
+		_, err = ws.Read(make([]byte, 16))
+		ws.close(wsCloseNormal, "")
+		finished <- err
+	}))
+	defer httpServer.Close()
+
+	conn, reader := dialTestWebSocket(t, httpServer.URL, "")
+	defer conn.Close()
+	if _, err := conn.Write([]byte{0x80 | wsOpBinary, 2, 'h', 'i'}); err != nil {
+		t.Fatal(err)
+	}
+	if op, payload := readTestFrame(t, reader); op != 0x80|wsOpClose ||
+		binary.BigEndian.Uint16(payload) != wsCloseProtocolError {
+		t.Fatalf("Expected a protocol error, got %#x %q", op, payload)
+	}
+	if err := <-finished; err != errWebSocketProtocol {
+		t.Fatalf("Expected a protocol error, got %v", err)
+	}
+}
+
+func TestGraphicsWebSocketHandler(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Destroy()
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+	uuid, err := dom.GetUUIDString()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handler := NewGraphicsWebSocketHandler(conn, nil)
+	defer handler.Close()
+
+	request := func(query string, upgrade bool) int {
+		r := httptest.NewRequest("GET", "/?"+query, nil)
+		if upgrade {
+			r.Header.Set("Connection", "Upgrade")
+			r.Header.Set("Upgrade", "websocket")
+			r.Header.Set("Sec-WebSocket-Version", "13")
+			r.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+		}
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	if code := request("uuid="+uuid, false); code != http.StatusBadRequest {
+		t.Errorf("Plain request gave status %d", code)
+	}
+	if code := request("uuid=00000000-0000-0000-0000-000000000000", true); code != http.StatusNotFound {
+		t.Errorf("Missing domain gave status %d", code)
+	}
+	// The test driver has no graphics to open
+	if code := request("uuid="+uuid, true); code != http.StatusBadGateway {
+		t.Errorf("Domain without graphics gave status %d", code)
+	}
+}
@@ -0,0 +1,291 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+// Debugging aid for finding object handles which are never freed.
+//
+// When tracking is enabled, every handle returned by a lookup, list
+// or create method is recorded along with the stack of the caller
+// which obtained it. Calls to Ref() and Free() adjust the recorded
+// reference count, and a handle is forgotten once the count drops to
+// zero. Only the most recently freed handles are remembered, to report
+// them being freed again. Whatever is left over at any point can be
+// listed with TrackedHandles() or written out with
+// DumpTrackedHandles().
+//
+// Handles passed to event callbacks are owned by libvirt and are not
+// tracked, unless the callback takes its own reference with Ref().
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+type TrackedHandle struct {
+	// Name of the Go type, eg "Domain" or "StorageVol"
+	Type string
+	// Address of the underlying C object
+	Pointer uintptr
+	// Number of outstanding references
+	Refs int
+	// Stack trace of the caller which first obtained the handle
+	Stack string
+}
+
+type handleRecord struct {
+	ptr   unsafe.Pointer
+	kind  string
+	refs  int
+	stack string
+}
+
+// Number of freed handles remembered to report double frees
+const handleFreedLimit = 1024
+
+var handleTrackingEnabled int32
+
+var handleTracking = struct {
+	lock              sync.Mutex
+	panicOnDoubleFree bool
+	handles           map[unsafe.Pointer]*handleRecord
+	// The most recently freed handles, and the order they were
+	// freed in, oldest first from freedNext
+	freed      map[unsafe.Pointer]*handleRecord
+	freedOrder []*handleRecord
+	freedNext  int
+}{}
+
+// EnableHandleTracking starts recording object handles. Handles
+// obtained before tracking was enabled are not recorded. If
+// panicOnDoubleFree is set, calling Free() on a tracked handle which
+// has no references left panics, rather than returning an error.
+//
+// Building with the 'libvirt_track_handles' tag enables tracking,
+// with panicOnDoubleFree set, before the program starts.
+func EnableHandleTracking(panicOnDoubleFree bool) {
+	handleTracking.lock.Lock()
+	defer handleTracking.lock.Unlock()
+
+	handleTracking.panicOnDoubleFree = panicOnDoubleFree
+	if handleTracking.handles == nil {
+		handleTracking.handles = make(map[unsafe.Pointer]*handleRecord)
+		handleTracking.freed = make(map[unsafe.Pointer]*handleRecord)
+	}
+	atomic.StoreInt32(&handleTrackingEnabled, 1)
+}
+
+// DisableHandleTracking stops recording object handles and discards
+// everything recorded so far.
+func DisableHandleTracking() {
+	handleTracking.lock.Lock()
+	defer handleTracking.lock.Unlock()
+
+	atomic.StoreInt32(&handleTrackingEnabled, 0)
+	handleTracking.handles = nil
+	handleTracking.freed = nil
+	handleTracking.freedOrder = nil
+	handleTracking.freedNext = 0
+}
+
+// TrackedHandles returns all handles which currently have outstanding
+// references, ordered by type and address.
+func TrackedHandles() []TrackedHandle {
+	handleTracking.lock.Lock()
+	defer handleTracking.lock.Unlock()
+
+	handles := []TrackedHandle{}
+	for ptr, rec := range handleTracking.handles {
+		handles = append(handles, TrackedHandle{
+			Type:    rec.kind,
+			Pointer: uintptr(ptr),
+			Refs:    rec.refs,
+			Stack:   rec.stack,
+		})
+	}
+
+	sort.Slice(handles, func(i, j int) bool {
+		if handles[i].Type != handles[j].Type {
+			return handles[i].Type < handles[j].Type
+		}
+		return handles[i].Pointer < handles[j].Pointer
+	})
+	return handles
+}
+
+// DumpTrackedHandles writes a report of all handles which currently
+// have outstanding references to w.
+func DumpTrackedHandles(w io.Writer) error {
+	handles := TrackedHandles()
+
+	if _, err := fmt.Fprintf(w, "%d outstanding libvirt handles\n", len(handles)); err != nil {
+		return err
+	}
+	for _, h := range handles {
+		_, err := fmt.Fprintf(w, "\n%s %#x refs=%d\n%s",
+			h.Type, h.Pointer, h.Refs, h.Stack)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func handleStack() string {
+	pc := make([]uintptr, 32)
+	// Skip runtime.Callers, handleStack, trackHandle*
+	n := runtime.Callers(3, pc)
+	frames := runtime.CallersFrames(pc[:n])
+
+	var buf strings.Builder
+	for {
+		frame, more := frames.Next()
+		fmt.Fprintf(&buf, "\t%s\n\t\t%s:%d\n", frame.Function, frame.File, frame.Line)
+		if !more {
+			break
+		}
+	}
+	return buf.String()
+}
+
+// Remembers a handle whose last reference was released. The caller
+// holds the lock
+func rememberFreedHandle(rec *handleRecord) {
+	delete(handleTracking.handles, rec.ptr)
+	if len(handleTracking.freedOrder) < handleFreedLimit {
+		handleTracking.freedOrder = append(handleTracking.freedOrder, rec)
+	} else {
+		oldest := handleTracking.freedOrder[handleTracking.freedNext]
+		if handleTracking.freed[oldest.ptr] == oldest {
+			delete(handleTracking.freed, oldest.ptr)
+		}
+		handleTracking.freedOrder[handleTracking.freedNext] = rec
+		handleTracking.freedNext = (handleTracking.freedNext + 1) % handleFreedLimit
+	}
+	handleTracking.freed[rec.ptr] = rec
+}
+
+// Records a handle obtained with one reference, which libvirt may
+// have allocated at the address of one freed earlier. The caller
+// holds the lock
+func addHandleRecord(kind string, ptr unsafe.Pointer, stack string) {
+	delete(handleTracking.freed, ptr)
+	handleTracking.handles[ptr] = &handleRecord{
+		ptr:   ptr,
+		kind:  kind,
+		refs:  1,
+		stack: stack,
+	}
+}
+
+// Records a new reference to a handle, returned by the libvirt API
+func trackHandle(kind string, ptr unsafe.Pointer) {
+	if atomic.LoadInt32(&handleTrackingEnabled) == 0 {
+		return
+	}
+
+	stack := handleStack()
+
+	handleTracking.lock.Lock()
+	defer handleTracking.lock.Unlock()
+
+	if handleTracking.handles == nil {
+		return
+	}
+	rec, ok := handleTracking.handles[ptr]
+	if !ok || rec.kind != kind {
+		addHandleRecord(kind, ptr, stack)
+		return
+	}
+	rec.refs++
+}
+
+// Records an extra reference to a handle, taken by its Ref() method
+func trackHandleRef(kind string, ptr unsafe.Pointer) {
+	if atomic.LoadInt32(&handleTrackingEnabled) == 0 {
+		return
+	}
+
+	stack := handleStack()
+
+	handleTracking.lock.Lock()
+	defer handleTracking.lock.Unlock()
+
+	if handleTracking.handles == nil {
+		return
+	}
+	rec, ok := handleTracking.handles[ptr]
+	if !ok {
+		// Not obtained while tracking was enabled, or owned
+		// by libvirt as in event callbacks. Only the new
+		// reference is the caller's responsibility
+		addHandleRecord(kind, ptr, stack)
+		return
+	}
+	rec.refs++
+}
+
+// Records a reference being released by a handle's Free() method.
+// Returns an error if the handle is known to have been freed already,
+// in which case the caller must not pass it on to libvirt
+func trackHandleFree(kind string, ptr unsafe.Pointer) error {
+	if atomic.LoadInt32(&handleTrackingEnabled) == 0 {
+		return nil
+	}
+
+	handleTracking.lock.Lock()
+	defer handleTracking.lock.Unlock()
+
+	if handleTracking.handles == nil {
+		return nil
+	}
+	if rec, ok := handleTracking.handles[ptr]; ok {
+		rec.refs--
+		if rec.refs == 0 {
+			rememberFreedHandle(rec)
+		}
+		return nil
+	}
+	if rec, ok := handleTracking.freed[ptr]; ok {
+		msg := fmt.Sprintf("%s handle %p freed with no references left, first obtained at\n%s",
+			kind, ptr, rec.stack)
+		if handleTracking.panicOnDoubleFree {
+			panic(msg)
+		}
+		return Error{
+			Code:    ERR_INVALID_ARG,
+			Domain:  FROM_NONE,
+			Message: msg,
+			Level:   ERR_ERROR,
+		}
+	}
+	return nil
+}
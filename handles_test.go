@@ -0,0 +1,203 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+func TestHandleTracking(t *testing.T) {
+	EnableHandleTracking(false)
+	defer DisableHandleTracking()
+
+	conn := buildTestConnection()
+	defer conn.Close()
+
+	dom, err := conn.LookupDomainByName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	handles := TrackedHandles()
+	if len(handles) != 1 {
+		t.Fatalf("Expected 1 tracked handle, got %d", len(handles))
+	}
+	if handles[0].Type != "Domain" || handles[0].Refs != 1 {
+		t.Fatalf("Unexpected tracked handle %s refs=%d", handles[0].Type, handles[0].Refs)
+	}
+	if !strings.Contains(handles[0].Stack, "TestHandleTracking") {
+		t.Fatalf("Expected creation stack to include the test, got\n%s", handles[0].Stack)
+	}
+
+	if err := dom.Ref(); err != nil {
+		t.Fatal(err)
+	}
+	if handles = TrackedHandles(); handles[0].Refs != 2 {
+		t.Fatalf("Expected 2 references, got %d", handles[0].Refs)
+	}
+
+	var buf bytes.Buffer
+	if err := DumpTrackedHandles(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(buf.String(), "1 outstanding libvirt handles\n") {
+		t.Fatalf("Unexpected dump\n%s", buf.String())
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := dom.Free(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if handles = TrackedHandles(); len(handles) != 0 {
+		t.Fatalf("Expected no tracked handles, got %d", len(handles))
+	}
+
+	if err := dom.Free(); err == nil {
+		t.Fatal("Expected an error from a double free")
+	}
+}
+
+func TestHandleTrackingList(t *testing.T) {
+	EnableHandleTracking(false)
+	defer DisableHandleTracking()
+
+	conn := buildTestConnection()
+	defer conn.Close()
+
+	doms, err := conn.ListAllDomains(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(TrackedHandles()) != len(doms) {
+		t.Fatalf("Expected %d tracked handles, got %d", len(doms), len(TrackedHandles()))
+	}
+	for _, dom := range doms {
+		dom.Free()
+	}
+	if len(TrackedHandles()) != 0 {
+		t.Fatalf("Expected no tracked handles, got %d", len(TrackedHandles()))
+	}
+}
+
+func TestHandleTrackingDoubleFreePanic(t *testing.T) {
+	EnableHandleTracking(true)
+	defer DisableHandleTracking()
+
+	conn := buildTestConnection()
+	defer conn.Close()
+
+	net, err := conn.LookupNetworkByName("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := net.Free(); err != nil {
+		t.Fatal(err)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatal("Expected a panic from a double free")
+		}
+	}()
+	net.Free()
+}
+
+func TestHandleTrackingFreedLimit(t *testing.T) {
+	EnableHandleTracking(false)
+	defer DisableHandleTracking()
+
+	// Stand-ins for the addresses of C objects
+	objects := make([]byte, handleFreedLimit+1)
+	for i := range objects {
+		ptr := unsafe.Pointer(&objects[i])
+		trackHandle("Domain", ptr)
+		if err := trackHandleFree("Domain", ptr); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	handleTracking.lock.Lock()
+	handles, freed := len(handleTracking.handles), len(handleTracking.freed)
+	handleTracking.lock.Unlock()
+	if handles != 0 || freed != handleFreedLimit {
+		t.Fatalf("Expected no handles and %d freed, got %d and %d", handleFreedLimit, handles, freed)
+	}
+
+	// The oldest is forgotten, while the newest is still reported
+	if err := trackHandleFree("Domain", unsafe.Pointer(&objects[0])); err != nil {
+		t.Fatalf("Unexpected error freeing a forgotten handle: %v", err)
+	}
+	if err := trackHandleFree("Domain", unsafe.Pointer(&objects[handleFreedLimit])); err == nil {
+		t.Fatal("Expected an error from a double free")
+	}
+
+	// An address reused for a new handle may be freed again
+	trackHandle("Domain", unsafe.Pointer(&objects[handleFreedLimit]))
+	if err := trackHandleFree("Domain", unsafe.Pointer(&objects[handleFreedLimit])); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestHandleTrackingDomainStats(t *testing.T) {
+	EnableHandleTracking(true)
+	defer DisableHandleTracking()
+
+	conn := buildTestConnection()
+	defer conn.Close()
+
+	// The domains of the stats may be given the address of one freed
+	// earlier
+	dom, err := conn.LookupDomainByName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dom.Free(); err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := conn.GetAllDomainStats(nil, DOMAIN_STATS_STATE, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) == 0 {
+		t.Fatal("Expected stats for the test domain")
+	}
+	if len(TrackedHandles()) != len(stats) {
+		t.Fatalf("Expected %d tracked handles, got %d", len(stats), len(TrackedHandles()))
+	}
+	for _, stat := range stats {
+		if err := stat.Domain.Free(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(TrackedHandles()) != 0 {
+		t.Fatalf("Expected no tracked handles, got %d", len(TrackedHandles()))
+	}
+}
@@ -0,0 +1,56 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+// Package imageinfo reads the headers of disk images
+//
+// It identifies the format of an image from its content, rather than
+// from its name or from what the storage pool claims, and reports
+// the virtual size, cluster size, backing file and encryption of the
+// image. It needs neither qemu-img nor libvirt, and works with any
+// io.ReaderAt, so an image can be inspected over a libvirt volume
+// stream as easily as from a local file.
+//
+// The qcow2, qcow and LUKS formats are decoded in full. QED, VMDK,
+// VDI, VPC, VHDX and ISO images are recognised so that they are not
+// mistaken for raw images, but only some of their details are read.
+// Anything else is reported as raw.
+//
+// The main use is to check that an image is of the format it is
+// declared to be:
+//
+//	info, err := imageinfo.InspectFile("/var/lib/libvirt/images/demo.img")
+//	...
+//	if !info.MatchesFormat("raw") {
+//	        return &imageinfo.FormatMismatchError{Declared: "raw", Detected: info.Format}
+//	}
+//
+// Note that the content of a raw image is under the control of the
+// guest using it, which may write any header it likes. A raw image
+// which appears to be qcow2 must never be opened as qcow2, as that
+// would give the guest access to the backing file it names.
+//
+// The libvirt package builds on this with StorageVol.InspectImage
+// and StorageVol.CheckFormat.
+package imageinfo
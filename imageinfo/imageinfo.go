@@ -0,0 +1,301 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package imageinfo
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Format is the name of an image format, as used in the libvirt
+// volume and domain XML
+type Format string
+
+const (
+	FORMAT_RAW   = Format("raw")
+	FORMAT_QCOW2 = Format("qcow2")
+	FORMAT_QCOW  = Format("qcow")
+	FORMAT_LUKS  = Format("luks")
+	FORMAT_QED   = Format("qed")
+	FORMAT_VMDK  = Format("vmdk")
+	FORMAT_VDI   = Format("vdi")
+	FORMAT_VPC   = Format("vpc")
+	FORMAT_VHDX  = Format("vhdx")
+	FORMAT_ISO   = Format("iso")
+)
+
+// Info describes an image
+type Info struct {
+	Format Format
+
+	// Version of the format, for qcow2 and LUKS
+	Version int
+
+	// Size of the disk seen by the guest, or 0 where it is not known
+	VirtualSize uint64
+
+	// Size of the image itself, as given to Inspect
+	PhysicalSize int64
+
+	// Cluster size, for qcow, qcow2 and QED
+	ClusterSize uint64
+
+	// Backing file exactly as named in the image, and its format if
+	// the image records it
+	BackingFile   string
+	BackingFormat Format
+
+	// External data file of a qcow2 image
+	DataFile string
+
+	// Whether the content is encrypted, and how: "aes" or "luks"
+	// for qcow and qcow2, and "luks" for LUKS images
+	Encrypted        bool
+	EncryptionFormat string
+
+	// Cipher, eg "aes-xts-plain64", and UUID of a LUKS header
+	Cipher string
+	UUID   string
+
+	// Whether the qcow2 image is marked as dirty or corrupt
+	Dirty   bool
+	Corrupt bool
+
+	// The backing image, when filled in by InspectFileChain
+	Backing *Info
+}
+
+// FormatMismatchError reports an image which is not of the format
+// it was declared to be
+type FormatMismatchError struct {
+	Declared string
+	Detected Format
+}
+
+func (e *FormatMismatchError) Error() string {
+	return fmt.Sprintf("image format is %s, but declared as %s", e.Detected, e.Declared)
+}
+
+var errTruncated = errors.New("image header is truncated")
+var errTooLarge = errors.New("image header is too large")
+
+// Most headers fit within this, so it is read in one go
+const headerReadSize = 64 * 1024
+
+// The most read of a header beyond the first part, which the largest,
+// LUKS2 metadata, fits within. Images may come from guests, so their
+// headers are not trusted with more
+const headerMaxRead = 4 * 1024 * 1024
+
+// The first part of an image, which reads further ranges on demand
+type header struct {
+	r    io.ReaderAt
+	buf  []byte
+	size int64
+}
+
+func (h *header) bytes(off, length uint64) ([]byte, error) {
+	if off+length < off {
+		return nil, errTruncated
+	}
+	if off+length <= uint64(len(h.buf)) {
+		return h.buf[off : off+length], nil
+	}
+	if h.size >= 0 && off+length > uint64(h.size) {
+		return nil, errTruncated
+	}
+	if length > headerMaxRead {
+		return nil, errTooLarge
+	}
+	data := make([]byte, length)
+	n, err := h.r.ReadAt(data, int64(off))
+	if n == len(data) {
+		return data, nil
+	}
+	if err == nil || err == io.EOF {
+		err = errTruncated
+	}
+	return nil, err
+}
+
+func (h *header) has(off uint64, magic string) bool {
+	return off+uint64(len(magic)) <= uint64(len(h.buf)) &&
+		string(h.buf[off:off+uint64(len(magic))]) == magic
+}
+
+// Inspect identifies the format of the image read from r, and decodes
+// its header. The size is that of the image, or -1 if it is not known,
+// and is used for the virtual size of raw and LUKS images.
+func Inspect(r io.ReaderAt, size int64) (*Info, error) {
+	readSize := int64(headerReadSize)
+	if size >= 0 && size < readSize {
+		readSize = size
+	}
+	buf := make([]byte, readSize)
+	n, err := r.ReadAt(buf, 0)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	h := &header{r: r, buf: buf[:n], size: size}
+	info := &Info{PhysicalSize: size}
+
+	switch {
+	case h.has(0, qcowMagic):
+		err = inspectQCow(h, info)
+	case h.has(0, luksMagic):
+		err = inspectLUKS(h, info)
+	case h.has(0, qedMagic):
+		err = inspectQED(h, info)
+	case h.has(0, vmdkMagic), h.has(0, vmdkDescriptorMagic):
+		err = inspectVMDK(h, info)
+	case h.has(vdiMagicOffset, vdiMagic):
+		err = inspectVDI(h, info)
+	case h.has(0, vpcMagic):
+		err = inspectVPC(h, info)
+	case h.has(0, vhdxMagic):
+		info.Format = FORMAT_VHDX
+	case h.has(isoMagicOffset, isoMagic):
+		info.Format = FORMAT_ISO
+		if size > 0 {
+			info.VirtualSize = uint64(size)
+		}
+	default:
+		info.Format = FORMAT_RAW
+		if size > 0 {
+			info.VirtualSize = uint64(size)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// InspectFile is Inspect for a local file
+func InspectFile(path string) (*Info, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	st, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := st.Size()
+	if !st.Mode().IsRegular() {
+		// Block devices report no size, so find it by seeking
+		if size, err = file.Seek(0, io.SeekEnd); err != nil {
+			size = -1
+		}
+	}
+	return Inspect(file, size)
+}
+
+// MaxChainDepth is the longest backing chain which is followed, as
+// with qemu
+const MaxChainDepth = 64
+
+// InspectFileChain is InspectFile which also inspects the backing
+// chain of the image, linking each image to its backing image with the
+// Backing field. Backing files with relative names are looked for next
+// to the image which names them. The chain stops at a backing file
+// which is not a local file, such as an NBD URI.
+func InspectFileChain(path string) (*Info, error) {
+	seen := make(map[string]bool)
+	var top, prev *Info
+	for depth := 0; ; depth++ {
+		if depth == MaxChainDepth {
+			return nil, fmt.Errorf("backing chain of %s is longer than %d images", path, MaxChainDepth)
+		}
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			return nil, err
+		}
+		if seen[abs] {
+			return nil, fmt.Errorf("backing chain loops back to %s", path)
+		}
+		seen[abs] = true
+
+		info, err := InspectFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if top == nil {
+			top = info
+		} else {
+			prev.Backing = info
+		}
+		prev = info
+
+		backing := ResolveBackingFile(path, info.BackingFile)
+		if backing == "" {
+			return top, nil
+		}
+		path = backing
+	}
+}
+
+// ResolveBackingFile returns the path of the backing file named in the
+// image at path, or "" if there is no backing file or it is not a
+// local file.
+func ResolveBackingFile(path, backing string) string {
+	if backing == "" || strings.Contains(backing, "://") ||
+		strings.HasPrefix(backing, "json:") || strings.HasPrefix(backing, "nbd:") {
+		return ""
+	}
+	backing = strings.TrimPrefix(backing, "file:")
+	if filepath.IsAbs(backing) {
+		return backing
+	}
+	return filepath.Join(filepath.Dir(path), backing)
+}
+
+// MatchesFormat reports whether the image is of the given format, as
+// found in the <format> element of a volume or the <driver> element of
+// a domain disk. Formats not known here, such as the partition types
+// of disk pools, are taken to mean raw. An ISO image matches raw, and
+// a raw image matches iso, since ISO images are read as raw. A LUKS
+// image must be declared as luks: libvirt declares them as raw with a
+// LUKS <encryption>, which the caller should translate.
+func (i *Info) MatchesFormat(format string) bool {
+	declared := Format(format)
+	switch declared {
+	case FORMAT_QCOW2, FORMAT_QCOW, FORMAT_LUKS, FORMAT_QED, FORMAT_VMDK,
+		FORMAT_VDI, FORMAT_VPC, FORMAT_VHDX, FORMAT_ISO:
+	default:
+		declared = FORMAT_RAW
+	}
+	if declared == i.Format {
+		return true
+	}
+	return (declared == FORMAT_RAW && i.Format == FORMAT_ISO) ||
+		(declared == FORMAT_ISO && i.Format == FORMAT_RAW)
+}
@@ -0,0 +1,269 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package imageinfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+type testQCow2 struct {
+	version       uint32
+	size          uint64
+	clusterBits   uint32
+	cryptMethod   uint32
+	incompatible  uint64
+	backingFile   string
+	backingFormat string
+}
+
+// Builds the first cluster of a qcow2 image, laid out as qemu-img does
+func (q *testQCow2) build() []byte {
+	buf := make([]byte, 1<<q.clusterBits)
+	copy(buf, qcowMagic)
+	binary.BigEndian.PutUint32(buf[qcow2VersionOffset:], q.version)
+	binary.BigEndian.PutUint32(buf[qcow2ClusterBitsOffset:], q.clusterBits)
+	binary.BigEndian.PutUint64(buf[qcow2SizeOffset:], q.size)
+	binary.BigEndian.PutUint32(buf[qcow2CryptMethodOffset:], q.cryptMethod)
+
+	offset := uint64(qcow2V2HeaderLength)
+	if q.version >= 3 {
+		binary.BigEndian.PutUint64(buf[qcow2IncompatibleOffset:], q.incompatible)
+		binary.BigEndian.PutUint32(buf[qcow2HeaderLengthOffset:], qcow2V3HeaderLength)
+		offset = qcow2V3HeaderLength
+	}
+	if q.backingFormat != "" {
+		binary.BigEndian.PutUint32(buf[offset:], qcow2ExtBackingFormat)
+		binary.BigEndian.PutUint32(buf[offset+4:], uint32(len(q.backingFormat)))
+		copy(buf[offset+8:], q.backingFormat)
+		offset += 8 + (uint64(len(q.backingFormat))+7)&^7
+	}
+	offset += 8 // end of extensions
+	if q.backingFile != "" {
+		binary.BigEndian.PutUint64(buf[qcow2BackingOffsetOffset:], offset)
+		binary.BigEndian.PutUint32(buf[qcow2BackingSizeOffset:], uint32(len(q.backingFile)))
+		copy(buf[offset:], q.backingFile)
+	}
+	return buf
+}
+
+func inspectBytes(t *testing.T, data []byte) *Info {
+	info, err := Inspect(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return info
+}
+
+func TestInspectQCow2(t *testing.T) {
+	image := &testQCow2{
+		version:       3,
+		size:          10 << 30,
+		clusterBits:   16,
+		cryptMethod:   2,
+		incompatible:  qcow2IncompatDirty,
+		backingFile:   "base.qcow2",
+		backingFormat: "qcow2",
+	}
+	info := inspectBytes(t, image.build())
+	expect := Info{
+		Format:           FORMAT_QCOW2,
+		Version:          3,
+		VirtualSize:      10 << 30,
+		PhysicalSize:     1 << 16,
+		ClusterSize:      1 << 16,
+		BackingFile:      "base.qcow2",
+		BackingFormat:    FORMAT_QCOW2,
+		Encrypted:        true,
+		EncryptionFormat: "luks",
+		Dirty:            true,
+	}
+	if *info != expect {
+		t.Fatalf("Unexpected info %+v", info)
+	}
+}
+
+func TestInspectQCow2Version2(t *testing.T) {
+	image := &testQCow2{
+		version:     2,
+		size:        1 << 30,
+		clusterBits: 16,
+		backingFile: "/var/lib/libvirt/images/base.img",
+	}
+	info := inspectBytes(t, image.build())
+	if info.Format != FORMAT_QCOW2 || info.Version != 2 ||
+		info.BackingFile != "/var/lib/libvirt/images/base.img" ||
+		info.BackingFormat != "" || info.Encrypted {
+		t.Fatalf("Unexpected info %+v", info)
+	}
+}
+
+func TestInspectQCow2Invalid(t *testing.T) {
+	image := &testQCow2{version: 3, size: 1 << 30, clusterBits: 16}
+	data := image.build()
+
+	if _, err := Inspect(bytes.NewReader(data[:50]), 50); err == nil {
+		t.Fatal("Expected an error for a truncated header")
+	}
+
+	binary.BigEndian.PutUint32(data[qcow2ClusterBitsOffset:], 40)
+	if _, err := Inspect(bytes.NewReader(data), int64(len(data))); err == nil {
+		t.Fatal("Expected an error for a bad cluster size")
+	}
+}
+
+func TestInspectLUKS1(t *testing.T) {
+	data := make([]byte, 8192)
+	copy(data, luksMagic)
+	binary.BigEndian.PutUint16(data[luksVersionOffset:], 1)
+	copy(data[luks1CipherNameOffset:], "aes")
+	copy(data[luks1CipherModeOffset:], "xts-plain64")
+	binary.BigEndian.PutUint32(data[luks1PayloadOffsetOffset:], 4)
+	copy(data[luksUUIDOffset:], "0d6f4c4a-27b3-4bbe-8ad4-5b1b1b7e8f10")
+
+	info := inspectBytes(t, data)
+	if info.Format != FORMAT_LUKS || info.Version != 1 ||
+		info.Cipher != "aes-xts-plain64" ||
+		info.UUID != "0d6f4c4a-27b3-4bbe-8ad4-5b1b1b7e8f10" ||
+		info.VirtualSize != 8192-4*512 || !info.Encrypted {
+		t.Fatalf("Unexpected info %+v", info)
+	}
+}
+
+func TestInspectLUKS2(t *testing.T) {
+	data := make([]byte, 65536)
+	copy(data, luksMagic)
+	binary.BigEndian.PutUint16(data[luksVersionOffset:], 2)
+	binary.BigEndian.PutUint64(data[luks2HeaderSizeOffset:], 16384)
+	copy(data[luks2HeaderLength:], `{"segments":{"0":{"type":"crypt",`+
+		`"offset":"32768","size":"dynamic","encryption":"aes-xts-plain64"}}}`)
+
+	info := inspectBytes(t, data)
+	if info.Format != FORMAT_LUKS || info.Version != 2 ||
+		info.Cipher != "aes-xts-plain64" || info.VirtualSize != 32768 {
+		t.Fatalf("Unexpected info %+v", info)
+	}
+}
+
+func TestInspectLUKS2InvalidHeaderSize(t *testing.T) {
+	data := make([]byte, luks2HeaderLength)
+	copy(data, luksMagic)
+	binary.BigEndian.PutUint16(data[luksVersionOffset:], 2)
+
+	for _, headerSize := range []uint64{1 << 62, 8 << 20, 16384 + 512, luks2HeaderLength} {
+		binary.BigEndian.PutUint64(data[luks2HeaderSizeOffset:], headerSize)
+		// Neither the unknown nor a huge size is trusted
+		for _, size := range []int64{-1, 1 << 62} {
+			if _, err := Inspect(bytes.NewReader(data), size); err == nil {
+				t.Errorf("Expected an error for header size %d in image of size %d", headerSize, size)
+			}
+		}
+	}
+}
+
+func TestInspectRaw(t *testing.T) {
+	data := make([]byte, 1<<20)
+	info := inspectBytes(t, data)
+	if info.Format != FORMAT_RAW || info.VirtualSize != 1<<20 {
+		t.Fatalf("Unexpected info %+v", info)
+	}
+
+	copy(data[isoMagicOffset:], isoMagic)
+	if info := inspectBytes(t, data); info.Format != FORMAT_ISO {
+		t.Fatalf("Unexpected format %s", info.Format)
+	}
+}
+
+func TestMatchesFormat(t *testing.T) {
+	cases := []struct {
+		detected Format
+		declared string
+		matches  bool
+	}{
+		{FORMAT_QCOW2, "qcow2", true},
+		{FORMAT_QCOW2, "raw", false},
+		{FORMAT_RAW, "qcow2", false},
+		{FORMAT_RAW, "", true},
+		{FORMAT_RAW, "none", true},
+		{FORMAT_RAW, "iso", true},
+		{FORMAT_ISO, "raw", true},
+		{FORMAT_LUKS, "raw", false},
+		{FORMAT_LUKS, "luks", true},
+	}
+	for _, c := range cases {
+		info := &Info{Format: c.detected}
+		if info.MatchesFormat(c.declared) != c.matches {
+			t.Errorf("MatchesFormat(%q) on %s != %v", c.declared, c.detected, c.matches)
+		}
+	}
+}
+
+func TestInspectFileChain(t *testing.T) {
+	dir, err := ioutil.TempDir("", "imageinfo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	write := func(name string, data []byte) {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), data, 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("base.img", make([]byte, 4096))
+	write("middle.qcow2", (&testQCow2{
+		version: 3, size: 4096, clusterBits: 16,
+		backingFile: "base.img", backingFormat: "raw",
+	}).build())
+	write("top.qcow2", (&testQCow2{
+		version: 3, size: 4096, clusterBits: 16,
+		backingFile: filepath.Join(dir, "middle.qcow2"), backingFormat: "qcow2",
+	}).build())
+
+	info, err := InspectFileChain(filepath.Join(dir, "top.qcow2"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var formats []Format
+	for ; info != nil; info = info.Backing {
+		formats = append(formats, info.Format)
+	}
+	if len(formats) != 3 || formats[0] != FORMAT_QCOW2 ||
+		formats[1] != FORMAT_QCOW2 || formats[2] != FORMAT_RAW {
+		t.Fatalf("Unexpected chain %v", formats)
+	}
+
+	write("loop.qcow2", (&testQCow2{
+		version: 3, size: 4096, clusterBits: 16, backingFile: "loop.qcow2",
+	}).build())
+	if _, err := InspectFileChain(filepath.Join(dir, "loop.qcow2")); err == nil {
+		t.Fatal("Expected an error for a looping chain")
+	}
+}
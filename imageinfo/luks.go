@@ -0,0 +1,155 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package imageinfo
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+const luksMagic = "LUKS\xba\xbe"
+
+// Offsets of the fields of the LUKS header
+const (
+	luksVersionOffset = 6
+	luksUUIDOffset    = 168
+	luksUUIDLength    = 40
+
+	luks1CipherNameOffset    = 8
+	luks1CipherModeOffset    = 40
+	luks1CipherLength        = 32
+	luks1PayloadOffsetOffset = 104
+	luks1HeaderLength        = 208
+
+	luks2HeaderSizeOffset = 8
+	luks2HeaderLength     = 4096
+	// The header, with its JSON area, is a multiple of the binary
+	// header length up to this
+	luks2MaxHeaderSize = 4 * 1024 * 1024
+)
+
+const luksSectorSize = 512
+
+// The part of the LUKS2 JSON metadata describing the encrypted data
+type luks2Metadata struct {
+	Segments map[string]luks2Segment `json:"segments"`
+}
+
+type luks2Segment struct {
+	Type       string `json:"type"`
+	Offset     string `json:"offset"`
+	Size       string `json:"size"`
+	Encryption string `json:"encryption"`
+}
+
+// Returns a NUL terminated string from a header field
+func cString(data []byte) string {
+	if i := bytes.IndexByte(data, 0); i >= 0 {
+		data = data[:i]
+	}
+	return string(data)
+}
+
+func inspectLUKS(h *header, info *Info) error {
+	data, err := h.bytes(0, luks1HeaderLength)
+	if err != nil {
+		return err
+	}
+	info.Format = FORMAT_LUKS
+	info.Version = int(binary.BigEndian.Uint16(data[luksVersionOffset:]))
+	info.Encrypted = true
+	info.EncryptionFormat = "luks"
+	info.UUID = cString(data[luksUUIDOffset : luksUUIDOffset+luksUUIDLength])
+
+	switch info.Version {
+	case 1:
+		return inspectLUKS1(h, info, data)
+	case 2:
+		return inspectLUKS2(h, info, data)
+	}
+	return fmt.Errorf("unknown LUKS version %d", info.Version)
+}
+
+func inspectLUKS1(h *header, info *Info, data []byte) error {
+	name := cString(data[luks1CipherNameOffset : luks1CipherNameOffset+luks1CipherLength])
+	mode := cString(data[luks1CipherModeOffset : luks1CipherModeOffset+luks1CipherLength])
+	info.Cipher = name + "-" + mode
+
+	payload := uint64(binary.BigEndian.Uint32(data[luks1PayloadOffsetOffset:])) * luksSectorSize
+	if h.size >= 0 && uint64(h.size) >= payload {
+		info.VirtualSize = uint64(h.size) - payload
+	}
+	return nil
+}
+
+func inspectLUKS2(h *header, info *Info, data []byte) error {
+	headerSize := binary.BigEndian.Uint64(data[luks2HeaderSizeOffset:])
+	if headerSize <= luks2HeaderLength || headerSize > luks2MaxHeaderSize ||
+		headerSize%luks2HeaderLength != 0 {
+		return fmt.Errorf("invalid LUKS2 header size %d", headerSize)
+	}
+	area, err := h.bytes(luks2HeaderLength, headerSize-luks2HeaderLength)
+	if err != nil {
+		return err
+	}
+	var metadata luks2Metadata
+	if err := json.Unmarshal([]byte(cString(area)), &metadata); err != nil {
+		return fmt.Errorf("invalid LUKS2 metadata: %v", err)
+	}
+
+	// The data is described by the first crypt segment
+	var ids []int
+	for id := range metadata.Segments {
+		if n, err := strconv.Atoi(id); err == nil {
+			ids = append(ids, n)
+		}
+	}
+	sort.Ints(ids)
+	for _, id := range ids {
+		segment := metadata.Segments[strconv.Itoa(id)]
+		if segment.Type != "crypt" {
+			continue
+		}
+		info.Cipher = segment.Encryption
+		offset, err := strconv.ParseUint(segment.Offset, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid LUKS2 segment offset %q", segment.Offset)
+		}
+		if segment.Size != "dynamic" {
+			if info.VirtualSize, err = strconv.ParseUint(segment.Size, 10, 64); err != nil {
+				return fmt.Errorf("invalid LUKS2 segment size %q", segment.Size)
+			}
+		} else if h.size >= 0 && uint64(h.size) >= offset {
+			info.VirtualSize = uint64(h.size) - offset
+		}
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package imageinfo
+
+import (
+	"encoding/binary"
+)
+
+// The formats below are only recognised, along with their size where
+// it is easily found, so that they are not reported as raw
+
+const (
+	qedMagic              = "QED\x00"
+	qedClusterSizeOffset  = 4
+	qedImageSizeOffset    = 48
+	qedBackingOffsetField = 56
+	qedBackingSizeField   = 60
+	qedHeaderLength       = 64
+)
+
+func inspectQED(h *header, info *Info) error {
+	data, err := h.bytes(0, qedHeaderLength)
+	if err != nil {
+		return err
+	}
+	info.Format = FORMAT_QED
+	info.ClusterSize = uint64(binary.LittleEndian.Uint32(data[qedClusterSizeOffset:]))
+	info.VirtualSize = binary.LittleEndian.Uint64(data[qedImageSizeOffset:])
+	return readBackingFile(h, info,
+		uint64(binary.LittleEndian.Uint32(data[qedBackingOffsetField:])),
+		binary.LittleEndian.Uint32(data[qedBackingSizeField:]))
+}
+
+const (
+	vmdkMagic           = "KDMV"
+	vmdkDescriptorMagic = "# Disk DescriptorFile"
+	vmdkCapacityOffset  = 12
+	vmdkHeaderLength    = 20
+	vmdkSectorSize      = 512
+)
+
+func inspectVMDK(h *header, info *Info) error {
+	info.Format = FORMAT_VMDK
+	if !h.has(0, vmdkMagic) {
+		// A descriptor only names the extents holding the data
+		return nil
+	}
+	data, err := h.bytes(0, vmdkHeaderLength)
+	if err != nil {
+		return err
+	}
+	info.VirtualSize = binary.LittleEndian.Uint64(data[vmdkCapacityOffset:]) * vmdkSectorSize
+	return nil
+}
+
+const (
+	vdiMagic          = "\x7f\x10\xda\xbe"
+	vdiMagicOffset    = 64
+	vdiDiskSizeOffset = 368
+	vdiHeaderLength   = 376
+)
+
+func inspectVDI(h *header, info *Info) error {
+	data, err := h.bytes(0, vdiHeaderLength)
+	if err != nil {
+		return err
+	}
+	info.Format = FORMAT_VDI
+	info.VirtualSize = binary.LittleEndian.Uint64(data[vdiDiskSizeOffset:])
+	return nil
+}
+
+const (
+	vpcMagic             = "conectix"
+	vpcCurrentSizeOffset = 48
+	vpcHeaderLength      = 56
+)
+
+func inspectVPC(h *header, info *Info) error {
+	data, err := h.bytes(0, vpcHeaderLength)
+	if err != nil {
+		return err
+	}
+	info.Format = FORMAT_VPC
+	info.VirtualSize = binary.BigEndian.Uint64(data[vpcCurrentSizeOffset:])
+	return nil
+}
+
+const vhdxMagic = "vhdxfile"
+
+const (
+	isoMagic       = "CD001"
+	isoMagicOffset = 0x8001
+)
@@ -0,0 +1,229 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package imageinfo
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+const qcowMagic = "QFI\xfb"
+
+// Offsets of the fields of the qcow2 header
+const (
+	qcow2VersionOffset       = 4
+	qcow2BackingOffsetOffset = 8
+	qcow2BackingSizeOffset   = 16
+	qcow2ClusterBitsOffset   = 20
+	qcow2SizeOffset          = 24
+	qcow2CryptMethodOffset   = 32
+	qcow2IncompatibleOffset  = 72
+	qcow2HeaderLengthOffset  = 100
+
+	qcow2V2HeaderLength = 72
+	qcow2V3HeaderLength = 104
+)
+
+// The original qcow header lays out the fields differently
+const (
+	qcow1BackingOffsetOffset = 8
+	qcow1BackingSizeOffset   = 16
+	qcow1SizeOffset          = 24
+	qcow1ClusterBitsOffset   = 32
+	qcow1CryptMethodOffset   = 36
+	qcow1HeaderLength        = 48
+)
+
+// Types of qcow2 header extension
+const (
+	qcow2ExtEnd           = 0
+	qcow2ExtBackingFormat = 0xe2792aca
+	qcow2ExtDataFile      = 0x44415441
+)
+
+// Bits of the qcow2 incompatible features
+const (
+	qcow2IncompatDirty   = 1 << 0
+	qcow2IncompatCorrupt = 1 << 1
+)
+
+// Limits applied by qemu to the names in the header
+const (
+	qcowMaxBackingSize = 1023
+	qcowMaxClusterBits = 21
+	qcowMinClusterBits = 9
+)
+
+func qcowEncryption(method uint32) (string, error) {
+	switch method {
+	case 0:
+		return "", nil
+	case 1:
+		return "aes", nil
+	case 2:
+		return "luks", nil
+	}
+	return "", fmt.Errorf("unknown qcow encryption method %d", method)
+}
+
+func inspectQCow(h *header, info *Info) error {
+	data, err := h.bytes(0, 8)
+	if err != nil {
+		return err
+	}
+	version := binary.BigEndian.Uint32(data[qcow2VersionOffset:])
+	switch version {
+	case 1:
+		return inspectQCow1(h, info)
+	case 2, 3:
+		return inspectQCow2(h, info, int(version))
+	}
+	return fmt.Errorf("unknown qcow version %d", version)
+}
+
+func readBackingFile(h *header, info *Info, offset uint64, size uint32) error {
+	if offset == 0 {
+		return nil
+	}
+	if size > qcowMaxBackingSize {
+		return fmt.Errorf("backing file name is %d bytes long", size)
+	}
+	name, err := h.bytes(offset, uint64(size))
+	if err != nil {
+		return err
+	}
+	info.BackingFile = string(name)
+	return nil
+}
+
+func inspectQCow1(h *header, info *Info) error {
+	data, err := h.bytes(0, qcow1HeaderLength)
+	if err != nil {
+		return err
+	}
+	info.Format = FORMAT_QCOW
+	info.Version = 1
+	info.VirtualSize = binary.BigEndian.Uint64(data[qcow1SizeOffset:])
+
+	clusterBits := uint32(data[qcow1ClusterBitsOffset])
+	if clusterBits < qcowMinClusterBits || clusterBits > qcowMaxClusterBits {
+		return fmt.Errorf("invalid qcow cluster size 2^%d", clusterBits)
+	}
+	info.ClusterSize = 1 << clusterBits
+
+	method := binary.BigEndian.Uint32(data[qcow1CryptMethodOffset:])
+	if info.EncryptionFormat, err = qcowEncryption(method); err != nil {
+		return err
+	}
+	info.Encrypted = info.EncryptionFormat != ""
+
+	return readBackingFile(h, info,
+		binary.BigEndian.Uint64(data[qcow1BackingOffsetOffset:]),
+		binary.BigEndian.Uint32(data[qcow1BackingSizeOffset:]))
+}
+
+func inspectQCow2(h *header, info *Info, version int) error {
+	data, err := h.bytes(0, qcow2V2HeaderLength)
+	if err != nil {
+		return err
+	}
+	info.Format = FORMAT_QCOW2
+	info.Version = version
+	info.VirtualSize = binary.BigEndian.Uint64(data[qcow2SizeOffset:])
+
+	clusterBits := binary.BigEndian.Uint32(data[qcow2ClusterBitsOffset:])
+	if clusterBits < qcowMinClusterBits || clusterBits > qcowMaxClusterBits {
+		return fmt.Errorf("invalid qcow2 cluster size 2^%d", clusterBits)
+	}
+	info.ClusterSize = 1 << clusterBits
+
+	method := binary.BigEndian.Uint32(data[qcow2CryptMethodOffset:])
+	if info.EncryptionFormat, err = qcowEncryption(method); err != nil {
+		return err
+	}
+	info.Encrypted = info.EncryptionFormat != ""
+
+	headerLength := uint64(qcow2V2HeaderLength)
+	if version >= 3 {
+		if data, err = h.bytes(0, qcow2V3HeaderLength); err != nil {
+			return err
+		}
+		incompatible := binary.BigEndian.Uint64(data[qcow2IncompatibleOffset:])
+		info.Dirty = incompatible&qcow2IncompatDirty != 0
+		info.Corrupt = incompatible&qcow2IncompatCorrupt != 0
+		headerLength = uint64(binary.BigEndian.Uint32(data[qcow2HeaderLengthOffset:]))
+		if headerLength < qcow2V3HeaderLength {
+			return fmt.Errorf("invalid qcow2 header length %d", headerLength)
+		}
+	}
+
+	backingOffset := binary.BigEndian.Uint64(data[qcow2BackingOffsetOffset:])
+	if err := readBackingFile(h, info, backingOffset,
+		binary.BigEndian.Uint32(data[qcow2BackingSizeOffset:])); err != nil {
+		return err
+	}
+
+	// The extensions follow the header, and end before the backing
+	// file name or the end of the first cluster
+	end := info.ClusterSize
+	if backingOffset != 0 && backingOffset < end {
+		end = backingOffset
+	}
+	return inspectQCow2Extensions(h, info, headerLength, end)
+}
+
+func inspectQCow2Extensions(h *header, info *Info, offset, end uint64) error {
+	for offset+8 <= end {
+		ext, err := h.bytes(offset, 8)
+		if err != nil {
+			return err
+		}
+		extType := binary.BigEndian.Uint32(ext)
+		extLength := uint64(binary.BigEndian.Uint32(ext[4:]))
+		offset += 8
+		if extType == qcow2ExtEnd {
+			return nil
+		}
+		if offset+extLength > end {
+			return fmt.Errorf("qcow2 header extension %#x overflows the header", extType)
+		}
+
+		switch extType {
+		case qcow2ExtBackingFormat, qcow2ExtDataFile:
+			value, err := h.bytes(offset, extLength)
+			if err != nil {
+				return err
+			}
+			if extType == qcow2ExtBackingFormat {
+				info.BackingFormat = Format(value)
+			} else {
+				info.DataFile = string(value)
+			}
+		}
+		offset += (extLength + 7) &^ 7
+	}
+	return nil
+}
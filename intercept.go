@@ -0,0 +1,167 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Every exported method of the object types is generated by callgen.go,
+// and passes through runCallHooks when callHooksInstalled reports true.
+// The hooks are the interceptors set with SetInterceptors, followed by
+// the logger set with SetLogger.
+
+//go:generate go run callgen.go
+
+type CallArg struct {
+	Name  string
+	Value interface{}
+}
+
+// Call describes a method call on one of the object types, such as
+// Connect, Domain, Network, StoragePool or StorageVol.
+type Call struct {
+	// Name of the type and method, eg "Domain.Create"
+	Method string
+	// The object the method was called on, eg a *Domain
+	Receiver interface{}
+	// The arguments of the call, in the order of the method signature
+	Args []CallArg
+}
+
+// Arg returns the value of the named argument, or nil if the
+// method has no such argument.
+func (c *Call) Arg(name string) interface{} {
+	for _, arg := range c.Args {
+		if arg.Name == name {
+			return arg.Value
+		}
+	}
+	return nil
+}
+
+// Object returns identifying details of the receiver, such as its
+// "name" and "uuid". For storage volumes the "key" is given instead
+// of the UUID, and for network filter bindings the "portdev" and
+// "filter". Nothing is returned for Connect and Stream.
+//
+// The details are fetched without passing through the interceptors.
+// Since a call may free the receiver, this must be used before the
+// call is invoked.
+func (c *Call) Object() map[string]string {
+	object := make(map[string]string)
+	for _, attr := range callObjectAttrs(c.Receiver) {
+		object[attr.key] = attr.value.(string)
+	}
+	return object
+}
+
+// Interceptor is run in place of each method call. It must call
+// invoke to carry out the call, and return the error invoke returned,
+// or any other error to be returned by the method. It may also
+// return an error without calling invoke, in which case the method
+// returns the zero value for its other results. Returning nil without
+// calling invoke is a bug, which the method reports as an
+// ERR_INTERNAL_ERROR.
+//
+// Methods called from within an interceptor pass through the
+// interceptors again, so care is needed to avoid infinite recursion.
+type Interceptor func(call *Call, invoke func() error) error
+
+var callHooks int32
+
+var interceptors = struct {
+	lock  sync.RWMutex
+	chain []Interceptor
+}{}
+
+// SetInterceptors replaces the chain of interceptors run for each
+// method call. The first interceptor is the outermost, and each calls
+// the next when it invokes the call. Passing no interceptors removes
+// the chain, after which method calls carry no extra overhead.
+func SetInterceptors(chain ...Interceptor) {
+	interceptors.lock.Lock()
+	interceptors.chain = append([]Interceptor(nil), chain...)
+	interceptors.lock.Unlock()
+
+	updateCallHooks()
+}
+
+func getInterceptors() []Interceptor {
+	interceptors.lock.RLock()
+	defer interceptors.lock.RUnlock()
+	return interceptors.chain
+}
+
+func updateCallHooks() {
+	if len(getInterceptors()) != 0 || getLogSink() != nil {
+		atomic.StoreInt32(&callHooks, 1)
+	} else {
+		atomic.StoreInt32(&callHooks, 0)
+	}
+}
+
+func callHooksInstalled() bool {
+	return atomic.LoadInt32(&callHooks) != 0
+}
+
+func runCallHooks(call *Call, fn func() error) error {
+	// Whether the call itself was made, since the results are left
+	// unset if an interceptor skipped it
+	invoked := false
+	made := func() error {
+		invoked = true
+		return fn()
+	}
+	invoke := made
+
+	if sink := getLogSink(); sink != nil {
+		invoke = func() error {
+			return logCall(sink, call, made)
+		}
+	}
+
+	chain := getInterceptors()
+	for i := len(chain) - 1; i >= 0; i-- {
+		interceptor := chain[i]
+		next := invoke
+		invoke = func() error {
+			return interceptor(call, next)
+		}
+	}
+
+	err := invoke()
+	if err == nil && !invoked {
+		return Error{
+			Code:    ERR_INTERNAL_ERROR,
+			Domain:  FROM_NONE,
+			Message: "Interceptor for " + call.Method + " returned without invoking the call",
+			Level:   ERR_ERROR,
+		}
+	}
+	return err
+}
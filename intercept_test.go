@@ -0,0 +1,158 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestInterceptorChain(t *testing.T) {
+	var order []string
+	var calls []*Call
+	var objects []map[string]string
+
+	SetInterceptors(
+		func(call *Call, invoke func() error) error {
+			order = append(order, "outer")
+			calls = append(calls, call)
+			objects = append(objects, call.Object())
+			return invoke()
+		},
+		func(call *Call, invoke func() error) error {
+			order = append(order, "inner")
+			return invoke()
+		})
+	defer SetInterceptors()
+
+	if !callHooksInstalled() {
+		t.Fatal("Expected call hooks to be installed")
+	}
+
+	conn := buildTestConnection()
+	defer conn.Close()
+
+	dom, err := conn.LookupDomainByName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dom.Free()
+
+	xml, err := dom.GetXMLDesc(DOMAIN_XML_INACTIVE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml == "" {
+		t.Fatal("Expected XML from intercepted call")
+	}
+
+	if len(calls) != 2 {
+		t.Fatalf("Expected 2 intercepted calls, got %d", len(calls))
+	}
+	if len(order) != 4 || order[0] != "outer" || order[1] != "inner" {
+		t.Fatalf("Unexpected interceptor order %v", order)
+	}
+
+	if calls[0].Method != "Connect.LookupDomainByName" {
+		t.Errorf("Unexpected method %s", calls[0].Method)
+	}
+	if calls[0].Receiver != conn {
+		t.Errorf("Unexpected receiver %v", calls[0].Receiver)
+	}
+	if name, ok := calls[0].Arg("id").(string); !ok || name != "test" {
+		t.Errorf("Unexpected args %v", calls[0].Args)
+	}
+
+	if calls[1].Method != "Domain.GetXMLDesc" {
+		t.Errorf("Unexpected method %s", calls[1].Method)
+	}
+	if flags, ok := calls[1].Arg("flags").(DomainXMLFlags); !ok || flags != DOMAIN_XML_INACTIVE {
+		t.Errorf("Unexpected args %v", calls[1].Args)
+	}
+	if objects[1]["name"] != "test" {
+		t.Errorf("Unexpected object %v", objects[1])
+	}
+
+	SetInterceptors()
+	if callHooksInstalled() {
+		t.Fatal("Expected call hooks to be removed")
+	}
+}
+
+func TestInterceptorReject(t *testing.T) {
+	SetInterceptors(func(call *Call, invoke func() error) error {
+		if call.Method == "Domain.Suspend" {
+			return fmt.Errorf("Suspend is not permitted")
+		}
+		return invoke()
+	})
+	defer SetInterceptors()
+
+	conn := buildTestConnection()
+	defer conn.Close()
+
+	dom, err := conn.LookupDomainByName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dom.Free()
+
+	if err := dom.Suspend(); err == nil {
+		t.Fatal("Expected Suspend to be rejected")
+	}
+
+	state, _, err := dom.GetState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != DOMAIN_RUNNING {
+		t.Fatalf("Expected domain to still be running, got %d", state)
+	}
+}
+
+func TestInterceptorSkip(t *testing.T) {
+	SetInterceptors(func(call *Call, invoke func() error) error {
+		if call.Method == "Domain.GetName" {
+			return nil
+		}
+		return invoke()
+	})
+	defer SetInterceptors()
+
+	conn := buildTestConnection()
+	defer conn.Close()
+
+	dom, err := conn.LookupDomainByName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dom.Free()
+
+	name, err := dom.GetName()
+	if virErr, ok := err.(Error); !ok || virErr.Code != ERR_INTERNAL_ERROR {
+		t.Fatalf("Expected an internal error from a skipped call, got %q, %v", name, err)
+	}
+}
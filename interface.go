@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "interface_wrapper.h"
 */
@@ -48,7 +48,7 @@ type Interface struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceCreate
-func (n *Interface) Create(flags uint32) error {
+func (n *Interface) doCreate(flags uint32) error {
 	var err C.virError
 	result := C.virInterfaceCreateWrapper(n.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -58,7 +58,7 @@ func (n *Interface) Create(flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceDestroy
-func (n *Interface) Destroy(flags uint32) error {
+func (n *Interface) doDestroy(flags uint32) error {
 	var err C.virError
 	result := C.virInterfaceDestroyWrapper(n.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -68,7 +68,7 @@ func (n *Interface) Destroy(flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceIsActive
-func (n *Interface) IsActive() (bool, error) {
+func (n *Interface) doIsActive() (bool, error) {
 	var err C.virError
 	result := C.virInterfaceIsActiveWrapper(n.ptr, &err)
 	if result == -1 {
@@ -81,7 +81,7 @@ func (n *Interface) IsActive() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceGetMACString
-func (n *Interface) GetMACString() (string, error) {
+func (n *Interface) doGetMACString() (string, error) {
 	var err C.virError
 	result := C.virInterfaceGetMACStringWrapper(n.ptr, &err)
 	if result == nil {
@@ -92,7 +92,7 @@ func (n *Interface) GetMACString() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceGetName
-func (n *Interface) GetName() (string, error) {
+func (n *Interface) doGetName() (string, error) {
 	var err C.virError
 	result := C.virInterfaceGetNameWrapper(n.ptr, &err)
 	if result == nil {
@@ -103,7 +103,7 @@ func (n *Interface) GetName() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceGetXMLDesc
-func (n *Interface) GetXMLDesc(flags InterfaceXMLFlags) (string, error) {
+func (n *Interface) doGetXMLDesc(flags InterfaceXMLFlags) (string, error) {
 	var err C.virError
 	result := C.virInterfaceGetXMLDescWrapper(n.ptr, C.uint(flags), &err)
 	if result == nil {
@@ -115,7 +115,7 @@ func (n *Interface) GetXMLDesc(flags InterfaceXMLFlags) (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceUndefine
-func (n *Interface) Undefine() error {
+func (n *Interface) doUndefine() error {
 	var err C.virError
 	result := C.virInterfaceUndefineWrapper(n.ptr, &err)
 	if result == -1 {
@@ -125,7 +125,10 @@ func (n *Interface) Undefine() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceFree
-func (n *Interface) Free() error {
+func (n *Interface) doFree() error {
+	if err := trackHandleFree("Interface", unsafe.Pointer(n.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virInterfaceFreeWrapper(n.ptr, &err)
 	if ret == -1 {
@@ -135,11 +138,12 @@ func (n *Interface) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-interface.html#virInterfaceRef
-func (c *Interface) Ref() error {
+func (c *Interface) doRef() error {
 	var err C.virError
 	ret := C.virInterfaceRefWrapper(c.ptr, &err)
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("Interface", unsafe.Pointer(c.ptr))
 	return nil
 }
@@ -26,7 +26,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <assert.h>
 #include "interface_wrapper.h"
 
@@ -28,6 +28,7 @@
 
 #include <libvirt/libvirt.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 
 int
 virInterfaceCreateWrapper(virInterfacePtr iface,
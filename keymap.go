@@ -0,0 +1,227 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"fmt"
+)
+
+// No keycode for the key in a set
+const keycodeNone = -1
+
+// One key, with its keycode in each set. The xt, qnum and rfb codes
+// are derived from the AT set 1 code, in which extended keys have an
+// 0xe0 prefix, held here in the high byte.
+type keymapEntry struct {
+	linux  int
+	atset1 int
+	xtKbd  int
+	usb    int
+	win32  int
+	osx    int
+}
+
+// The keys of a 105 key PC keyboard, named by their linux keycode
+var keymap = []keymapEntry{
+	{1, 0x01, 0x01, 0x29, 0x1b, 0x35},     // ESC
+	{2, 0x02, 0x02, 0x1e, 0x31, 0x12},     // 1
+	{3, 0x03, 0x03, 0x1f, 0x32, 0x13},     // 2
+	{4, 0x04, 0x04, 0x20, 0x33, 0x14},     // 3
+	{5, 0x05, 0x05, 0x21, 0x34, 0x15},     // 4
+	{6, 0x06, 0x06, 0x22, 0x35, 0x17},     // 5
+	{7, 0x07, 0x07, 0x23, 0x36, 0x16},     // 6
+	{8, 0x08, 0x08, 0x24, 0x37, 0x1a},     // 7
+	{9, 0x09, 0x09, 0x25, 0x38, 0x1c},     // 8
+	{10, 0x0a, 0x0a, 0x26, 0x39, 0x19},    // 9
+	{11, 0x0b, 0x0b, 0x27, 0x30, 0x1d},    // 0
+	{12, 0x0c, 0x0c, 0x2d, 0xbd, 0x1b},    // MINUS
+	{13, 0x0d, 0x0d, 0x2e, 0xbb, 0x18},    // EQUAL
+	{14, 0x0e, 0x0e, 0x2a, 0x08, 0x33},    // BACKSPACE
+	{15, 0x0f, 0x0f, 0x2b, 0x09, 0x30},    // TAB
+	{16, 0x10, 0x10, 0x14, 0x51, 0x0c},    // Q
+	{17, 0x11, 0x11, 0x1a, 0x57, 0x0d},    // W
+	{18, 0x12, 0x12, 0x08, 0x45, 0x0e},    // E
+	{19, 0x13, 0x13, 0x15, 0x52, 0x0f},    // R
+	{20, 0x14, 0x14, 0x17, 0x54, 0x11},    // T
+	{21, 0x15, 0x15, 0x1c, 0x59, 0x10},    // Y
+	{22, 0x16, 0x16, 0x18, 0x55, 0x20},    // U
+	{23, 0x17, 0x17, 0x0c, 0x49, 0x22},    // I
+	{24, 0x18, 0x18, 0x12, 0x4f, 0x1f},    // O
+	{25, 0x19, 0x19, 0x13, 0x50, 0x23},    // P
+	{26, 0x1a, 0x1a, 0x2f, 0xdb, 0x21},    // LEFTBRACE
+	{27, 0x1b, 0x1b, 0x30, 0xdd, 0x1e},    // RIGHTBRACE
+	{28, 0x1c, 0x1c, 0x28, 0x0d, 0x24},    // ENTER
+	{29, 0x1d, 0x1d, 0xe0, 0xa2, 0x3b},    // LEFTCTRL
+	{30, 0x1e, 0x1e, 0x04, 0x41, 0x00},    // A
+	{31, 0x1f, 0x1f, 0x16, 0x53, 0x01},    // S
+	{32, 0x20, 0x20, 0x07, 0x44, 0x02},    // D
+	{33, 0x21, 0x21, 0x09, 0x46, 0x03},    // F
+	{34, 0x22, 0x22, 0x0a, 0x47, 0x05},    // G
+	{35, 0x23, 0x23, 0x0b, 0x48, 0x04},    // H
+	{36, 0x24, 0x24, 0x0d, 0x4a, 0x26},    // J
+	{37, 0x25, 0x25, 0x0e, 0x4b, 0x28},    // K
+	{38, 0x26, 0x26, 0x0f, 0x4c, 0x25},    // L
+	{39, 0x27, 0x27, 0x33, 0xba, 0x29},    // SEMICOLON
+	{40, 0x28, 0x28, 0x34, 0xde, 0x27},    // APOSTROPHE
+	{41, 0x29, 0x29, 0x35, 0xc0, 0x32},    // GRAVE
+	{42, 0x2a, 0x2a, 0xe1, 0xa0, 0x38},    // LEFTSHIFT
+	{43, 0x2b, 0x2b, 0x31, 0xdc, 0x2a},    // BACKSLASH
+	{44, 0x2c, 0x2c, 0x1d, 0x5a, 0x06},    // Z
+	{45, 0x2d, 0x2d, 0x1b, 0x58, 0x07},    // X
+	{46, 0x2e, 0x2e, 0x06, 0x43, 0x08},    // C
+	{47, 0x2f, 0x2f, 0x19, 0x56, 0x09},    // V
+	{48, 0x30, 0x30, 0x05, 0x42, 0x0b},    // B
+	{49, 0x31, 0x31, 0x11, 0x4e, 0x2d},    // N
+	{50, 0x32, 0x32, 0x10, 0x4d, 0x2e},    // M
+	{51, 0x33, 0x33, 0x36, 0xbc, 0x2b},    // COMMA
+	{52, 0x34, 0x34, 0x37, 0xbe, 0x2f},    // DOT
+	{53, 0x35, 0x35, 0x38, 0xbf, 0x2c},    // SLASH
+	{54, 0x36, 0x36, 0xe5, 0xa1, 0x3c},    // RIGHTSHIFT
+	{55, 0x37, 0x37, 0x55, 0x6a, 0x43},    // KPASTERISK
+	{56, 0x38, 0x38, 0xe2, 0xa4, 0x3a},    // LEFTALT
+	{57, 0x39, 0x39, 0x2c, 0x20, 0x31},    // SPACE
+	{58, 0x3a, 0x3a, 0x39, 0x14, 0x39},    // CAPSLOCK
+	{59, 0x3b, 0x3b, 0x3a, 0x70, 0x7a},    // F1
+	{60, 0x3c, 0x3c, 0x3b, 0x71, 0x78},    // F2
+	{61, 0x3d, 0x3d, 0x3c, 0x72, 0x63},    // F3
+	{62, 0x3e, 0x3e, 0x3d, 0x73, 0x76},    // F4
+	{63, 0x3f, 0x3f, 0x3e, 0x74, 0x60},    // F5
+	{64, 0x40, 0x40, 0x3f, 0x75, 0x61},    // F6
+	{65, 0x41, 0x41, 0x40, 0x76, 0x62},    // F7
+	{66, 0x42, 0x42, 0x41, 0x77, 0x64},    // F8
+	{67, 0x43, 0x43, 0x42, 0x78, 0x65},    // F9
+	{68, 0x44, 0x44, 0x43, 0x79, 0x6d},    // F10
+	{69, 0x45, 0x45, 0x53, 0x90, 0x47},    // NUMLOCK
+	{70, 0x46, 0x46, 0x47, 0x91, -1},      // SCROLLLOCK
+	{71, 0x47, 0x47, 0x5f, 0x67, 0x59},    // KP7
+	{72, 0x48, 0x48, 0x60, 0x68, 0x5b},    // KP8
+	{73, 0x49, 0x49, 0x61, 0x69, 0x5c},    // KP9
+	{74, 0x4a, 0x4a, 0x56, 0x6d, 0x4e},    // KPMINUS
+	{75, 0x4b, 0x4b, 0x5c, 0x64, 0x56},    // KP4
+	{76, 0x4c, 0x4c, 0x5d, 0x65, 0x57},    // KP5
+	{77, 0x4d, 0x4d, 0x5e, 0x66, 0x58},    // KP6
+	{78, 0x4e, 0x4e, 0x57, 0x6b, 0x45},    // KPPLUS
+	{79, 0x4f, 0x4f, 0x59, 0x61, 0x53},    // KP1
+	{80, 0x50, 0x50, 0x5a, 0x62, 0x54},    // KP2
+	{81, 0x51, 0x51, 0x5b, 0x63, 0x55},    // KP3
+	{82, 0x52, 0x52, 0x62, 0x60, 0x52},    // KP0
+	{83, 0x53, 0x53, 0x63, 0x6e, 0x41},    // KPDOT
+	{86, 0x56, 0x56, 0x64, 0xe2, 0x0a},    // 102ND
+	{87, 0x57, 0x57, 0x44, 0x7a, 0x67},    // F11
+	{88, 0x58, 0x58, 0x45, 0x7b, 0x6f},    // F12
+	{96, 0xe01c, 0x64, 0x58, 0x0d, 0x4c},  // KPENTER
+	{97, 0xe01d, 0x65, 0xe4, 0xa3, 0x3e},  // RIGHTCTRL
+	{98, 0xe035, 0x68, 0x54, 0x6f, 0x4b},  // KPSLASH
+	{99, 0x54, 0x54, 0x46, 0x2c, -1},      // SYSRQ
+	{100, 0xe038, 0x69, 0xe6, 0xa5, 0x3d}, // RIGHTALT
+	{102, 0xe047, 0x59, 0x4a, 0x24, 0x73}, // HOME
+	{103, 0xe048, 0x5a, 0x52, 0x26, 0x7e}, // UP
+	{104, 0xe049, 0x5b, 0x4b, 0x21, 0x74}, // PAGEUP
+	{105, 0xe04b, 0x5c, 0x50, 0x25, 0x7b}, // LEFT
+	{106, 0xe04d, 0x5e, 0x4f, 0x27, 0x7c}, // RIGHT
+	{107, 0xe04f, 0x5f, 0x4d, 0x23, 0x77}, // END
+	{108, 0xe050, 0x60, 0x51, 0x28, 0x7d}, // DOWN
+	{109, 0xe051, 0x61, 0x4e, 0x22, 0x79}, // PAGEDOWN
+	{110, 0xe052, 0x62, 0x49, 0x2d, 0x72}, // INSERT
+	{111, 0xe053, 0x63, 0x4c, 0x2e, 0x75}, // DELETE
+	{119, 0xe046, 0x66, 0x48, 0x13, -1},   // PAUSE
+	{125, 0xe05b, 0x6b, 0xe3, 0x5b, 0x37}, // LEFTMETA
+	{126, 0xe05c, 0x6c, 0xe7, 0x5c, 0x36}, // RIGHTMETA
+	{127, 0xe05d, 0x6d, 0x65, 0x5d, -1},   // COMPOSE
+}
+
+// Returns the keycode of the key in a set
+func (e *keymapEntry) code(set KeycodeSet) int {
+	switch set {
+	case KEYCODE_SET_LINUX:
+		return e.linux
+	case KEYCODE_SET_ATSET1:
+		return e.atset1
+	case KEYCODE_SET_XT:
+		// The XT set has no extended keys
+		if e.atset1 > 0xff {
+			return keycodeNone
+		}
+		return e.atset1
+	case KEYCODE_SET_QNUM, KEYCODE_SET_RFB:
+		// Extended keys have the top bit set, in place of the prefix
+		if e.atset1 > 0xff {
+			return 0x80 | (e.atset1 & 0x7f)
+		}
+		return e.atset1
+	case KEYCODE_SET_XT_KBD:
+		return e.xtKbd
+	case KEYCODE_SET_USB:
+		return e.usb
+	case KEYCODE_SET_WIN32:
+		return e.win32
+	case KEYCODE_SET_OSX:
+		return e.osx
+	}
+	return keycodeNone
+}
+
+func makeKeycodeError(format string, args ...interface{}) Error {
+	return Error{
+		Code:    ERR_INVALID_ARG,
+		Domain:  FROM_DOMAIN,
+		Message: fmt.Sprintf(format, args...),
+		Level:   ERR_ERROR,
+	}
+}
+
+// TranslateKeycode converts a keycode from one keycode set to another.
+// The LINUX, XT, ATSET1, XT_KBD, USB, WIN32, OSX, QNUM and RFB sets are
+// covered, for the keys of a standard 105 key PC keyboard. Where a set
+// gives one keycode to two keys, such as the two Enter keys in WIN32,
+// the keycode translates to the main key.
+func TranslateKeycode(code uint, from, to KeycodeSet) (uint, error) {
+	for i := range keymap {
+		entry := &keymap[i]
+		if value := entry.code(from); value == keycodeNone || uint(value) != code {
+			continue
+		}
+		value := entry.code(to)
+		if value == keycodeNone {
+			return 0, makeKeycodeError("Keycode %#x of set %d has no equivalent in set %d", code, from, to)
+		}
+		return uint(value), nil
+	}
+	return 0, makeKeycodeError("Unknown keycode %#x in set %d", code, from)
+}
+
+// TranslateKeycodes is TranslateKeycode for a list of keycodes
+func TranslateKeycodes(codes []uint, from, to KeycodeSet) ([]uint, error) {
+	ret := make([]uint, len(codes))
+	for i, code := range codes {
+		value, err := TranslateKeycode(code, from, to)
+		if err != nil {
+			return nil, err
+		}
+		ret[i] = value
+	}
+	return ret, nil
+}
@@ -0,0 +1,128 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestTranslateKeycode(t *testing.T) {
+	cases := []struct {
+		from, to KeycodeSet
+		code     uint
+		expect   uint
+	}{
+		{KEYCODE_SET_LINUX, KEYCODE_SET_USB, 30, 0x04},
+		{KEYCODE_SET_LINUX, KEYCODE_SET_QNUM, 103, 0xc8},
+		{KEYCODE_SET_LINUX, KEYCODE_SET_ATSET1, 103, 0xe048},
+		{KEYCODE_SET_QNUM, KEYCODE_SET_RFB, 0x9c, 0x9c},
+		{KEYCODE_SET_USB, KEYCODE_SET_WIN32, 0x28, 0x0d},
+		{KEYCODE_SET_WIN32, KEYCODE_SET_LINUX, 0x0d, 28},
+		{KEYCODE_SET_OSX, KEYCODE_SET_XT_KBD, 0x7e, 0x5a},
+		{KEYCODE_SET_XT, KEYCODE_SET_LINUX, 0x1e, 30},
+	}
+	for _, c := range cases {
+		code, err := TranslateKeycode(c.code, c.from, c.to)
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		if code != c.expect {
+			t.Errorf("Keycode %#x of set %d is %#x in set %d, expected %#x",
+				c.code, c.from, code, c.to, c.expect)
+		}
+	}
+
+	// The XT set has no arrow keys
+	if _, err := TranslateKeycode(103, KEYCODE_SET_LINUX, KEYCODE_SET_XT); err == nil {
+		t.Error("Expected an error translating an extended key to XT")
+	}
+	if _, err := TranslateKeycode(0x200, KEYCODE_SET_LINUX, KEYCODE_SET_USB); err == nil {
+		t.Error("Expected an error translating an unknown keycode")
+	}
+}
+
+func TestKeyboardLayoutKeycodes(t *testing.T) {
+	groups, err := KEYBOARD_LAYOUT_US.Keycodes("Hello\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := [][]uint{{42, 35}, {18, 38}, {38, 24, 28}}
+	if !reflect.DeepEqual(groups, expect) {
+		t.Fatalf("Keycodes %v, expected %v", groups, expect)
+	}
+
+	groups, err = KEYBOARD_LAYOUT_DE.Keycodes("z@")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect = [][]uint{{21}, {100, 16}}
+	if !reflect.DeepEqual(groups, expect) {
+		t.Fatalf("Keycodes %v, expected %v", groups, expect)
+	}
+
+	groups, err = KEYBOARD_LAYOUT_US.Keycodes("abcdefgh")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, group := range groups {
+		if len(group) > sendTextMaxKeys {
+			t.Fatalf("Group %v has more than %d keys", group, sendTextMaxKeys)
+		}
+	}
+
+	if _, err := KEYBOARD_LAYOUT_US.Keycodes("£"); err == nil {
+		t.Fatal("Expected an error for a character missing from the layout")
+	}
+	if _, err := KeyboardLayout("xx").Keycodes("a"); err == nil {
+		t.Fatal("Expected an error for an unknown layout")
+	}
+}
+
+func TestDomainSendText(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Destroy()
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+	if err := dom.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	err := dom.SendText(context.Background(), "root\n", KEYBOARD_LAYOUT_US)
+	if err != nil {
+		// Not every test driver version supports sending keys
+		if virErr, ok := err.(Error); !ok || virErr.Code != ERR_NO_SUPPORT {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,419 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// LeaseEventType is the kind of change to a DHCP lease reported by a
+// LeaseWatcher.
+type LeaseEventType int
+
+const (
+	// A lease was handed out
+	LEASE_EVENT_ADDED = LeaseEventType(iota)
+	// The expiry time of a lease moved later
+	LEASE_EVENT_RENEWED
+	// A lease reached its expiry time without being renewed
+	LEASE_EVENT_EXPIRED
+	// A lease went away before its expiry time, eg because the guest
+	// released it or the network was stopped
+	LEASE_EVENT_REMOVED
+)
+
+func (t LeaseEventType) String() string {
+	switch t {
+	case LEASE_EVENT_ADDED:
+		return "added"
+	case LEASE_EVENT_RENEWED:
+		return "renewed"
+	case LEASE_EVENT_EXPIRED:
+		return "expired"
+	case LEASE_EVENT_REMOVED:
+		return "removed"
+	default:
+		return "unknown"
+	}
+}
+
+// LeaseEvent is a change to a DHCP lease of a network.
+type LeaseEvent struct {
+	Type    LeaseEventType
+	Network string
+	// The lease as last seen, so the old lease for EXPIRED and REMOVED
+	Lease NetworkDHCPLease
+
+	// The domain with an interface using the MAC address of the lease,
+	// if one was found
+	DomainName string
+	DomainUUID string
+}
+
+// LeaseWatcherConfig selects the networks a LeaseWatcher polls, and
+// what it does with the changes.
+type LeaseWatcherConfig struct {
+	// Names of the networks to poll. If empty, every active network is
+	// polled
+	Networks []string
+
+	// How often the leases are read. Defaults to ten seconds
+	Interval time.Duration
+
+	// Called with each change found by a poll
+	Callback func(event *LeaseEvent)
+
+	// Called by Run with the errors of failed polls. If nil, the
+	// errors are ignored
+	ErrorCallback func(err error)
+
+	// A file to keep up to date with the address and host name of
+	// each lease, in the format of /etc/hosts. Leases without a host
+	// name use the name of their domain, and are left out if they
+	// have neither
+	HostsFile string
+}
+
+// LeaseWatcher turns the point in time lists of DHCP leases returned
+// by Network.GetDHCPLeases into a stream of changes, since libvirt has
+// no events for leases. Each poll is compared with the previous one.
+type LeaseWatcher struct {
+	conn   *Connect
+	config LeaseWatcherConfig
+
+	lock   sync.Mutex
+	leases map[string]*LeaseEvent
+	hosts  []byte
+}
+
+// NewLeaseWatcher creates a LeaseWatcher for networks of conn. The
+// leases which exist when it first polls are reported as added. The
+// config may be nil to use the defaults.
+func NewLeaseWatcher(conn *Connect, config *LeaseWatcherConfig) *LeaseWatcher {
+	w := &LeaseWatcher{
+		conn:   conn,
+		leases: make(map[string]*LeaseEvent),
+	}
+	if config != nil {
+		w.config = *config
+	}
+	if w.config.Interval <= 0 {
+		w.config.Interval = 10 * time.Second
+	}
+	return w
+}
+
+// Run polls the leases every Interval, until ctx is done, and returns
+// ctx.Err().
+func (w *LeaseWatcher) Run(ctx context.Context) error {
+	ticker := time.NewTicker(w.config.Interval)
+	defer ticker.Stop()
+	for {
+		if _, err := w.Poll(); err != nil && w.config.ErrorCallback != nil {
+			w.config.ErrorCallback(err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll reads the leases of the networks once, and returns the changes
+// since the previous poll, after passing each of them to the Callback.
+// If the leases of a network cannot be read, its previous leases are
+// kept, so that they are not reported as removed, and the error is
+// returned along with the changes to the other networks. A network
+// which no longer exists has no leases.
+//
+// The Callback is called after the watcher is unlocked, so it may use
+// the watcher.
+func (w *LeaseWatcher) Poll() ([]*LeaseEvent, error) {
+	events, err := w.poll()
+	if w.config.Callback != nil {
+		for _, event := range events {
+			w.config.Callback(event)
+		}
+	}
+	return events, err
+}
+
+func (w *LeaseWatcher) poll() ([]*LeaseEvent, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	names := w.config.Networks
+	if len(names) == 0 {
+		networks, err := w.conn.ListAllNetworks(CONNECT_LIST_NETWORKS_ACTIVE)
+		if err != nil {
+			return nil, err
+		}
+		for _, network := range networks {
+			if name, err := network.GetName(); err == nil {
+				names = append(names, name)
+			}
+			network.Free()
+		}
+	}
+
+	var firstErr error
+	failed := make(map[string]bool)
+	current := make(map[string]*LeaseEvent)
+	for _, name := range names {
+		leases, err := networkLeases(w.conn, name)
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			failed[name] = true
+			continue
+		}
+		for _, lease := range leases {
+			event := &LeaseEvent{Network: name, Lease: lease}
+			current[leaseKey(event)] = event
+		}
+	}
+	for key, event := range w.leases {
+		if failed[event.Network] {
+			current[key] = event
+		}
+	}
+
+	events := diffLeases(w.leases, current, time.Now())
+	if err := w.resolveDomains(events, current); err != nil && firstErr == nil {
+		firstErr = err
+	}
+	w.leases = current
+
+	if w.config.HostsFile != "" {
+		hosts := formatHostsFile(current)
+		if !bytes.Equal(hosts, w.hosts) {
+			if err := writeFileAtomic(w.config.HostsFile, hosts, 0644); err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+			} else {
+				w.hosts = hosts
+			}
+		}
+	}
+
+	return events, firstErr
+}
+
+// Leases returns the leases seen by the last poll, sorted by network
+// and IP address. The Type of each is LEASE_EVENT_ADDED.
+func (w *LeaseWatcher) Leases() []LeaseEvent {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	var leases []LeaseEvent
+	for _, event := range sortedLeases(w.leases) {
+		lease := *event
+		lease.Type = LEASE_EVENT_ADDED
+		leases = append(leases, lease)
+	}
+	return leases
+}
+
+// Fills in the domain of the events, and of the new leases, from the
+// MAC addresses of the interfaces of all domains. The domain of a
+// lease which was already known is kept.
+func (w *LeaseWatcher) resolveDomains(events []*LeaseEvent, current map[string]*LeaseEvent) error {
+	var unresolved []*LeaseEvent
+	for key, event := range current {
+		if old, ok := w.leases[key]; ok && old.DomainUUID != "" {
+			event.DomainName = old.DomainName
+			event.DomainUUID = old.DomainUUID
+		} else {
+			unresolved = append(unresolved, event)
+		}
+	}
+	for _, event := range events {
+		if event.DomainUUID == "" {
+			unresolved = append(unresolved, event)
+		}
+	}
+	if len(unresolved) == 0 {
+		return nil
+	}
+
+	doms, err := w.conn.ListAllDomains(0)
+	if err != nil {
+		return err
+	}
+	owners := make(map[string][2]string)
+	for _, dom := range doms {
+		spec, err := dom.GetSpec(0)
+		if err == nil && spec.Devices != nil {
+			for _, iface := range spec.Devices.Interfaces {
+				if iface.MAC != nil {
+					owners[strings.ToLower(iface.MAC.Address)] = [2]string{spec.Name, spec.UUID}
+				}
+			}
+		}
+		dom.Free()
+	}
+
+	for _, event := range unresolved {
+		if owner, ok := owners[strings.ToLower(event.Lease.Mac)]; ok {
+			event.DomainName = owner[0]
+			event.DomainUUID = owner[1]
+		}
+	}
+	return nil
+}
+
+// Returns the leases of the named network, with none for a network
+// which does not exist
+func networkLeases(conn *Connect, name string) ([]NetworkDHCPLease, error) {
+	network, err := conn.LookupNetworkByName(name)
+	if err != nil {
+		if virErr, ok := err.(Error); ok && virErr.Code == ERR_NO_NETWORK {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer network.Free()
+	return network.GetDHCPLeases()
+}
+
+// Identifies a lease across polls. A lease for the same address given
+// to another client is a different lease.
+func leaseKey(event *LeaseEvent) string {
+	client := event.Lease.Mac
+	if event.Lease.Iaid != "" || event.Lease.Clientid != "" {
+		client = event.Lease.Iaid + "/" + event.Lease.Clientid
+	}
+	return event.Network + " " + event.Lease.IPaddr + " " + strings.ToLower(client)
+}
+
+// Compares two snapshots of leases, keyed by leaseKey. Events for new
+// and renewed leases refer to the entries of current.
+func diffLeases(previous, current map[string]*LeaseEvent, now time.Time) []*LeaseEvent {
+	var events []*LeaseEvent
+	for _, event := range sortedLeases(current) {
+		old, ok := previous[leaseKey(event)]
+		switch {
+		case !ok:
+			event.Type = LEASE_EVENT_ADDED
+		case event.Lease.ExpiryTime.After(old.Lease.ExpiryTime):
+			event.Type = LEASE_EVENT_RENEWED
+		default:
+			continue
+		}
+		events = append(events, event)
+	}
+	for _, old := range sortedLeases(previous) {
+		if _, ok := current[leaseKey(old)]; ok {
+			continue
+		}
+		event := *old
+		event.Type = LEASE_EVENT_REMOVED
+		if expiry := old.Lease.ExpiryTime; expiry.Unix() > 0 && !expiry.After(now) {
+			event.Type = LEASE_EVENT_EXPIRED
+		}
+		events = append(events, &event)
+	}
+	return events
+}
+
+// Sorts leases by network and then IP address
+func sortedLeases(leases map[string]*LeaseEvent) []*LeaseEvent {
+	var sorted []*LeaseEvent
+	for _, event := range leases {
+		sorted = append(sorted, event)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if a.Network != b.Network {
+			return a.Network < b.Network
+		}
+		ipA, ipB := net.ParseIP(a.Lease.IPaddr), net.ParseIP(b.Lease.IPaddr)
+		if c := bytes.Compare(ipA.To16(), ipB.To16()); c != 0 {
+			return c < 0
+		}
+		return leaseKey(a) < leaseKey(b)
+	})
+	return sorted
+}
+
+// Formats the leases as an /etc/hosts file, with one line per address
+func formatHostsFile(leases map[string]*LeaseEvent) []byte {
+	var buf bytes.Buffer
+	buf.WriteString("# DHCP leases of libvirt networks\n")
+	seen := make(map[string]bool)
+	for _, event := range sortedLeases(leases) {
+		name := event.Lease.Hostname
+		if name == "" {
+			name = event.DomainName
+		}
+		if name == "" || seen[event.Lease.IPaddr] {
+			continue
+		}
+		seen[event.Lease.IPaddr] = true
+		fmt.Fprintf(&buf, "%s\t%s\n", event.Lease.IPaddr, name)
+	}
+	return buf.Bytes()
+}
+
+// Replaces the file at path with data, so that readers see either the
+// old or the new contents, never a partial file
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(perm); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
@@ -0,0 +1,203 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testLeases(leases ...*LeaseEvent) map[string]*LeaseEvent {
+	snapshot := make(map[string]*LeaseEvent)
+	for _, lease := range leases {
+		snapshot[leaseKey(lease)] = lease
+	}
+	return snapshot
+}
+
+func TestDiffLeases(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	lease := func(ip, mac string, expiry time.Time) *LeaseEvent {
+		return &LeaseEvent{
+			Network: "default",
+			Lease:   NetworkDHCPLease{IPaddr: ip, Mac: mac, ExpiryTime: expiry},
+		}
+	}
+
+	previous := testLeases(
+		lease("192.168.122.10", "52:54:00:00:00:10", now.Add(time.Hour)),
+		lease("192.168.122.11", "52:54:00:00:00:11", now.Add(time.Hour)),
+		lease("192.168.122.12", "52:54:00:00:00:12", now.Add(-time.Minute)),
+		lease("192.168.122.13", "52:54:00:00:00:13", now.Add(time.Hour)),
+	)
+	current := testLeases(
+		lease("192.168.122.10", "52:54:00:00:00:10", now.Add(time.Hour)),
+		lease("192.168.122.11", "52:54:00:00:00:11", now.Add(2*time.Hour)),
+		lease("192.168.122.9", "52:54:00:00:00:09", now.Add(time.Hour)),
+		lease("192.168.122.13", "52:54:00:00:00:14", now.Add(time.Hour)),
+	)
+
+	events := diffLeases(previous, current, now)
+	expected := []struct {
+		eventType LeaseEventType
+		ip        string
+		mac       string
+	}{
+		{LEASE_EVENT_ADDED, "192.168.122.9", "52:54:00:00:00:09"},
+		{LEASE_EVENT_RENEWED, "192.168.122.11", "52:54:00:00:00:11"},
+		{LEASE_EVENT_ADDED, "192.168.122.13", "52:54:00:00:00:14"},
+		{LEASE_EVENT_EXPIRED, "192.168.122.12", "52:54:00:00:00:12"},
+		{LEASE_EVENT_REMOVED, "192.168.122.13", "52:54:00:00:00:13"},
+	}
+	if len(events) != len(expected) {
+		t.Fatalf("Got %d events, expected %d", len(events), len(expected))
+	}
+	for i, event := range events {
+		if event.Type != expected[i].eventType || event.Lease.IPaddr != expected[i].ip || event.Lease.Mac != expected[i].mac {
+			t.Errorf("Event %d is %s %s %s, expected %s %s %s", i,
+				event.Type, event.Lease.IPaddr, event.Lease.Mac,
+				expected[i].eventType, expected[i].ip, expected[i].mac)
+		}
+	}
+
+	if events := diffLeases(current, current, now); len(events) != 0 {
+		t.Fatalf("Got %d events for unchanged leases", len(events))
+	}
+}
+
+func TestFormatHostsFile(t *testing.T) {
+	leases := testLeases(
+		&LeaseEvent{Network: "default", Lease: NetworkDHCPLease{IPaddr: "192.168.122.20", Mac: "52:54:00:00:00:20", Hostname: "db"}},
+		&LeaseEvent{Network: "default", Lease: NetworkDHCPLease{IPaddr: "192.168.122.3", Mac: "52:54:00:00:00:03"}, DomainName: "web"},
+		&LeaseEvent{Network: "default", Lease: NetworkDHCPLease{IPaddr: "192.168.122.4", Mac: "52:54:00:00:00:04"}},
+	)
+	expected := "# DHCP leases of libvirt networks\n" +
+		"192.168.122.3\tweb\n" +
+		"192.168.122.20\tdb\n"
+	if got := string(formatHostsFile(leases)); got != expected {
+		t.Fatalf("Got hosts file:\n%s\nexpected:\n%s", got, expected)
+	}
+}
+
+func TestLeaseWatcherHostsFile(t *testing.T) {
+	conn := buildTestConnection()
+	defer func() {
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	dir, err := ioutil.TempDir("", "leasewatch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	hostsFile := filepath.Join(dir, "hosts")
+
+	watcher := NewLeaseWatcher(conn, &LeaseWatcherConfig{
+		Networks:  []string{"no-such-network"},
+		HostsFile: hostsFile,
+	})
+	events, err := watcher.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("Got %d events for a missing network", len(events))
+	}
+
+	data, err := ioutil.ReadFile(hostsFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "# DHCP leases of libvirt networks\n" {
+		t.Fatalf("Unexpected hosts file:\n%s", data)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("Expected only the hosts file, found %d files", len(files))
+	}
+}
+
+func TestLeaseWatcherCallback(t *testing.T) {
+	conn := buildTestConnection()
+	defer func() {
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	var watcher *LeaseWatcher
+	var events []LeaseEventType
+	var leases []LeaseEvent
+	watcher = NewLeaseWatcher(conn, &LeaseWatcherConfig{
+		Networks: []string{"no-such-network"},
+		Callback: func(event *LeaseEvent) {
+			events = append(events, event.Type)
+			// The watcher may be used from the callback
+			leases = watcher.Leases()
+		},
+	})
+	// A lease seen by an earlier poll, of a network since removed
+	event := &LeaseEvent{
+		Network: "no-such-network",
+		Lease:   NetworkDHCPLease{Mac: "52:54:00:00:00:01", IPaddr: "192.168.122.10"},
+	}
+	watcher.leases[leaseKey(event)] = event
+
+	finished := make(chan error, 1)
+	go func() {
+		_, err := watcher.Poll()
+		finished <- err
+	}()
+	select {
+	case err := <-finished:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("Poll deadlocked calling back into the watcher")
+	}
+	if len(events) != 1 || events[0] != LEASE_EVENT_REMOVED {
+		t.Fatalf("Unexpected events %v", events)
+	}
+	if len(leases) != 0 {
+		t.Fatalf("Callback saw %d leases, expected none", len(leases))
+	}
+}
+
+func TestLeaseWatcherNilConfig(t *testing.T) {
+	watcher := NewLeaseWatcher(nil, nil)
+	if watcher.config.Interval != 10*time.Second {
+		t.Fatalf("Unexpected interval %v", watcher.config.Interval)
+	}
+}
@@ -0,0 +1,169 @@
+// +build libvirt_dlopen
+
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+/*
+#cgo CFLAGS: -DLIBVIRT_DLOPEN
+#cgo LDFLAGS: -ldl
+#include <dlfcn.h>
+#include <pthread.h>
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+#include "libvirt_dlopen.h"
+
+static const char *libvirtDlopenLibNames[] = {
+    "libvirt.so.0",
+    "libvirt-qemu.so.0",
+    "libvirt-lxc.so.0",
+    "libvirt-admin.so.0",
+};
+
+static void *libvirtDlopenLibs[4];
+static char *libvirtDlopenLibErrors[4];
+static pthread_once_t libvirtDlopenOnce[4] = {
+    PTHREAD_ONCE_INIT, PTHREAD_ONCE_INIT, PTHREAD_ONCE_INIT, PTHREAD_ONCE_INIT,
+};
+
+static __thread int libvirtDlopenFailed;
+static __thread char libvirtDlopenMessage[1024];
+
+#define LIBVIRT_DLOPEN_ONCE(lib) \
+    static void libvirtDlopenLoad##lib(void) \
+    { \
+        const char *name = libvirtDlopenLibNames[LIBVIRT_DLOPEN_LIB_##lib]; \
+        void *handle = dlopen(name, RTLD_NOW | RTLD_GLOBAL); \
+        if (!handle) { \
+            const char *msg = dlerror(); \
+            libvirtDlopenLibErrors[LIBVIRT_DLOPEN_LIB_##lib] = strdup(msg ? msg : "unknown error"); \
+        } \
+        libvirtDlopenLibs[LIBVIRT_DLOPEN_LIB_##lib] = handle; \
+    }
+
+LIBVIRT_DLOPEN_ONCE(LIBVIRT)
+LIBVIRT_DLOPEN_ONCE(QEMU)
+LIBVIRT_DLOPEN_ONCE(LXC)
+LIBVIRT_DLOPEN_ONCE(ADMIN)
+
+static void *
+libvirtDlopenLoad(int lib)
+{
+    switch (lib) {
+    case LIBVIRT_DLOPEN_LIB_LIBVIRT:
+        pthread_once(&libvirtDlopenOnce[lib], libvirtDlopenLoadLIBVIRT);
+        break;
+    case LIBVIRT_DLOPEN_LIB_QEMU:
+        // libvirt-qemu.so needs the symbols from libvirt.so
+        libvirtDlopenLoad(LIBVIRT_DLOPEN_LIB_LIBVIRT);
+        pthread_once(&libvirtDlopenOnce[lib], libvirtDlopenLoadQEMU);
+        break;
+    case LIBVIRT_DLOPEN_LIB_LXC:
+        libvirtDlopenLoad(LIBVIRT_DLOPEN_LIB_LIBVIRT);
+        pthread_once(&libvirtDlopenOnce[lib], libvirtDlopenLoadLXC);
+        break;
+    case LIBVIRT_DLOPEN_LIB_ADMIN:
+        libvirtDlopenLoad(LIBVIRT_DLOPEN_LIB_LIBVIRT);
+        pthread_once(&libvirtDlopenOnce[lib], libvirtDlopenLoadADMIN);
+        break;
+    default:
+        return NULL;
+    }
+    return libvirtDlopenLibs[lib];
+}
+
+int
+libvirtDlopenSymbol(int lib,
+                    const char *name,
+                    void **sym)
+{
+    void *handle = libvirtDlopenLoad(lib);
+
+    if (!handle) {
+        libvirtDlopenFailed = 1;
+        snprintf(libvirtDlopenMessage, sizeof(libvirtDlopenMessage),
+                 "Function '%s' not available, unable to load '%s': %s",
+                 name, libvirtDlopenLibNames[lib], libvirtDlopenLibErrors[lib]);
+        return -1;
+    }
+
+    *sym = dlsym(handle, name);
+    if (!*sym) {
+        libvirtDlopenFailed = 1;
+        snprintf(libvirtDlopenMessage, sizeof(libvirtDlopenMessage),
+                 "Function '%s' not available in the libvirt library '%s' used at runtime",
+                 name, libvirtDlopenLibNames[lib]);
+        return -1;
+    }
+
+    libvirtDlopenFailed = 0;
+    return 0;
+}
+
+int
+libvirtDlopenCopyLastError(virErrorPtr err)
+{
+    int (*copyLastError)(virErrorPtr) = NULL;
+
+    if (!libvirtDlopenFailed &&
+        libvirtDlopenSymbol(LIBVIRT_DLOPEN_LIB_LIBVIRT, "virCopyLastError",
+                            (void **)&copyLastError) == 0)
+        return copyLastError(err);
+
+    // Either the API that just failed, or virCopyLastError itself,
+    // could not be resolved, so report it the same way as an API
+    // that was missing at build time.
+    libvirtDlopenFailed = 0;
+    memset(err, 0, sizeof(*err));
+    err->code = VIR_ERR_NO_SUPPORT;
+    err->domain = VIR_FROM_NONE;
+    err->level = VIR_ERR_ERROR;
+    err->message = strdup(libvirtDlopenMessage);
+    return err->code;
+}
+
+void
+libvirtDlopenResetError(virErrorPtr err)
+{
+    void (*resetError)(virErrorPtr) = NULL;
+
+    if (libvirtDlopenSymbol(LIBVIRT_DLOPEN_LIB_LIBVIRT, "virResetError",
+                            (void **)&resetError) == 0) {
+        resetError(err);
+        return;
+    }
+
+    libvirtDlopenFailed = 0;
+    free(err->message);
+    free(err->str1);
+    free(err->str2);
+    free(err->str3);
+    memset(err, 0, sizeof(*err));
+}
+
+*/
+import "C"
@@ -0,0 +1,606 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+#ifndef LIBVIRT_GO_LIBVIRT_DLOPEN_H__
+#define LIBVIRT_GO_LIBVIRT_DLOPEN_H__
+
+/*
+ * When built with the 'libvirt_dlopen' tag, no library is linked at
+ * build time. Instead every libvirt API used by the wrappers is
+ * resolved with dlsym() on first use, so a single binary can run
+ * against any libvirt.so. If a symbol is missing the call returns
+ * its usual error value, and the next virCopyLastError() reports
+ * VIR_ERR_NO_SUPPORT.
+ *
+ * The libvirt headers must be processed before any of the macros
+ * below are defined, otherwise the prototypes would be rewritten.
+ *
+ * Any new libvirt API used by a wrapper must be listed here. The
+ * macros are function-like, which cgo cannot call, so Go code must
+ * always go through a wrapper function.
+ */
+
+#include <libvirt/libvirt.h>
+#include <libvirt/virterror.h>
+
+#ifdef LIBVIRT_DLOPEN
+
+#include <stddef.h>
+#include <libvirt/libvirt-qemu.h>
+#include <libvirt/libvirt-lxc.h>
+#include <libvirt/libvirt-admin.h>
+
+#define LIBVIRT_DLOPEN_LIB_LIBVIRT 0
+#define LIBVIRT_DLOPEN_LIB_QEMU 1
+#define LIBVIRT_DLOPEN_LIB_LXC 2
+#define LIBVIRT_DLOPEN_LIB_ADMIN 3
+
+int
+libvirtDlopenSymbol(int lib,
+                    const char *name,
+                    void **sym);
+
+int
+libvirtDlopenCopyLastError(virErrorPtr err);
+
+void
+libvirtDlopenResetError(virErrorPtr err);
+
+#define LIBVIRT_DLOPEN_CALL(lib, name, errval, ...) \
+    ({ \
+        __typeof__(&name) libvirtDlopenFunc = NULL; \
+        __typeof__(name(__VA_ARGS__)) libvirtDlopenRet = (errval); \
+        if (libvirtDlopenSymbol(lib, #name, (void **)&libvirtDlopenFunc) == 0) \
+            libvirtDlopenRet = libvirtDlopenFunc(__VA_ARGS__); \
+        libvirtDlopenRet; \
+    })
+
+#define LIBVIRT_DLOPEN_CALL_VOID(lib, name, ...) \
+    ({ \
+        __typeof__(&name) libvirtDlopenFunc = NULL; \
+        if (libvirtDlopenSymbol(lib, #name, (void **)&libvirtDlopenFunc) == 0) \
+            libvirtDlopenFunc(__VA_ARGS__); \
+    })
+
+#define virCopyLastError(err) libvirtDlopenCopyLastError(err)
+#define virResetError(err) libvirtDlopenResetError(err)
+
+#define virAdmClientClose(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmClientClose, -1, __VA_ARGS__)
+#define virAdmClientFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmClientFree, -1, __VA_ARGS__)
+#define virAdmClientGetID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmClientGetID, (unsigned long long)-1, __VA_ARGS__)
+#define virAdmClientGetInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmClientGetInfo, -1, __VA_ARGS__)
+#define virAdmClientGetTimestamp(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmClientGetTimestamp, -1, __VA_ARGS__)
+#define virAdmClientGetTransport(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmClientGetTransport, -1, __VA_ARGS__)
+#define virAdmConnectClose(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectClose, -1, __VA_ARGS__)
+#define virAdmConnectGetLibVersion(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectGetLibVersion, -1, __VA_ARGS__)
+#define virAdmConnectGetLoggingFilters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectGetLoggingFilters, -1, __VA_ARGS__)
+#define virAdmConnectGetLoggingOutputs(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectGetLoggingOutputs, -1, __VA_ARGS__)
+#define virAdmConnectGetURI(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectGetURI, NULL, __VA_ARGS__)
+#define virAdmConnectIsAlive(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectIsAlive, -1, __VA_ARGS__)
+#define virAdmConnectListServers(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectListServers, -1, __VA_ARGS__)
+#define virAdmConnectLookupServer(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectLookupServer, NULL, __VA_ARGS__)
+#define virAdmConnectOpen(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectOpen, NULL, __VA_ARGS__)
+#define virAdmConnectRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectRef, -1, __VA_ARGS__)
+#define virAdmConnectRegisterCloseCallback(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectRegisterCloseCallback, -1, __VA_ARGS__)
+#define virAdmConnectSetDaemonTimeout(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectSetDaemonTimeout, -1, __VA_ARGS__)
+#define virAdmConnectSetLoggingFilters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectSetLoggingFilters, -1, __VA_ARGS__)
+#define virAdmConnectSetLoggingOutputs(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectSetLoggingOutputs, -1, __VA_ARGS__)
+#define virAdmConnectUnregisterCloseCallback(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmConnectUnregisterCloseCallback, -1, __VA_ARGS__)
+#define virAdmGetVersion(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmGetVersion, -1, __VA_ARGS__)
+#define virAdmServerFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmServerFree, -1, __VA_ARGS__)
+#define virAdmServerGetClientLimits(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmServerGetClientLimits, -1, __VA_ARGS__)
+#define virAdmServerGetName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmServerGetName, NULL, __VA_ARGS__)
+#define virAdmServerGetThreadPoolParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmServerGetThreadPoolParameters, -1, __VA_ARGS__)
+#define virAdmServerListClients(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmServerListClients, -1, __VA_ARGS__)
+#define virAdmServerLookupClient(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmServerLookupClient, NULL, __VA_ARGS__)
+#define virAdmServerSetClientLimits(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmServerSetClientLimits, -1, __VA_ARGS__)
+#define virAdmServerSetThreadPoolParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmServerSetThreadPoolParameters, -1, __VA_ARGS__)
+#define virAdmServerUpdateTlsFiles(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_ADMIN, virAdmServerUpdateTlsFiles, -1, __VA_ARGS__)
+#define virConnectBaselineCPU(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectBaselineCPU, NULL, __VA_ARGS__)
+#define virConnectBaselineHypervisorCPU(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectBaselineHypervisorCPU, NULL, __VA_ARGS__)
+#define virConnectClose(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectClose, -1, __VA_ARGS__)
+#define virConnectCompareCPU(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectCompareCPU, -1, __VA_ARGS__)
+#define virConnectCompareHypervisorCPU(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectCompareHypervisorCPU, -1, __VA_ARGS__)
+#define virConnectDomainEventDeregisterAny(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectDomainEventDeregisterAny, -1, __VA_ARGS__)
+#define virConnectDomainEventRegisterAny(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectDomainEventRegisterAny, -1, __VA_ARGS__)
+#define virConnectDomainQemuMonitorEventDeregister(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_QEMU, virConnectDomainQemuMonitorEventDeregister, -1, __VA_ARGS__)
+#define virConnectDomainQemuMonitorEventRegister(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_QEMU, virConnectDomainQemuMonitorEventRegister, -1, __VA_ARGS__)
+#define virConnectDomainXMLFromNative(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectDomainXMLFromNative, NULL, __VA_ARGS__)
+#define virConnectDomainXMLToNative(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectDomainXMLToNative, NULL, __VA_ARGS__)
+#define virConnectFindStoragePoolSources(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectFindStoragePoolSources, NULL, __VA_ARGS__)
+#define virConnectGetAllDomainStats(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetAllDomainStats, -1, __VA_ARGS__)
+#define virConnectGetCPUModelNames(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetCPUModelNames, -1, __VA_ARGS__)
+#define virConnectGetCapabilities(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetCapabilities, NULL, __VA_ARGS__)
+#define virConnectGetDomainCapabilities(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetDomainCapabilities, NULL, __VA_ARGS__)
+#define virConnectGetHostname(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetHostname, NULL, __VA_ARGS__)
+#define virConnectGetLibVersion(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetLibVersion, -1, __VA_ARGS__)
+#define virConnectGetMaxVcpus(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetMaxVcpus, -1, __VA_ARGS__)
+#define virConnectGetStoragePoolCapabilities(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetStoragePoolCapabilities, NULL, __VA_ARGS__)
+#define virConnectGetSysinfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetSysinfo, NULL, __VA_ARGS__)
+#define virConnectGetType(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetType, NULL, __VA_ARGS__)
+#define virConnectGetURI(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetURI, NULL, __VA_ARGS__)
+#define virConnectGetVersion(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectGetVersion, -1, __VA_ARGS__)
+#define virConnectIsAlive(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectIsAlive, -1, __VA_ARGS__)
+#define virConnectIsEncrypted(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectIsEncrypted, -1, __VA_ARGS__)
+#define virConnectIsSecure(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectIsSecure, -1, __VA_ARGS__)
+#define virConnectListAllDomains(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListAllDomains, -1, __VA_ARGS__)
+#define virConnectListAllInterfaces(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListAllInterfaces, -1, __VA_ARGS__)
+#define virConnectListAllNWFilterBindings(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListAllNWFilterBindings, -1, __VA_ARGS__)
+#define virConnectListAllNWFilters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListAllNWFilters, -1, __VA_ARGS__)
+#define virConnectListAllNetworks(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListAllNetworks, -1, __VA_ARGS__)
+#define virConnectListAllNodeDevices(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListAllNodeDevices, -1, __VA_ARGS__)
+#define virConnectListAllSecrets(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListAllSecrets, -1, __VA_ARGS__)
+#define virConnectListAllStoragePools(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListAllStoragePools, -1, __VA_ARGS__)
+#define virConnectListDefinedDomains(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListDefinedDomains, -1, __VA_ARGS__)
+#define virConnectListDefinedInterfaces(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListDefinedInterfaces, -1, __VA_ARGS__)
+#define virConnectListDefinedNetworks(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListDefinedNetworks, -1, __VA_ARGS__)
+#define virConnectListDefinedStoragePools(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListDefinedStoragePools, -1, __VA_ARGS__)
+#define virConnectListDomains(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListDomains, -1, __VA_ARGS__)
+#define virConnectListInterfaces(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListInterfaces, -1, __VA_ARGS__)
+#define virConnectListNWFilters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListNWFilters, -1, __VA_ARGS__)
+#define virConnectListNetworks(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListNetworks, -1, __VA_ARGS__)
+#define virConnectListSecrets(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListSecrets, -1, __VA_ARGS__)
+#define virConnectListStoragePools(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectListStoragePools, -1, __VA_ARGS__)
+#define virConnectNetworkEventDeregisterAny(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNetworkEventDeregisterAny, -1, __VA_ARGS__)
+#define virConnectNetworkEventRegisterAny(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNetworkEventRegisterAny, -1, __VA_ARGS__)
+#define virConnectNodeDeviceEventDeregisterAny(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNodeDeviceEventDeregisterAny, -1, __VA_ARGS__)
+#define virConnectNodeDeviceEventRegisterAny(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNodeDeviceEventRegisterAny, -1, __VA_ARGS__)
+#define virConnectNumOfDefinedDomains(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNumOfDefinedDomains, -1, __VA_ARGS__)
+#define virConnectNumOfDefinedInterfaces(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNumOfDefinedInterfaces, -1, __VA_ARGS__)
+#define virConnectNumOfDefinedNetworks(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNumOfDefinedNetworks, -1, __VA_ARGS__)
+#define virConnectNumOfDefinedStoragePools(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNumOfDefinedStoragePools, -1, __VA_ARGS__)
+#define virConnectNumOfDomains(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNumOfDomains, -1, __VA_ARGS__)
+#define virConnectNumOfInterfaces(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNumOfInterfaces, -1, __VA_ARGS__)
+#define virConnectNumOfNWFilters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNumOfNWFilters, -1, __VA_ARGS__)
+#define virConnectNumOfNetworks(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNumOfNetworks, -1, __VA_ARGS__)
+#define virConnectNumOfSecrets(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNumOfSecrets, -1, __VA_ARGS__)
+#define virConnectNumOfStoragePools(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectNumOfStoragePools, -1, __VA_ARGS__)
+#define virConnectOpen(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectOpen, NULL, __VA_ARGS__)
+#define virConnectOpenAuth(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectOpenAuth, NULL, __VA_ARGS__)
+#define virConnectOpenReadOnly(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectOpenReadOnly, NULL, __VA_ARGS__)
+#define virConnectRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectRef, -1, __VA_ARGS__)
+#define virConnectRegisterCloseCallback(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectRegisterCloseCallback, -1, __VA_ARGS__)
+#define virConnectSecretEventDeregisterAny(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectSecretEventDeregisterAny, -1, __VA_ARGS__)
+#define virConnectSecretEventRegisterAny(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectSecretEventRegisterAny, -1, __VA_ARGS__)
+#define virConnectSetIdentity(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectSetIdentity, -1, __VA_ARGS__)
+#define virConnectSetKeepAlive(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectSetKeepAlive, -1, __VA_ARGS__)
+#define virConnectStoragePoolEventDeregisterAny(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectStoragePoolEventDeregisterAny, -1, __VA_ARGS__)
+#define virConnectStoragePoolEventRegisterAny(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectStoragePoolEventRegisterAny, -1, __VA_ARGS__)
+#define virConnectUnregisterCloseCallback(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virConnectUnregisterCloseCallback, -1, __VA_ARGS__)
+#define virDomainAbortJob(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainAbortJob, -1, __VA_ARGS__)
+#define virDomainAddIOThread(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainAddIOThread, -1, __VA_ARGS__)
+#define virDomainAgentSetResponseTimeout(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainAgentSetResponseTimeout, -1, __VA_ARGS__)
+#define virDomainAttachDevice(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainAttachDevice, -1, __VA_ARGS__)
+#define virDomainAttachDeviceFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainAttachDeviceFlags, -1, __VA_ARGS__)
+#define virDomainBackupBegin(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBackupBegin, -1, __VA_ARGS__)
+#define virDomainBackupGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBackupGetXMLDesc, NULL, __VA_ARGS__)
+#define virDomainBlockCommit(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBlockCommit, -1, __VA_ARGS__)
+#define virDomainBlockCopy(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBlockCopy, -1, __VA_ARGS__)
+#define virDomainBlockJobAbort(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBlockJobAbort, -1, __VA_ARGS__)
+#define virDomainBlockJobSetSpeed(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBlockJobSetSpeed, -1, __VA_ARGS__)
+#define virDomainBlockPeek(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBlockPeek, -1, __VA_ARGS__)
+#define virDomainBlockPull(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBlockPull, -1, __VA_ARGS__)
+#define virDomainBlockRebase(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBlockRebase, -1, __VA_ARGS__)
+#define virDomainBlockResize(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBlockResize, -1, __VA_ARGS__)
+#define virDomainBlockStats(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBlockStats, -1, __VA_ARGS__)
+#define virDomainBlockStatsFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainBlockStatsFlags, -1, __VA_ARGS__)
+#define virDomainCheckpointCreateXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCheckpointCreateXML, NULL, __VA_ARGS__)
+#define virDomainCheckpointDelete(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCheckpointDelete, -1, __VA_ARGS__)
+#define virDomainCheckpointFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCheckpointFree, -1, __VA_ARGS__)
+#define virDomainCheckpointGetName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCheckpointGetName, NULL, __VA_ARGS__)
+#define virDomainCheckpointGetParent(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCheckpointGetParent, NULL, __VA_ARGS__)
+#define virDomainCheckpointGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCheckpointGetXMLDesc, NULL, __VA_ARGS__)
+#define virDomainCheckpointListAllChildren(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCheckpointListAllChildren, -1, __VA_ARGS__)
+#define virDomainCheckpointLookupByName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCheckpointLookupByName, NULL, __VA_ARGS__)
+#define virDomainCheckpointRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCheckpointRef, -1, __VA_ARGS__)
+#define virDomainCoreDump(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCoreDump, -1, __VA_ARGS__)
+#define virDomainCoreDumpWithFormat(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCoreDumpWithFormat, -1, __VA_ARGS__)
+#define virDomainCreate(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCreate, -1, __VA_ARGS__)
+#define virDomainCreateLinux(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCreateLinux, NULL, __VA_ARGS__)
+#define virDomainCreateWithFiles(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCreateWithFiles, -1, __VA_ARGS__)
+#define virDomainCreateWithFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCreateWithFlags, -1, __VA_ARGS__)
+#define virDomainCreateXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCreateXML, NULL, __VA_ARGS__)
+#define virDomainCreateXMLWithFiles(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainCreateXMLWithFiles, NULL, __VA_ARGS__)
+#define virDomainDefineXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainDefineXML, NULL, __VA_ARGS__)
+#define virDomainDefineXMLFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainDefineXMLFlags, NULL, __VA_ARGS__)
+#define virDomainDelIOThread(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainDelIOThread, -1, __VA_ARGS__)
+#define virDomainDestroy(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainDestroy, -1, __VA_ARGS__)
+#define virDomainDestroyFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainDestroyFlags, -1, __VA_ARGS__)
+#define virDomainDetachDevice(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainDetachDevice, -1, __VA_ARGS__)
+#define virDomainDetachDeviceAlias(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainDetachDeviceAlias, -1, __VA_ARGS__)
+#define virDomainDetachDeviceFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainDetachDeviceFlags, -1, __VA_ARGS__)
+#define virDomainFSFreeze(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainFSFreeze, -1, __VA_ARGS__)
+#define virDomainFSInfoFree(...) LIBVIRT_DLOPEN_CALL_VOID(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainFSInfoFree, __VA_ARGS__)
+#define virDomainFSThaw(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainFSThaw, -1, __VA_ARGS__)
+#define virDomainFSTrim(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainFSTrim, -1, __VA_ARGS__)
+#define virDomainFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainFree, -1, __VA_ARGS__)
+#define virDomainGetAutostart(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetAutostart, -1, __VA_ARGS__)
+#define virDomainGetBlkioParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetBlkioParameters, -1, __VA_ARGS__)
+#define virDomainGetBlockInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetBlockInfo, -1, __VA_ARGS__)
+#define virDomainGetBlockIoTune(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetBlockIoTune, -1, __VA_ARGS__)
+#define virDomainGetBlockJobInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetBlockJobInfo, -1, __VA_ARGS__)
+#define virDomainGetCPUStats(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetCPUStats, -1, __VA_ARGS__)
+#define virDomainGetConnect(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetConnect, NULL, __VA_ARGS__)
+#define virDomainGetControlInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetControlInfo, -1, __VA_ARGS__)
+#define virDomainGetDiskErrors(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetDiskErrors, -1, __VA_ARGS__)
+#define virDomainGetEmulatorPinInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetEmulatorPinInfo, -1, __VA_ARGS__)
+#define virDomainGetFSInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetFSInfo, -1, __VA_ARGS__)
+#define virDomainGetGuestInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetGuestInfo, -1, __VA_ARGS__)
+#define virDomainGetGuestVcpus(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetGuestVcpus, -1, __VA_ARGS__)
+#define virDomainGetHostname(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetHostname, NULL, __VA_ARGS__)
+#define virDomainGetID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetID, -1, __VA_ARGS__)
+#define virDomainGetIOThreadInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetIOThreadInfo, -1, __VA_ARGS__)
+#define virDomainGetInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetInfo, -1, __VA_ARGS__)
+#define virDomainGetInterfaceParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetInterfaceParameters, -1, __VA_ARGS__)
+#define virDomainGetJobInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetJobInfo, -1, __VA_ARGS__)
+#define virDomainGetJobStats(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetJobStats, -1, __VA_ARGS__)
+#define virDomainGetLaunchSecurityInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetLaunchSecurityInfo, -1, __VA_ARGS__)
+#define virDomainGetMaxMemory(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetMaxMemory, 0, __VA_ARGS__)
+#define virDomainGetMaxVcpus(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetMaxVcpus, -1, __VA_ARGS__)
+#define virDomainGetMemoryParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetMemoryParameters, -1, __VA_ARGS__)
+#define virDomainGetMetadata(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetMetadata, NULL, __VA_ARGS__)
+#define virDomainGetName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetName, NULL, __VA_ARGS__)
+#define virDomainGetNumaParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetNumaParameters, -1, __VA_ARGS__)
+#define virDomainGetOSType(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetOSType, NULL, __VA_ARGS__)
+#define virDomainGetPerfEvents(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetPerfEvents, -1, __VA_ARGS__)
+#define virDomainGetSchedulerParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetSchedulerParameters, -1, __VA_ARGS__)
+#define virDomainGetSchedulerParametersFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetSchedulerParametersFlags, -1, __VA_ARGS__)
+#define virDomainGetSchedulerType(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetSchedulerType, NULL, __VA_ARGS__)
+#define virDomainGetSecurityLabel(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetSecurityLabel, -1, __VA_ARGS__)
+#define virDomainGetSecurityLabelList(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetSecurityLabelList, -1, __VA_ARGS__)
+#define virDomainGetState(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetState, -1, __VA_ARGS__)
+#define virDomainGetTime(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetTime, -1, __VA_ARGS__)
+#define virDomainGetUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetUUID, -1, __VA_ARGS__)
+#define virDomainGetUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetUUIDString, -1, __VA_ARGS__)
+#define virDomainGetVcpuPinInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetVcpuPinInfo, -1, __VA_ARGS__)
+#define virDomainGetVcpus(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetVcpus, -1, __VA_ARGS__)
+#define virDomainGetVcpusFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetVcpusFlags, -1, __VA_ARGS__)
+#define virDomainGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainGetXMLDesc, NULL, __VA_ARGS__)
+#define virDomainHasCurrentSnapshot(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainHasCurrentSnapshot, -1, __VA_ARGS__)
+#define virDomainHasManagedSaveImage(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainHasManagedSaveImage, -1, __VA_ARGS__)
+#define virDomainIOThreadInfoFree(...) LIBVIRT_DLOPEN_CALL_VOID(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainIOThreadInfoFree, __VA_ARGS__)
+#define virDomainInjectNMI(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainInjectNMI, -1, __VA_ARGS__)
+#define virDomainInterfaceAddresses(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainInterfaceAddresses, -1, __VA_ARGS__)
+#define virDomainInterfaceFree(...) LIBVIRT_DLOPEN_CALL_VOID(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainInterfaceFree, __VA_ARGS__)
+#define virDomainInterfaceStats(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainInterfaceStats, -1, __VA_ARGS__)
+#define virDomainIsActive(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainIsActive, -1, __VA_ARGS__)
+#define virDomainIsPersistent(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainIsPersistent, -1, __VA_ARGS__)
+#define virDomainIsUpdated(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainIsUpdated, -1, __VA_ARGS__)
+#define virDomainListAllCheckpoints(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainListAllCheckpoints, -1, __VA_ARGS__)
+#define virDomainListAllSnapshots(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainListAllSnapshots, -1, __VA_ARGS__)
+#define virDomainListGetStats(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainListGetStats, -1, __VA_ARGS__)
+#define virDomainLookupByID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainLookupByID, NULL, __VA_ARGS__)
+#define virDomainLookupByName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainLookupByName, NULL, __VA_ARGS__)
+#define virDomainLookupByUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainLookupByUUID, NULL, __VA_ARGS__)
+#define virDomainLookupByUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainLookupByUUIDString, NULL, __VA_ARGS__)
+#define virDomainLxcEnterCGroup(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LXC, virDomainLxcEnterCGroup, -1, __VA_ARGS__)
+#define virDomainLxcEnterNamespace(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LXC, virDomainLxcEnterNamespace, -1, __VA_ARGS__)
+#define virDomainLxcEnterSecurityLabel(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LXC, virDomainLxcEnterSecurityLabel, -1, __VA_ARGS__)
+#define virDomainLxcOpenNamespace(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LXC, virDomainLxcOpenNamespace, -1, __VA_ARGS__)
+#define virDomainManagedSave(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainManagedSave, -1, __VA_ARGS__)
+#define virDomainManagedSaveDefineXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainManagedSaveDefineXML, -1, __VA_ARGS__)
+#define virDomainManagedSaveGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainManagedSaveGetXMLDesc, NULL, __VA_ARGS__)
+#define virDomainManagedSaveRemove(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainManagedSaveRemove, -1, __VA_ARGS__)
+#define virDomainMemoryPeek(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMemoryPeek, -1, __VA_ARGS__)
+#define virDomainMemoryStats(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMemoryStats, -1, __VA_ARGS__)
+#define virDomainMigrate(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrate, NULL, __VA_ARGS__)
+#define virDomainMigrate2(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrate2, NULL, __VA_ARGS__)
+#define virDomainMigrate3(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrate3, NULL, __VA_ARGS__)
+#define virDomainMigrateGetCompressionCache(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrateGetCompressionCache, -1, __VA_ARGS__)
+#define virDomainMigrateGetMaxDowntime(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrateGetMaxDowntime, -1, __VA_ARGS__)
+#define virDomainMigrateGetMaxSpeed(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrateGetMaxSpeed, -1, __VA_ARGS__)
+#define virDomainMigrateSetCompressionCache(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrateSetCompressionCache, -1, __VA_ARGS__)
+#define virDomainMigrateSetMaxDowntime(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrateSetMaxDowntime, -1, __VA_ARGS__)
+#define virDomainMigrateSetMaxSpeed(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrateSetMaxSpeed, -1, __VA_ARGS__)
+#define virDomainMigrateStartPostCopy(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrateStartPostCopy, -1, __VA_ARGS__)
+#define virDomainMigrateToURI(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrateToURI, -1, __VA_ARGS__)
+#define virDomainMigrateToURI2(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrateToURI2, -1, __VA_ARGS__)
+#define virDomainMigrateToURI3(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainMigrateToURI3, -1, __VA_ARGS__)
+#define virDomainOpenChannel(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainOpenChannel, -1, __VA_ARGS__)
+#define virDomainOpenConsole(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainOpenConsole, -1, __VA_ARGS__)
+#define virDomainOpenGraphics(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainOpenGraphics, -1, __VA_ARGS__)
+#define virDomainOpenGraphicsFD(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainOpenGraphicsFD, -1, __VA_ARGS__)
+#define virDomainPMSuspendForDuration(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainPMSuspendForDuration, -1, __VA_ARGS__)
+#define virDomainPMWakeup(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainPMWakeup, -1, __VA_ARGS__)
+#define virDomainPinEmulator(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainPinEmulator, -1, __VA_ARGS__)
+#define virDomainPinIOThread(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainPinIOThread, -1, __VA_ARGS__)
+#define virDomainPinVcpu(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainPinVcpu, -1, __VA_ARGS__)
+#define virDomainPinVcpuFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainPinVcpuFlags, -1, __VA_ARGS__)
+#define virDomainQemuAgentCommand(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_QEMU, virDomainQemuAgentCommand, NULL, __VA_ARGS__)
+#define virDomainQemuAttach(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_QEMU, virDomainQemuAttach, NULL, __VA_ARGS__)
+#define virDomainQemuMonitorCommand(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_QEMU, virDomainQemuMonitorCommand, -1, __VA_ARGS__)
+#define virDomainReboot(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainReboot, -1, __VA_ARGS__)
+#define virDomainRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainRef, -1, __VA_ARGS__)
+#define virDomainRename(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainRename, -1, __VA_ARGS__)
+#define virDomainReset(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainReset, -1, __VA_ARGS__)
+#define virDomainRestore(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainRestore, -1, __VA_ARGS__)
+#define virDomainRestoreFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainRestoreFlags, -1, __VA_ARGS__)
+#define virDomainResume(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainResume, -1, __VA_ARGS__)
+#define virDomainRevertToSnapshot(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainRevertToSnapshot, -1, __VA_ARGS__)
+#define virDomainSave(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSave, -1, __VA_ARGS__)
+#define virDomainSaveFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSaveFlags, -1, __VA_ARGS__)
+#define virDomainSaveImageDefineXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSaveImageDefineXML, -1, __VA_ARGS__)
+#define virDomainSaveImageGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSaveImageGetXMLDesc, NULL, __VA_ARGS__)
+#define virDomainScreenshot(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainScreenshot, NULL, __VA_ARGS__)
+#define virDomainSendKey(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSendKey, -1, __VA_ARGS__)
+#define virDomainSendProcessSignal(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSendProcessSignal, -1, __VA_ARGS__)
+#define virDomainSetAutostart(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetAutostart, -1, __VA_ARGS__)
+#define virDomainSetBlkioParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetBlkioParameters, -1, __VA_ARGS__)
+#define virDomainSetBlockIoTune(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetBlockIoTune, -1, __VA_ARGS__)
+#define virDomainSetBlockThreshold(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetBlockThreshold, -1, __VA_ARGS__)
+#define virDomainSetGuestVcpus(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetGuestVcpus, -1, __VA_ARGS__)
+#define virDomainSetIOThreadParams(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetIOThreadParams, -1, __VA_ARGS__)
+#define virDomainSetInterfaceParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetInterfaceParameters, -1, __VA_ARGS__)
+#define virDomainSetLifecycleAction(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetLifecycleAction, -1, __VA_ARGS__)
+#define virDomainSetMaxMemory(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetMaxMemory, -1, __VA_ARGS__)
+#define virDomainSetMemory(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetMemory, -1, __VA_ARGS__)
+#define virDomainSetMemoryFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetMemoryFlags, -1, __VA_ARGS__)
+#define virDomainSetMemoryParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetMemoryParameters, -1, __VA_ARGS__)
+#define virDomainSetMemoryStatsPeriod(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetMemoryStatsPeriod, -1, __VA_ARGS__)
+#define virDomainSetMetadata(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetMetadata, -1, __VA_ARGS__)
+#define virDomainSetNumaParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetNumaParameters, -1, __VA_ARGS__)
+#define virDomainSetPerfEvents(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetPerfEvents, -1, __VA_ARGS__)
+#define virDomainSetSchedulerParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetSchedulerParameters, -1, __VA_ARGS__)
+#define virDomainSetSchedulerParametersFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetSchedulerParametersFlags, -1, __VA_ARGS__)
+#define virDomainSetTime(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetTime, -1, __VA_ARGS__)
+#define virDomainSetUserPassword(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetUserPassword, -1, __VA_ARGS__)
+#define virDomainSetVcpu(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetVcpu, -1, __VA_ARGS__)
+#define virDomainSetVcpus(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetVcpus, -1, __VA_ARGS__)
+#define virDomainSetVcpusFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSetVcpusFlags, -1, __VA_ARGS__)
+#define virDomainShutdown(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainShutdown, -1, __VA_ARGS__)
+#define virDomainShutdownFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainShutdownFlags, -1, __VA_ARGS__)
+#define virDomainSnapshotCreateXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotCreateXML, NULL, __VA_ARGS__)
+#define virDomainSnapshotCurrent(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotCurrent, NULL, __VA_ARGS__)
+#define virDomainSnapshotDelete(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotDelete, -1, __VA_ARGS__)
+#define virDomainSnapshotFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotFree, -1, __VA_ARGS__)
+#define virDomainSnapshotGetName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotGetName, NULL, __VA_ARGS__)
+#define virDomainSnapshotGetParent(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotGetParent, NULL, __VA_ARGS__)
+#define virDomainSnapshotGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotGetXMLDesc, NULL, __VA_ARGS__)
+#define virDomainSnapshotHasMetadata(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotHasMetadata, -1, __VA_ARGS__)
+#define virDomainSnapshotIsCurrent(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotIsCurrent, -1, __VA_ARGS__)
+#define virDomainSnapshotListAllChildren(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotListAllChildren, -1, __VA_ARGS__)
+#define virDomainSnapshotListChildrenNames(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotListChildrenNames, -1, __VA_ARGS__)
+#define virDomainSnapshotListNames(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotListNames, -1, __VA_ARGS__)
+#define virDomainSnapshotLookupByName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotLookupByName, NULL, __VA_ARGS__)
+#define virDomainSnapshotNum(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotNum, -1, __VA_ARGS__)
+#define virDomainSnapshotNumChildren(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotNumChildren, -1, __VA_ARGS__)
+#define virDomainSnapshotRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSnapshotRef, -1, __VA_ARGS__)
+#define virDomainStatsRecordListFree(...) LIBVIRT_DLOPEN_CALL_VOID(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainStatsRecordListFree, __VA_ARGS__)
+#define virDomainSuspend(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainSuspend, -1, __VA_ARGS__)
+#define virDomainUndefine(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainUndefine, -1, __VA_ARGS__)
+#define virDomainUndefineFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainUndefineFlags, -1, __VA_ARGS__)
+#define virDomainUpdateDeviceFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virDomainUpdateDeviceFlags, -1, __VA_ARGS__)
+#define virEventAddHandle(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virEventAddHandle, -1, __VA_ARGS__)
+#define virEventAddTimeout(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virEventAddTimeout, -1, __VA_ARGS__)
+#define virEventRegisterDefaultImpl(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virEventRegisterDefaultImpl, -1, __VA_ARGS__)
+#define virEventRegisterImpl(...) LIBVIRT_DLOPEN_CALL_VOID(LIBVIRT_DLOPEN_LIB_LIBVIRT, virEventRegisterImpl, __VA_ARGS__)
+#define virEventRemoveHandle(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virEventRemoveHandle, -1, __VA_ARGS__)
+#define virEventRemoveTimeout(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virEventRemoveTimeout, -1, __VA_ARGS__)
+#define virEventRunDefaultImpl(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virEventRunDefaultImpl, -1, __VA_ARGS__)
+#define virEventUpdateHandle(...) LIBVIRT_DLOPEN_CALL_VOID(LIBVIRT_DLOPEN_LIB_LIBVIRT, virEventUpdateHandle, __VA_ARGS__)
+#define virEventUpdateTimeout(...) LIBVIRT_DLOPEN_CALL_VOID(LIBVIRT_DLOPEN_LIB_LIBVIRT, virEventUpdateTimeout, __VA_ARGS__)
+#define virGetVersion(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virGetVersion, -1, __VA_ARGS__)
+#define virInitialize(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInitialize, -1, __VA_ARGS__)
+#define virInterfaceChangeBegin(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceChangeBegin, -1, __VA_ARGS__)
+#define virInterfaceChangeCommit(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceChangeCommit, -1, __VA_ARGS__)
+#define virInterfaceChangeRollback(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceChangeRollback, -1, __VA_ARGS__)
+#define virInterfaceCreate(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceCreate, -1, __VA_ARGS__)
+#define virInterfaceDefineXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceDefineXML, NULL, __VA_ARGS__)
+#define virInterfaceDestroy(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceDestroy, -1, __VA_ARGS__)
+#define virInterfaceFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceFree, -1, __VA_ARGS__)
+#define virInterfaceGetMACString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceGetMACString, NULL, __VA_ARGS__)
+#define virInterfaceGetName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceGetName, NULL, __VA_ARGS__)
+#define virInterfaceGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceGetXMLDesc, NULL, __VA_ARGS__)
+#define virInterfaceIsActive(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceIsActive, -1, __VA_ARGS__)
+#define virInterfaceLookupByMACString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceLookupByMACString, NULL, __VA_ARGS__)
+#define virInterfaceLookupByName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceLookupByName, NULL, __VA_ARGS__)
+#define virInterfaceRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceRef, -1, __VA_ARGS__)
+#define virInterfaceUndefine(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virInterfaceUndefine, -1, __VA_ARGS__)
+#define virNWFilterBindingCreateXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterBindingCreateXML, NULL, __VA_ARGS__)
+#define virNWFilterBindingDelete(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterBindingDelete, -1, __VA_ARGS__)
+#define virNWFilterBindingFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterBindingFree, -1, __VA_ARGS__)
+#define virNWFilterBindingGetFilterName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterBindingGetFilterName, NULL, __VA_ARGS__)
+#define virNWFilterBindingGetPortDev(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterBindingGetPortDev, NULL, __VA_ARGS__)
+#define virNWFilterBindingGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterBindingGetXMLDesc, NULL, __VA_ARGS__)
+#define virNWFilterBindingLookupByPortDev(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterBindingLookupByPortDev, NULL, __VA_ARGS__)
+#define virNWFilterBindingRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterBindingRef, -1, __VA_ARGS__)
+#define virNWFilterDefineXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterDefineXML, NULL, __VA_ARGS__)
+#define virNWFilterFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterFree, -1, __VA_ARGS__)
+#define virNWFilterGetName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterGetName, NULL, __VA_ARGS__)
+#define virNWFilterGetUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterGetUUID, -1, __VA_ARGS__)
+#define virNWFilterGetUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterGetUUIDString, -1, __VA_ARGS__)
+#define virNWFilterGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterGetXMLDesc, NULL, __VA_ARGS__)
+#define virNWFilterLookupByName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterLookupByName, NULL, __VA_ARGS__)
+#define virNWFilterLookupByUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterLookupByUUID, NULL, __VA_ARGS__)
+#define virNWFilterLookupByUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterLookupByUUIDString, NULL, __VA_ARGS__)
+#define virNWFilterRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterRef, -1, __VA_ARGS__)
+#define virNWFilterUndefine(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNWFilterUndefine, -1, __VA_ARGS__)
+#define virNetworkCreate(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkCreate, -1, __VA_ARGS__)
+#define virNetworkCreateXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkCreateXML, NULL, __VA_ARGS__)
+#define virNetworkCreateXMLFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkCreateXMLFlags, NULL, __VA_ARGS__)
+#define virNetworkDHCPLeaseFree(...) LIBVIRT_DLOPEN_CALL_VOID(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkDHCPLeaseFree, __VA_ARGS__)
+#define virNetworkDefineXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkDefineXML, NULL, __VA_ARGS__)
+#define virNetworkDefineXMLFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkDefineXMLFlags, NULL, __VA_ARGS__)
+#define virNetworkDestroy(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkDestroy, -1, __VA_ARGS__)
+#define virNetworkFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkFree, -1, __VA_ARGS__)
+#define virNetworkGetAutostart(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkGetAutostart, -1, __VA_ARGS__)
+#define virNetworkGetBridgeName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkGetBridgeName, NULL, __VA_ARGS__)
+#define virNetworkGetDHCPLeases(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkGetDHCPLeases, -1, __VA_ARGS__)
+#define virNetworkGetMetadata(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkGetMetadata, NULL, __VA_ARGS__)
+#define virNetworkGetName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkGetName, NULL, __VA_ARGS__)
+#define virNetworkGetUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkGetUUID, -1, __VA_ARGS__)
+#define virNetworkGetUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkGetUUIDString, -1, __VA_ARGS__)
+#define virNetworkGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkGetXMLDesc, NULL, __VA_ARGS__)
+#define virNetworkIsActive(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkIsActive, -1, __VA_ARGS__)
+#define virNetworkIsPersistent(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkIsPersistent, -1, __VA_ARGS__)
+#define virNetworkListAllPorts(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkListAllPorts, -1, __VA_ARGS__)
+#define virNetworkLookupByName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkLookupByName, NULL, __VA_ARGS__)
+#define virNetworkLookupByUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkLookupByUUID, NULL, __VA_ARGS__)
+#define virNetworkLookupByUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkLookupByUUIDString, NULL, __VA_ARGS__)
+#define virNetworkPortCreateXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortCreateXML, NULL, __VA_ARGS__)
+#define virNetworkPortDelete(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortDelete, -1, __VA_ARGS__)
+#define virNetworkPortFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortFree, -1, __VA_ARGS__)
+#define virNetworkPortGetNetwork(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortGetNetwork, NULL, __VA_ARGS__)
+#define virNetworkPortGetParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortGetParameters, -1, __VA_ARGS__)
+#define virNetworkPortGetUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortGetUUID, -1, __VA_ARGS__)
+#define virNetworkPortGetUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortGetUUIDString, -1, __VA_ARGS__)
+#define virNetworkPortGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortGetXMLDesc, NULL, __VA_ARGS__)
+#define virNetworkPortLookupByUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortLookupByUUID, NULL, __VA_ARGS__)
+#define virNetworkPortLookupByUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortLookupByUUIDString, NULL, __VA_ARGS__)
+#define virNetworkPortRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortRef, -1, __VA_ARGS__)
+#define virNetworkPortSetParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkPortSetParameters, -1, __VA_ARGS__)
+#define virNetworkRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkRef, -1, __VA_ARGS__)
+#define virNetworkSetAutostart(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkSetAutostart, -1, __VA_ARGS__)
+#define virNetworkSetMetadata(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkSetMetadata, -1, __VA_ARGS__)
+#define virNetworkUndefine(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkUndefine, -1, __VA_ARGS__)
+#define virNetworkUpdate(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNetworkUpdate, -1, __VA_ARGS__)
+#define virNodeAllocPages(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeAllocPages, -1, __VA_ARGS__)
+#define virNodeDeviceCreateXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceCreateXML, NULL, __VA_ARGS__)
+#define virNodeDeviceDestroy(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceDestroy, -1, __VA_ARGS__)
+#define virNodeDeviceDetachFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceDetachFlags, -1, __VA_ARGS__)
+#define virNodeDeviceDettach(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceDettach, -1, __VA_ARGS__)
+#define virNodeDeviceFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceFree, -1, __VA_ARGS__)
+#define virNodeDeviceGetName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceGetName, NULL, __VA_ARGS__)
+#define virNodeDeviceGetParent(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceGetParent, NULL, __VA_ARGS__)
+#define virNodeDeviceGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceGetXMLDesc, NULL, __VA_ARGS__)
+#define virNodeDeviceListCaps(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceListCaps, -1, __VA_ARGS__)
+#define virNodeDeviceLookupByName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceLookupByName, NULL, __VA_ARGS__)
+#define virNodeDeviceLookupSCSIHostByWWN(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceLookupSCSIHostByWWN, NULL, __VA_ARGS__)
+#define virNodeDeviceNumOfCaps(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceNumOfCaps, -1, __VA_ARGS__)
+#define virNodeDeviceReAttach(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceReAttach, -1, __VA_ARGS__)
+#define virNodeDeviceRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceRef, -1, __VA_ARGS__)
+#define virNodeDeviceReset(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeDeviceReset, -1, __VA_ARGS__)
+#define virNodeGetCPUMap(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeGetCPUMap, -1, __VA_ARGS__)
+#define virNodeGetCPUStats(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeGetCPUStats, -1, __VA_ARGS__)
+#define virNodeGetCellsFreeMemory(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeGetCellsFreeMemory, -1, __VA_ARGS__)
+#define virNodeGetFreeMemory(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeGetFreeMemory, 0, __VA_ARGS__)
+#define virNodeGetFreePages(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeGetFreePages, -1, __VA_ARGS__)
+#define virNodeGetInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeGetInfo, -1, __VA_ARGS__)
+#define virNodeGetMemoryParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeGetMemoryParameters, -1, __VA_ARGS__)
+#define virNodeGetMemoryStats(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeGetMemoryStats, -1, __VA_ARGS__)
+#define virNodeGetSEVInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeGetSEVInfo, -1, __VA_ARGS__)
+#define virNodeGetSecurityModel(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeGetSecurityModel, -1, __VA_ARGS__)
+#define virNodeListDevices(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeListDevices, -1, __VA_ARGS__)
+#define virNodeNumOfDevices(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeNumOfDevices, -1, __VA_ARGS__)
+#define virNodeSetMemoryParameters(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeSetMemoryParameters, -1, __VA_ARGS__)
+#define virNodeSuspendForDuration(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virNodeSuspendForDuration, -1, __VA_ARGS__)
+#define virSecretDefineXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretDefineXML, NULL, __VA_ARGS__)
+#define virSecretFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretFree, -1, __VA_ARGS__)
+#define virSecretGetUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretGetUUID, -1, __VA_ARGS__)
+#define virSecretGetUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretGetUUIDString, -1, __VA_ARGS__)
+#define virSecretGetUsageID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretGetUsageID, NULL, __VA_ARGS__)
+#define virSecretGetUsageType(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretGetUsageType, -1, __VA_ARGS__)
+#define virSecretGetValue(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretGetValue, NULL, __VA_ARGS__)
+#define virSecretGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretGetXMLDesc, NULL, __VA_ARGS__)
+#define virSecretLookupByUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretLookupByUUID, NULL, __VA_ARGS__)
+#define virSecretLookupByUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretLookupByUUIDString, NULL, __VA_ARGS__)
+#define virSecretLookupByUsage(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretLookupByUsage, NULL, __VA_ARGS__)
+#define virSecretRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretRef, -1, __VA_ARGS__)
+#define virSecretSetValue(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretSetValue, -1, __VA_ARGS__)
+#define virSecretUndefine(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSecretUndefine, -1, __VA_ARGS__)
+#define virSetErrorFunc(...) LIBVIRT_DLOPEN_CALL_VOID(LIBVIRT_DLOPEN_LIB_LIBVIRT, virSetErrorFunc, __VA_ARGS__)
+#define virStoragePoolBuild(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolBuild, -1, __VA_ARGS__)
+#define virStoragePoolCreate(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolCreate, -1, __VA_ARGS__)
+#define virStoragePoolCreateXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolCreateXML, NULL, __VA_ARGS__)
+#define virStoragePoolDefineXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolDefineXML, NULL, __VA_ARGS__)
+#define virStoragePoolDelete(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolDelete, -1, __VA_ARGS__)
+#define virStoragePoolDestroy(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolDestroy, -1, __VA_ARGS__)
+#define virStoragePoolFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolFree, -1, __VA_ARGS__)
+#define virStoragePoolGetAutostart(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolGetAutostart, -1, __VA_ARGS__)
+#define virStoragePoolGetConnect(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolGetConnect, NULL, __VA_ARGS__)
+#define virStoragePoolGetInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolGetInfo, -1, __VA_ARGS__)
+#define virStoragePoolGetName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolGetName, NULL, __VA_ARGS__)
+#define virStoragePoolGetUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolGetUUID, -1, __VA_ARGS__)
+#define virStoragePoolGetUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolGetUUIDString, -1, __VA_ARGS__)
+#define virStoragePoolGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolGetXMLDesc, NULL, __VA_ARGS__)
+#define virStoragePoolIsActive(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolIsActive, -1, __VA_ARGS__)
+#define virStoragePoolIsPersistent(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolIsPersistent, -1, __VA_ARGS__)
+#define virStoragePoolListAllVolumes(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolListAllVolumes, -1, __VA_ARGS__)
+#define virStoragePoolListVolumes(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolListVolumes, -1, __VA_ARGS__)
+#define virStoragePoolLookupByName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolLookupByName, NULL, __VA_ARGS__)
+#define virStoragePoolLookupByTargetPath(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolLookupByTargetPath, NULL, __VA_ARGS__)
+#define virStoragePoolLookupByUUID(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolLookupByUUID, NULL, __VA_ARGS__)
+#define virStoragePoolLookupByUUIDString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolLookupByUUIDString, NULL, __VA_ARGS__)
+#define virStoragePoolLookupByVolume(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolLookupByVolume, NULL, __VA_ARGS__)
+#define virStoragePoolNumOfVolumes(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolNumOfVolumes, -1, __VA_ARGS__)
+#define virStoragePoolRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolRef, -1, __VA_ARGS__)
+#define virStoragePoolRefresh(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolRefresh, -1, __VA_ARGS__)
+#define virStoragePoolSetAutostart(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolSetAutostart, -1, __VA_ARGS__)
+#define virStoragePoolUndefine(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStoragePoolUndefine, -1, __VA_ARGS__)
+#define virStorageVolCreateXML(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolCreateXML, NULL, __VA_ARGS__)
+#define virStorageVolCreateXMLFrom(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolCreateXMLFrom, NULL, __VA_ARGS__)
+#define virStorageVolDelete(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolDelete, -1, __VA_ARGS__)
+#define virStorageVolDownload(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolDownload, -1, __VA_ARGS__)
+#define virStorageVolFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolFree, -1, __VA_ARGS__)
+#define virStorageVolGetInfo(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolGetInfo, -1, __VA_ARGS__)
+#define virStorageVolGetInfoFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolGetInfoFlags, -1, __VA_ARGS__)
+#define virStorageVolGetKey(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolGetKey, NULL, __VA_ARGS__)
+#define virStorageVolGetName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolGetName, NULL, __VA_ARGS__)
+#define virStorageVolGetPath(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolGetPath, NULL, __VA_ARGS__)
+#define virStorageVolGetXMLDesc(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolGetXMLDesc, NULL, __VA_ARGS__)
+#define virStorageVolLookupByKey(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolLookupByKey, NULL, __VA_ARGS__)
+#define virStorageVolLookupByName(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolLookupByName, NULL, __VA_ARGS__)
+#define virStorageVolLookupByPath(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolLookupByPath, NULL, __VA_ARGS__)
+#define virStorageVolRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolRef, -1, __VA_ARGS__)
+#define virStorageVolResize(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolResize, -1, __VA_ARGS__)
+#define virStorageVolUpload(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolUpload, -1, __VA_ARGS__)
+#define virStorageVolWipe(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolWipe, -1, __VA_ARGS__)
+#define virStorageVolWipePattern(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStorageVolWipePattern, -1, __VA_ARGS__)
+#define virStreamAbort(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamAbort, -1, __VA_ARGS__)
+#define virStreamEventAddCallback(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamEventAddCallback, -1, __VA_ARGS__)
+#define virStreamEventRemoveCallback(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamEventRemoveCallback, -1, __VA_ARGS__)
+#define virStreamEventUpdateCallback(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamEventUpdateCallback, -1, __VA_ARGS__)
+#define virStreamFinish(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamFinish, -1, __VA_ARGS__)
+#define virStreamFree(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamFree, -1, __VA_ARGS__)
+#define virStreamNew(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamNew, NULL, __VA_ARGS__)
+#define virStreamRecv(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamRecv, -1, __VA_ARGS__)
+#define virStreamRecvAll(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamRecvAll, -1, __VA_ARGS__)
+#define virStreamRecvFlags(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamRecvFlags, -1, __VA_ARGS__)
+#define virStreamRecvHole(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamRecvHole, -1, __VA_ARGS__)
+#define virStreamRef(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamRef, -1, __VA_ARGS__)
+#define virStreamSend(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamSend, -1, __VA_ARGS__)
+#define virStreamSendAll(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamSendAll, -1, __VA_ARGS__)
+#define virStreamSendHole(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamSendHole, -1, __VA_ARGS__)
+#define virStreamSparseRecvAll(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamSparseRecvAll, -1, __VA_ARGS__)
+#define virStreamSparseSendAll(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virStreamSparseSendAll, -1, __VA_ARGS__)
+#define virTypedParamsAddBoolean(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsAddBoolean, -1, __VA_ARGS__)
+#define virTypedParamsAddDouble(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsAddDouble, -1, __VA_ARGS__)
+#define virTypedParamsAddInt(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsAddInt, -1, __VA_ARGS__)
+#define virTypedParamsAddLLong(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsAddLLong, -1, __VA_ARGS__)
+#define virTypedParamsAddString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsAddString, -1, __VA_ARGS__)
+#define virTypedParamsAddUInt(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsAddUInt, -1, __VA_ARGS__)
+#define virTypedParamsAddULLong(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsAddULLong, -1, __VA_ARGS__)
+#define virTypedParamsFree(...) LIBVIRT_DLOPEN_CALL_VOID(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsFree, __VA_ARGS__)
+#define virTypedParamsGetBoolean(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsGetBoolean, -1, __VA_ARGS__)
+#define virTypedParamsGetDouble(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsGetDouble, -1, __VA_ARGS__)
+#define virTypedParamsGetInt(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsGetInt, -1, __VA_ARGS__)
+#define virTypedParamsGetLLong(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsGetLLong, -1, __VA_ARGS__)
+#define virTypedParamsGetString(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsGetString, -1, __VA_ARGS__)
+#define virTypedParamsGetUInt(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsGetUInt, -1, __VA_ARGS__)
+#define virTypedParamsGetULLong(...) LIBVIRT_DLOPEN_CALL(LIBVIRT_DLOPEN_LIB_LIBVIRT, virTypedParamsGetULLong, -1, __VA_ARGS__)
+
+#endif /* LIBVIRT_DLOPEN */
+
+#endif /* LIBVIRT_GO_LIBVIRT_DLOPEN_H__ */
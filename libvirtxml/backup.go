@@ -0,0 +1,83 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/xml"
+)
+
+// DomainBackup is the <domainbackup> document, as used by
+// Domain.BackupBegin and returned by Domain.BackupGetXMLDesc.
+type DomainBackup struct {
+	XMLName     xml.Name            `xml:"domainbackup"`
+	Mode        string              `xml:"mode,attr,omitempty"`
+	Incremental string              `xml:"incremental,omitempty"`
+	Server      *DomainBackupServer `xml:"server"`
+	Disks       *DomainBackupDisks  `xml:"disks"`
+	Extra       []AnyElement        `xml:",any"`
+	ExtraAttrs  []ExtraAttr         `xml:",any,attr"`
+}
+
+// DomainBackupServer is the NBD server used by a backup in "pull" mode.
+type DomainBackupServer struct {
+	Transport  string      `xml:"transport,attr,omitempty"`
+	Name       string      `xml:"name,attr,omitempty"`
+	Port       string      `xml:"port,attr,omitempty"`
+	Socket     string      `xml:"socket,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainBackupDisks struct {
+	Disks []DomainBackupDisk `xml:"disk"`
+}
+
+// DomainBackupDisk selects a disk to back up. In "push" mode the
+// Target is where the backup is written, while in "pull" mode the
+// Scratch file holds the data needed by the NBD client.
+type DomainBackupDisk struct {
+	Name        string                  `xml:"name,attr"`
+	Backup      string                  `xml:"backup,attr,omitempty"`
+	Type        string                  `xml:"type,attr,omitempty"`
+	ExportName  string                  `xml:"exportname,attr,omitempty"`
+	Incremental string                  `xml:"incremental,attr,omitempty"`
+	Driver      *DomainBackupDiskDriver `xml:"driver"`
+	Target      *DomainDiskSource       `xml:"target"`
+	Scratch     *DomainDiskSource       `xml:"scratch"`
+	Extra       []AnyElement            `xml:",any"`
+	ExtraAttrs  []ExtraAttr             `xml:",any,attr"`
+}
+
+type DomainBackupDiskDriver struct {
+	Type string `xml:"type,attr"`
+}
+
+func (b *DomainBackup) Marshal() (string, error) {
+	return marshal(b)
+}
+
+func (b *DomainBackup) Unmarshal(doc string) error {
+	return unmarshal(doc, b)
+}
@@ -0,0 +1,131 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// ExtraAttr holds an attribute without a dedicated field, so that it
+// is preserved when a parsed document is marshalled again. XML
+// namespace declarations are dropped when marshalling, since the
+// encoder adds its own as needed.
+type ExtraAttr xml.Attr
+
+func (a *ExtraAttr) UnmarshalXMLAttr(attr xml.Attr) error {
+	*a = ExtraAttr(attr)
+	return nil
+}
+
+func (a ExtraAttr) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if a.Name.Space == "xmlns" || (a.Name.Space == "" && a.Name.Local == "xmlns") {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr(a), nil
+}
+
+// AnyElement holds an element without a dedicated field, so that it
+// is preserved when a parsed document is marshalled again.
+type AnyElement struct {
+	XMLName  xml.Name
+	Attrs    []ExtraAttr  `xml:",any,attr"`
+	CharData string       `xml:",chardata"`
+	Children []AnyElement `xml:",any"`
+}
+
+func (a *AnyElement) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	a.XMLName = start.Name
+	for _, attr := range start.Attr {
+		a.Attrs = append(a.Attrs, ExtraAttr(attr))
+	}
+
+	var text strings.Builder
+	for {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			var child AnyElement
+			if err := child.UnmarshalXML(d, tok); err != nil {
+				return err
+			}
+			a.Children = append(a.Children, child)
+		case xml.CharData:
+			text.Write(tok)
+		case xml.EndElement:
+			// Drop the indentation between child elements
+			if len(a.Children) == 0 || strings.TrimSpace(text.String()) != "" {
+				a.CharData = text.String()
+			}
+			return nil
+		}
+	}
+}
+
+// Marker for elements which have no content, such as <readonly/>
+type Empty struct{}
+
+type DomainAlias struct {
+	Name string `xml:"name,attr"`
+}
+
+// DomainAddress is the address of a device on its bus. Which of the
+// attributes apply depends on the Type, eg "pci" uses Domain, Bus,
+// Slot and Function, while "drive" uses Controller, Bus, Target and
+// Unit. The values are kept as they appear in the XML, which is
+// usually hexadecimal for PCI addresses.
+type DomainAddress struct {
+	Type          string      `xml:"type,attr,omitempty"`
+	Domain        string      `xml:"domain,attr,omitempty"`
+	Bus           string      `xml:"bus,attr,omitempty"`
+	Slot          string      `xml:"slot,attr,omitempty"`
+	Function      string      `xml:"function,attr,omitempty"`
+	Multifunction string      `xml:"multifunction,attr,omitempty"`
+	Controller    string      `xml:"controller,attr,omitempty"`
+	Target        string      `xml:"target,attr,omitempty"`
+	Unit          string      `xml:"unit,attr,omitempty"`
+	Port          string      `xml:"port,attr,omitempty"`
+	ExtraAttrs    []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainDeviceBoot struct {
+	Order uint `xml:"order,attr"`
+}
+
+func marshal(v interface{}) (string, error) {
+	doc, err := xml.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(doc), nil
+}
+
+func unmarshal(doc string, v interface{}) error {
+	return xml.Unmarshal([]byte(doc), v)
+}
@@ -0,0 +1,55 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+// Package libvirtxml provides Go structs for the libvirt XML formats
+//
+// The domain, device, snapshot, checkpoint, backup and network
+// documents accepted and returned by the libvirt APIs are modelled as
+// Go structs, which are converted to and from XML with their Marshal
+// and Unmarshal methods. This avoids building XML documents from
+// string templates, and catches many mistakes at compile time.
+//
+// Only the commonly used parts of each schema have dedicated fields.
+// Everything else is kept in the Extra and ExtraAttrs fields of the
+// enclosing struct when a document is parsed, and written back out
+// when it is marshalled, so that a document can be loaded, changed
+// and saved without losing any information. Unknown elements are
+// written after the known ones, and XML namespace prefixes may be
+// renamed, neither of which changes the meaning of the document.
+//
+// The libvirt package builds on these types with methods such as
+// Connect.DefineDomain and Domain.AttachDeviceSpec:
+//
+//	dom := &libvirtxml.Domain{
+//	        Type: "kvm",
+//	        Name: "demo",
+//	        Memory: &libvirtxml.DomainMemory{Value: 1, Unit: "GiB"},
+//	        OS: &libvirtxml.DomainOS{
+//	                Type: &libvirtxml.DomainOSType{Type: "hvm"},
+//	        },
+//	}
+//	...
+//	domain, err := conn.DefineDomain(dom)
+package libvirtxml
@@ -0,0 +1,226 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/xml"
+)
+
+// Domain is the top level <domain> document, as used by
+// Connect.DomainDefineXML and returned by Domain.GetXMLDesc.
+type Domain struct {
+	XMLName       xml.Name             `xml:"domain"`
+	Type          string               `xml:"type,attr,omitempty"`
+	ID            *int                 `xml:"id,attr"`
+	Name          string               `xml:"name,omitempty"`
+	UUID          string               `xml:"uuid,omitempty"`
+	Title         string               `xml:"title,omitempty"`
+	Description   string               `xml:"description,omitempty"`
+	Metadata      *DomainMetadata      `xml:"metadata"`
+	MaximumMemory *DomainMaxMemory     `xml:"maxMemory"`
+	Memory        *DomainMemory        `xml:"memory"`
+	CurrentMemory *DomainCurrentMemory `xml:"currentMemory"`
+	VCPU          *DomainVCPU          `xml:"vcpu"`
+	OS            *DomainOS            `xml:"os"`
+	Features      *DomainFeatureList   `xml:"features"`
+	CPU           *DomainCPU           `xml:"cpu"`
+	Clock         *DomainClock         `xml:"clock"`
+	OnPoweroff    string               `xml:"on_poweroff,omitempty"`
+	OnReboot      string               `xml:"on_reboot,omitempty"`
+	OnCrash       string               `xml:"on_crash,omitempty"`
+	Devices       *DomainDeviceList    `xml:"devices"`
+	SecLabel      []DomainSecLabel     `xml:"seclabel"`
+	Extra         []AnyElement         `xml:",any"`
+	ExtraAttrs    []ExtraAttr          `xml:",any,attr"`
+}
+
+// DomainMetadata holds the custom metadata elements of applications
+// as raw XML, including their namespace declarations.
+type DomainMetadata struct {
+	XML string `xml:",innerxml"`
+}
+
+type DomainMaxMemory struct {
+	Value uint   `xml:",chardata"`
+	Unit  string `xml:"unit,attr,omitempty"`
+	Slots uint   `xml:"slots,attr,omitempty"`
+}
+
+type DomainMemory struct {
+	Value    uint   `xml:",chardata"`
+	Unit     string `xml:"unit,attr,omitempty"`
+	DumpCore string `xml:"dumpCore,attr,omitempty"`
+}
+
+type DomainCurrentMemory struct {
+	Value uint   `xml:",chardata"`
+	Unit  string `xml:"unit,attr,omitempty"`
+}
+
+type DomainVCPU struct {
+	Value     uint   `xml:",chardata"`
+	Placement string `xml:"placement,attr,omitempty"`
+	CPUSet    string `xml:"cpuset,attr,omitempty"`
+	Current   uint   `xml:"current,attr,omitempty"`
+}
+
+type DomainOS struct {
+	Firmware    string             `xml:"firmware,attr,omitempty"`
+	Type        *DomainOSType      `xml:"type"`
+	Loader      *DomainLoader      `xml:"loader"`
+	NVRam       *DomainNVRam       `xml:"nvram"`
+	Kernel      string             `xml:"kernel,omitempty"`
+	Initrd      string             `xml:"initrd,omitempty"`
+	Cmdline     string             `xml:"cmdline,omitempty"`
+	DTB         string             `xml:"dtb,omitempty"`
+	Init        string             `xml:"init,omitempty"`
+	InitArgs    []string           `xml:"initarg"`
+	BootDevices []DomainBootDevice `xml:"boot"`
+	BootMenu    *DomainBootMenu    `xml:"bootmenu"`
+	Extra       []AnyElement       `xml:",any"`
+	ExtraAttrs  []ExtraAttr        `xml:",any,attr"`
+}
+
+type DomainOSType struct {
+	Arch    string `xml:"arch,attr,omitempty"`
+	Machine string `xml:"machine,attr,omitempty"`
+	Type    string `xml:",chardata"`
+}
+
+type DomainLoader struct {
+	Path       string      `xml:",chardata"`
+	Readonly   string      `xml:"readonly,attr,omitempty"`
+	Secure     string      `xml:"secure,attr,omitempty"`
+	Type       string      `xml:"type,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainNVRam struct {
+	NVRam      string      `xml:",chardata"`
+	Template   string      `xml:"template,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainBootDevice struct {
+	Dev string `xml:"dev,attr"`
+}
+
+type DomainBootMenu struct {
+	Enable  string `xml:"enable,attr,omitempty"`
+	Timeout string `xml:"timeout,attr,omitempty"`
+}
+
+// DomainFeatureList has fields for the features which are commonly
+// enabled. Hypervisor specific features such as <hyperv> or <kvm> are
+// kept in Extra.
+type DomainFeatureList struct {
+	PAE        *Empty              `xml:"pae"`
+	ACPI       *Empty              `xml:"acpi"`
+	APIC       *DomainFeatureAPIC  `xml:"apic"`
+	HAP        *DomainFeatureState `xml:"hap"`
+	VMCoreInfo *DomainFeatureState `xml:"vmcoreinfo"`
+	SMM        *DomainFeatureState `xml:"smm"`
+	Extra      []AnyElement        `xml:",any"`
+}
+
+type DomainFeatureAPIC struct {
+	EOI string `xml:"eoi,attr,omitempty"`
+}
+
+// DomainFeatureState is used for features which may be explicitly
+// turned on or off with the "state" attribute.
+type DomainFeatureState struct {
+	State      string      `xml:"state,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainCPU struct {
+	Mode       string             `xml:"mode,attr,omitempty"`
+	Match      string             `xml:"match,attr,omitempty"`
+	Check      string             `xml:"check,attr,omitempty"`
+	Migratable string             `xml:"migratable,attr,omitempty"`
+	Model      *DomainCPUModel    `xml:"model"`
+	Vendor     string             `xml:"vendor,omitempty"`
+	Topology   *DomainCPUTopology `xml:"topology"`
+	Features   []DomainCPUFeature `xml:"feature"`
+	Extra      []AnyElement       `xml:",any"`
+	ExtraAttrs []ExtraAttr        `xml:",any,attr"`
+}
+
+type DomainCPUModel struct {
+	Fallback string `xml:"fallback,attr,omitempty"`
+	VendorID string `xml:"vendor_id,attr,omitempty"`
+	Value    string `xml:",chardata"`
+}
+
+type DomainCPUTopology struct {
+	Sockets int `xml:"sockets,attr,omitempty"`
+	Dies    int `xml:"dies,attr,omitempty"`
+	Cores   int `xml:"cores,attr,omitempty"`
+	Threads int `xml:"threads,attr,omitempty"`
+}
+
+type DomainCPUFeature struct {
+	Policy string `xml:"policy,attr,omitempty"`
+	Name   string `xml:"name,attr"`
+}
+
+type DomainClock struct {
+	Offset     string        `xml:"offset,attr,omitempty"`
+	Basis      string        `xml:"basis,attr,omitempty"`
+	Adjustment string        `xml:"adjustment,attr,omitempty"`
+	TimeZone   string        `xml:"timezone,attr,omitempty"`
+	Timers     []DomainTimer `xml:"timer"`
+	ExtraAttrs []ExtraAttr   `xml:",any,attr"`
+}
+
+type DomainTimer struct {
+	Name       string       `xml:"name,attr"`
+	Track      string       `xml:"track,attr,omitempty"`
+	TickPolicy string       `xml:"tickpolicy,attr,omitempty"`
+	Present    string       `xml:"present,attr,omitempty"`
+	Extra      []AnyElement `xml:",any"`
+	ExtraAttrs []ExtraAttr  `xml:",any,attr"`
+}
+
+type DomainSecLabel struct {
+	Type       string       `xml:"type,attr,omitempty"`
+	Model      string       `xml:"model,attr,omitempty"`
+	Relabel    string       `xml:"relabel,attr,omitempty"`
+	Label      string       `xml:"label,omitempty"`
+	ImageLabel string       `xml:"imagelabel,omitempty"`
+	BaseLabel  string       `xml:"baselabel,omitempty"`
+	Extra      []AnyElement `xml:",any"`
+	ExtraAttrs []ExtraAttr  `xml:",any,attr"`
+}
+
+func (d *Domain) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *Domain) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
@@ -0,0 +1,528 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/xml"
+)
+
+// DomainDevice is implemented by each of the device types, which can
+// be passed to the device hotplug APIs as standalone documents.
+type DomainDevice interface {
+	Marshal() (string, error)
+	isDomainDevice()
+}
+
+// DomainDeviceList holds the <devices> of a domain. Devices of the
+// same type are kept in order, but on marshalling the device types
+// are written in the order of the fields here, rather than in their
+// original order.
+type DomainDeviceList struct {
+	Emulator    string             `xml:"emulator,omitempty"`
+	Disks       []DomainDisk       `xml:"disk"`
+	Controllers []DomainController `xml:"controller"`
+	Filesystems []DomainFilesystem `xml:"filesystem"`
+	Interfaces  []DomainInterface  `xml:"interface"`
+	Serials     []DomainSerial     `xml:"serial"`
+	Consoles    []DomainConsole    `xml:"console"`
+	Channels    []DomainChannel    `xml:"channel"`
+	Inputs      []DomainInput      `xml:"input"`
+	Graphics    []DomainGraphic    `xml:"graphics"`
+	Videos      []DomainVideo      `xml:"video"`
+	Hostdevs    []DomainHostdev    `xml:"hostdev"`
+	Watchdogs   []DomainWatchdog   `xml:"watchdog"`
+	RNGs        []DomainRNG        `xml:"rng"`
+	TPMs        []DomainTPM        `xml:"tpm"`
+	MemBalloon  *DomainMemBalloon  `xml:"memballoon"`
+	Extra       []AnyElement       `xml:",any"`
+}
+
+type DomainDisk struct {
+	XMLName    xml.Name          `xml:"disk"`
+	Type       string            `xml:"type,attr,omitempty"`
+	Device     string            `xml:"device,attr,omitempty"`
+	Driver     *DomainDiskDriver `xml:"driver"`
+	Source     *DomainDiskSource `xml:"source"`
+	Target     *DomainDiskTarget `xml:"target"`
+	Serial     string            `xml:"serial,omitempty"`
+	ReadOnly   *Empty            `xml:"readonly"`
+	Shareable  *Empty            `xml:"shareable"`
+	Boot       *DomainDeviceBoot `xml:"boot"`
+	Alias      *DomainAlias      `xml:"alias"`
+	Address    *DomainAddress    `xml:"address"`
+	Extra      []AnyElement      `xml:",any"`
+	ExtraAttrs []ExtraAttr       `xml:",any,attr"`
+}
+
+type DomainDiskDriver struct {
+	Name       string      `xml:"name,attr,omitempty"`
+	Type       string      `xml:"type,attr,omitempty"`
+	Cache      string      `xml:"cache,attr,omitempty"`
+	IO         string      `xml:"io,attr,omitempty"`
+	Discard    string      `xml:"discard,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+// DomainDiskSource is the source of a disk. Which attributes apply
+// depends on the disk type: File for "file", Dev for "block", Dir for
+// "dir", Pool and Volume for "volume", and Protocol, Name and Hosts
+// for "network".
+type DomainDiskSource struct {
+	File       string                 `xml:"file,attr,omitempty"`
+	Dev        string                 `xml:"dev,attr,omitempty"`
+	Dir        string                 `xml:"dir,attr,omitempty"`
+	Pool       string                 `xml:"pool,attr,omitempty"`
+	Volume     string                 `xml:"volume,attr,omitempty"`
+	Protocol   string                 `xml:"protocol,attr,omitempty"`
+	Name       string                 `xml:"name,attr,omitempty"`
+	Hosts      []DomainDiskSourceHost `xml:"host"`
+	Extra      []AnyElement           `xml:",any"`
+	ExtraAttrs []ExtraAttr            `xml:",any,attr"`
+}
+
+type DomainDiskSourceHost struct {
+	Transport string `xml:"transport,attr,omitempty"`
+	Name      string `xml:"name,attr,omitempty"`
+	Port      string `xml:"port,attr,omitempty"`
+	Socket    string `xml:"socket,attr,omitempty"`
+}
+
+type DomainDiskTarget struct {
+	Dev        string      `xml:"dev,attr"`
+	Bus        string      `xml:"bus,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainController struct {
+	XMLName    xml.Name       `xml:"controller"`
+	Type       string         `xml:"type,attr"`
+	Index      *uint          `xml:"index,attr"`
+	Model      string         `xml:"model,attr,omitempty"`
+	Alias      *DomainAlias   `xml:"alias"`
+	Address    *DomainAddress `xml:"address"`
+	Extra      []AnyElement   `xml:",any"`
+	ExtraAttrs []ExtraAttr    `xml:",any,attr"`
+}
+
+type DomainFilesystem struct {
+	XMLName    xml.Name                `xml:"filesystem"`
+	Type       string                  `xml:"type,attr,omitempty"`
+	AccessMode string                  `xml:"accessmode,attr,omitempty"`
+	Driver     *DomainFilesystemDriver `xml:"driver"`
+	Source     *DomainFilesystemSource `xml:"source"`
+	Target     *DomainFilesystemTarget `xml:"target"`
+	ReadOnly   *Empty                  `xml:"readonly"`
+	Alias      *DomainAlias            `xml:"alias"`
+	Address    *DomainAddress          `xml:"address"`
+	Extra      []AnyElement            `xml:",any"`
+	ExtraAttrs []ExtraAttr             `xml:",any,attr"`
+}
+
+type DomainFilesystemDriver struct {
+	Type       string      `xml:"type,attr,omitempty"`
+	Format     string      `xml:"format,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainFilesystemSource struct {
+	Dir        string      `xml:"dir,attr,omitempty"`
+	File       string      `xml:"file,attr,omitempty"`
+	Dev        string      `xml:"dev,attr,omitempty"`
+	Name       string      `xml:"name,attr,omitempty"`
+	Socket     string      `xml:"socket,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainFilesystemTarget struct {
+	Dir string `xml:"dir,attr"`
+}
+
+type DomainInterface struct {
+	XMLName    xml.Name               `xml:"interface"`
+	Type       string                 `xml:"type,attr,omitempty"`
+	MAC        *DomainInterfaceMAC    `xml:"mac"`
+	Source     *DomainInterfaceSource `xml:"source"`
+	Target     *DomainInterfaceTarget `xml:"target"`
+	Model      *DomainInterfaceModel  `xml:"model"`
+	Boot       *DomainDeviceBoot      `xml:"boot"`
+	Alias      *DomainAlias           `xml:"alias"`
+	Address    *DomainAddress         `xml:"address"`
+	Extra      []AnyElement           `xml:",any"`
+	ExtraAttrs []ExtraAttr            `xml:",any,attr"`
+}
+
+type DomainInterfaceMAC struct {
+	Address string `xml:"address,attr"`
+}
+
+// DomainInterfaceSource is the source of an interface. Which
+// attributes apply depends on the interface type: Network and
+// PortGroup for "network", Bridge for "bridge", and Dev and Mode for
+// "direct".
+type DomainInterfaceSource struct {
+	Network    string       `xml:"network,attr,omitempty"`
+	PortGroup  string       `xml:"portgroup,attr,omitempty"`
+	Bridge     string       `xml:"bridge,attr,omitempty"`
+	Dev        string       `xml:"dev,attr,omitempty"`
+	Mode       string       `xml:"mode,attr,omitempty"`
+	Extra      []AnyElement `xml:",any"`
+	ExtraAttrs []ExtraAttr  `xml:",any,attr"`
+}
+
+type DomainInterfaceTarget struct {
+	Dev        string      `xml:"dev,attr"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainInterfaceModel struct {
+	Type string `xml:"type,attr"`
+}
+
+// DomainChardev has the fields shared by serial ports, consoles and
+// channels.
+type DomainChardev struct {
+	Type       string               `xml:"type,attr,omitempty"`
+	Source     *DomainChardevSource `xml:"source"`
+	Target     *DomainChardevTarget `xml:"target"`
+	Alias      *DomainAlias         `xml:"alias"`
+	Address    *DomainAddress       `xml:"address"`
+	Extra      []AnyElement         `xml:",any"`
+	ExtraAttrs []ExtraAttr          `xml:",any,attr"`
+}
+
+type DomainChardevSource struct {
+	Mode       string      `xml:"mode,attr,omitempty"`
+	Path       string      `xml:"path,attr,omitempty"`
+	Host       string      `xml:"host,attr,omitempty"`
+	Service    string      `xml:"service,attr,omitempty"`
+	Channel    string      `xml:"channel,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainChardevTarget struct {
+	Type       string       `xml:"type,attr,omitempty"`
+	Name       string       `xml:"name,attr,omitempty"`
+	Port       *uint        `xml:"port,attr"`
+	State      string       `xml:"state,attr,omitempty"`
+	Extra      []AnyElement `xml:",any"`
+	ExtraAttrs []ExtraAttr  `xml:",any,attr"`
+}
+
+type DomainSerial struct {
+	XMLName xml.Name `xml:"serial"`
+	DomainChardev
+}
+
+type DomainConsole struct {
+	XMLName xml.Name `xml:"console"`
+	DomainChardev
+}
+
+type DomainChannel struct {
+	XMLName xml.Name `xml:"channel"`
+	DomainChardev
+}
+
+type DomainInput struct {
+	XMLName    xml.Name       `xml:"input"`
+	Type       string         `xml:"type,attr"`
+	Bus        string         `xml:"bus,attr,omitempty"`
+	Alias      *DomainAlias   `xml:"alias"`
+	Address    *DomainAddress `xml:"address"`
+	Extra      []AnyElement   `xml:",any"`
+	ExtraAttrs []ExtraAttr    `xml:",any,attr"`
+}
+
+type DomainGraphic struct {
+	XMLName    xml.Name                `xml:"graphics"`
+	Type       string                  `xml:"type,attr"`
+	Port       int                     `xml:"port,attr,omitempty"`
+	TLSPort    int                     `xml:"tlsPort,attr,omitempty"`
+	AutoPort   string                  `xml:"autoport,attr,omitempty"`
+	Listen     string                  `xml:"listen,attr,omitempty"`
+	Passwd     string                  `xml:"passwd,attr,omitempty"`
+	Keymap     string                  `xml:"keymap,attr,omitempty"`
+	Listeners  []DomainGraphicListener `xml:"listen"`
+	Extra      []AnyElement            `xml:",any"`
+	ExtraAttrs []ExtraAttr             `xml:",any,attr"`
+}
+
+type DomainGraphicListener struct {
+	Type       string      `xml:"type,attr"`
+	Address    string      `xml:"address,attr,omitempty"`
+	Network    string      `xml:"network,attr,omitempty"`
+	Socket     string      `xml:"socket,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainVideo struct {
+	XMLName    xml.Name          `xml:"video"`
+	Model      *DomainVideoModel `xml:"model"`
+	Alias      *DomainAlias      `xml:"alias"`
+	Address    *DomainAddress    `xml:"address"`
+	Extra      []AnyElement      `xml:",any"`
+	ExtraAttrs []ExtraAttr       `xml:",any,attr"`
+}
+
+type DomainVideoModel struct {
+	Type       string       `xml:"type,attr"`
+	VRam       uint         `xml:"vram,attr,omitempty"`
+	Heads      uint         `xml:"heads,attr,omitempty"`
+	Primary    string       `xml:"primary,attr,omitempty"`
+	Extra      []AnyElement `xml:",any"`
+	ExtraAttrs []ExtraAttr  `xml:",any,attr"`
+}
+
+type DomainHostdev struct {
+	XMLName    xml.Name             `xml:"hostdev"`
+	Mode       string               `xml:"mode,attr,omitempty"`
+	Type       string               `xml:"type,attr,omitempty"`
+	Managed    string               `xml:"managed,attr,omitempty"`
+	Source     *DomainHostdevSource `xml:"source"`
+	Boot       *DomainDeviceBoot    `xml:"boot"`
+	Alias      *DomainAlias         `xml:"alias"`
+	Address    *DomainAddress       `xml:"address"`
+	Extra      []AnyElement         `xml:",any"`
+	ExtraAttrs []ExtraAttr          `xml:",any,attr"`
+}
+
+// DomainHostdevSource identifies the host device, by its Address for
+// PCI and SCSI devices, or by Vendor and Product for USB devices.
+type DomainHostdevSource struct {
+	Address    *DomainAddress   `xml:"address"`
+	Vendor     *DomainHostdevID `xml:"vendor"`
+	Product    *DomainHostdevID `xml:"product"`
+	Extra      []AnyElement     `xml:",any"`
+	ExtraAttrs []ExtraAttr      `xml:",any,attr"`
+}
+
+type DomainHostdevID struct {
+	ID string `xml:"id,attr"`
+}
+
+type DomainWatchdog struct {
+	XMLName    xml.Name       `xml:"watchdog"`
+	Model      string         `xml:"model,attr"`
+	Action     string         `xml:"action,attr,omitempty"`
+	Alias      *DomainAlias   `xml:"alias"`
+	Address    *DomainAddress `xml:"address"`
+	Extra      []AnyElement   `xml:",any"`
+	ExtraAttrs []ExtraAttr    `xml:",any,attr"`
+}
+
+type DomainRNG struct {
+	XMLName    xml.Name          `xml:"rng"`
+	Model      string            `xml:"model,attr"`
+	Backend    *DomainRNGBackend `xml:"backend"`
+	Alias      *DomainAlias      `xml:"alias"`
+	Address    *DomainAddress    `xml:"address"`
+	Extra      []AnyElement      `xml:",any"`
+	ExtraAttrs []ExtraAttr       `xml:",any,attr"`
+}
+
+type DomainRNGBackend struct {
+	Model      string       `xml:"model,attr"`
+	Device     string       `xml:",chardata"`
+	Extra      []AnyElement `xml:",any"`
+	ExtraAttrs []ExtraAttr  `xml:",any,attr"`
+}
+
+type DomainTPM struct {
+	XMLName    xml.Name          `xml:"tpm"`
+	Model      string            `xml:"model,attr,omitempty"`
+	Backend    *DomainTPMBackend `xml:"backend"`
+	Alias      *DomainAlias      `xml:"alias"`
+	Address    *DomainAddress    `xml:"address"`
+	Extra      []AnyElement      `xml:",any"`
+	ExtraAttrs []ExtraAttr       `xml:",any,attr"`
+}
+
+type DomainTPMBackend struct {
+	Type       string       `xml:"type,attr"`
+	Version    string       `xml:"version,attr,omitempty"`
+	Extra      []AnyElement `xml:",any"`
+	ExtraAttrs []ExtraAttr  `xml:",any,attr"`
+}
+
+type DomainMemBalloon struct {
+	XMLName     xml.Name       `xml:"memballoon"`
+	Model       string         `xml:"model,attr"`
+	AutoDeflate string         `xml:"autodeflate,attr,omitempty"`
+	Alias       *DomainAlias   `xml:"alias"`
+	Address     *DomainAddress `xml:"address"`
+	Extra       []AnyElement   `xml:",any"`
+	ExtraAttrs  []ExtraAttr    `xml:",any,attr"`
+}
+
+func (d *DomainDisk) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainDisk) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainDisk) isDomainDevice() {}
+
+func (d *DomainController) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainController) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainController) isDomainDevice() {}
+
+func (d *DomainFilesystem) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainFilesystem) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainFilesystem) isDomainDevice() {}
+
+func (d *DomainInterface) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainInterface) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainInterface) isDomainDevice() {}
+
+func (d *DomainSerial) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainSerial) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainSerial) isDomainDevice() {}
+
+func (d *DomainConsole) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainConsole) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainConsole) isDomainDevice() {}
+
+func (d *DomainChannel) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainChannel) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainChannel) isDomainDevice() {}
+
+func (d *DomainInput) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainInput) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainInput) isDomainDevice() {}
+
+func (d *DomainGraphic) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainGraphic) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainGraphic) isDomainDevice() {}
+
+func (d *DomainVideo) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainVideo) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainVideo) isDomainDevice() {}
+
+func (d *DomainHostdev) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainHostdev) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainHostdev) isDomainDevice() {}
+
+func (d *DomainWatchdog) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainWatchdog) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainWatchdog) isDomainDevice() {}
+
+func (d *DomainRNG) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainRNG) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainRNG) isDomainDevice() {}
+
+func (d *DomainTPM) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainTPM) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainTPM) isDomainDevice() {}
+
+func (d *DomainMemBalloon) Marshal() (string, error) {
+	return marshal(d)
+}
+
+func (d *DomainMemBalloon) Unmarshal(doc string) error {
+	return unmarshal(doc, d)
+}
+
+func (d *DomainMemBalloon) isDomainDevice() {}
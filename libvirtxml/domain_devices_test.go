@@ -0,0 +1,101 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"testing"
+)
+
+func TestDomainDeviceMarshal(t *testing.T) {
+	devices := []struct {
+		dev    DomainDevice
+		expect string
+	}{
+		{
+			&DomainDisk{
+				Type:     "file",
+				Device:   "cdrom",
+				Source:   &DomainDiskSource{File: "/tmp/demo.iso"},
+				Target:   &DomainDiskTarget{Dev: "sdb", Bus: "scsi"},
+				ReadOnly: &Empty{},
+			},
+			`<disk type="file" device="cdrom">
+  <source file="/tmp/demo.iso"></source>
+  <target dev="sdb" bus="scsi"></target>
+  <readonly></readonly>
+</disk>`,
+		},
+		{
+			&DomainInterface{
+				Type:   "bridge",
+				Source: &DomainInterfaceSource{Bridge: "br0"},
+			},
+			`<interface type="bridge">
+  <source bridge="br0"></source>
+</interface>`,
+		},
+		{
+			&DomainChannel{
+				DomainChardev: DomainChardev{
+					Type:   "unix",
+					Target: &DomainChardevTarget{Type: "virtio", Name: "org.qemu.guest_agent.0"},
+				},
+			},
+			`<channel type="unix">
+  <target type="virtio" name="org.qemu.guest_agent.0"></target>
+</channel>`,
+		},
+	}
+
+	for _, test := range devices {
+		doc, err := test.dev.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if doc != test.expect {
+			t.Errorf("Unexpected document:\n%s\nexpected:\n%s", doc, test.expect)
+		}
+	}
+}
+
+func TestDomainDeviceUnmarshal(t *testing.T) {
+	hostdev := &DomainHostdev{}
+	err := hostdev.Unmarshal(`<hostdev mode="subsystem" type="pci" managed="yes">
+  <source>
+    <address domain="0x0000" bus="0x06" slot="0x02" function="0x0"/>
+  </source>
+  <rom bar="off"/>
+</hostdev>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hostdev.Source == nil || hostdev.Source.Address == nil || hostdev.Source.Address.Bus != "0x06" {
+		t.Fatalf("Unexpected source %v", hostdev.Source)
+	}
+	if len(hostdev.Extra) != 1 || hostdev.Extra[0].XMLName.Local != "rom" {
+		t.Fatalf("Expected <rom> in extra elements, got %v", hostdev.Extra)
+	}
+}
@@ -0,0 +1,180 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+const testDomainXML = `<domain type="kvm" xmlns:qemu="http://libvirt.org/schemas/domain/qemu/1.0">
+  <name>demo</name>
+  <uuid>4dea22b3-1d52-d8f3-2516-782e98ab3fa0</uuid>
+  <metadata>
+    <app:data xmlns:app="http://example.org/app/1.0"><app:owner>alice</app:owner></app:data>
+  </metadata>
+  <memory unit="KiB">1048576</memory>
+  <vcpu placement="static">2</vcpu>
+  <os>
+    <type arch="x86_64" machine="q35">hvm</type>
+    <boot dev="hd"></boot>
+  </os>
+  <features>
+    <acpi></acpi>
+    <hyperv mode="custom">
+      <relaxed state="on"></relaxed>
+    </hyperv>
+  </features>
+  <devices>
+    <emulator>/usr/bin/qemu-system-x86_64</emulator>
+    <disk type="file" device="disk">
+      <driver name="qemu" type="qcow2"></driver>
+      <source file="/var/lib/libvirt/images/demo.qcow2"></source>
+      <target dev="vda" bus="virtio"></target>
+      <iotune>
+        <total_bytes_sec>10000000</total_bytes_sec>
+      </iotune>
+    </disk>
+    <interface type="network">
+      <mac address="52:54:00:12:34:56"></mac>
+      <source network="default"></source>
+      <model type="virtio"></model>
+    </interface>
+    <console type="pty">
+      <target type="serial" port="0"></target>
+    </console>
+    <panic model="isa"></panic>
+  </devices>
+  <qemu:commandline>
+    <qemu:arg value="-newarg"></qemu:arg>
+  </qemu:commandline>
+</domain>`
+
+func TestDomainUnmarshal(t *testing.T) {
+	dom := &Domain{}
+	if err := dom.Unmarshal(testDomainXML); err != nil {
+		t.Fatal(err)
+	}
+
+	if dom.Name != "demo" || dom.Type != "kvm" {
+		t.Fatalf("Unexpected name/type %q/%q", dom.Name, dom.Type)
+	}
+	if dom.Memory == nil || dom.Memory.Value != 1048576 || dom.Memory.Unit != "KiB" {
+		t.Fatalf("Unexpected memory %v", dom.Memory)
+	}
+	if dom.OS == nil || dom.OS.Type == nil || dom.OS.Type.Type != "hvm" {
+		t.Fatalf("Unexpected OS %v", dom.OS)
+	}
+	if dom.Devices == nil || len(dom.Devices.Disks) != 1 {
+		t.Fatal("Expected a single disk")
+	}
+	disk := dom.Devices.Disks[0]
+	if disk.Source == nil || disk.Source.File != "/var/lib/libvirt/images/demo.qcow2" {
+		t.Fatalf("Unexpected disk source %v", disk.Source)
+	}
+	if len(disk.Extra) != 1 || disk.Extra[0].XMLName.Local != "iotune" {
+		t.Fatalf("Expected <iotune> in disk extra elements, got %v", disk.Extra)
+	}
+	if len(dom.Devices.Extra) != 1 || dom.Devices.Extra[0].XMLName.Local != "panic" {
+		t.Fatalf("Expected <panic> in device extra elements, got %v", dom.Devices.Extra)
+	}
+	if len(dom.Extra) != 1 || dom.Extra[0].XMLName.Local != "commandline" {
+		t.Fatalf("Expected <qemu:commandline> in extra elements, got %v", dom.Extra)
+	}
+}
+
+func TestDomainRoundTrip(t *testing.T) {
+	dom := &Domain{}
+	if err := dom.Unmarshal(testDomainXML); err != nil {
+		t.Fatal(err)
+	}
+	doc, err := dom.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		"<total_bytes_sec>10000000</total_bytes_sec>",
+		`<panic model="isa">`,
+		`<relaxed state="on">`,
+		`value="-newarg"`,
+		"http://libvirt.org/schemas/domain/qemu/1.0",
+		"http://example.org/app/1.0",
+		"alice",
+	} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("Expected %q in marshalled document:\n%s", want, doc)
+		}
+	}
+
+	// A second pass must give the same document
+	again := &Domain{}
+	if err := again.Unmarshal(doc); err != nil {
+		t.Fatalf("Cannot parse marshalled document: %s\n%s", err, doc)
+	}
+	doc2, err := again.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if doc != doc2 {
+		t.Fatalf("Document changed on second round trip:\n%s\n%s", doc, doc2)
+	}
+}
+
+func TestDomainBuild(t *testing.T) {
+	index := uint(0)
+	dom := &Domain{
+		Type:   "kvm",
+		Name:   "demo",
+		Memory: &DomainMemory{Value: 1, Unit: "GiB"},
+		OS: &DomainOS{
+			Type: &DomainOSType{Type: "hvm"},
+		},
+		Devices: &DomainDeviceList{
+			Controllers: []DomainController{
+				{Type: "usb", Index: &index, Model: "none"},
+			},
+		},
+	}
+	doc, err := dom.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := `<domain type="kvm">
+  <name>demo</name>
+  <memory unit="GiB">1</memory>
+  <os>
+    <type>hvm</type>
+  </os>
+  <devices>
+    <controller type="usb" index="0" model="none"></controller>
+  </devices>
+</domain>`
+	if doc != expect {
+		t.Fatalf("Unexpected document:\n%s", doc)
+	}
+}
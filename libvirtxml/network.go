@@ -0,0 +1,199 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/xml"
+)
+
+// Network is the <network> document, as used by Connect.NetworkDefineXML
+// and returned by Network.GetXMLDesc.
+type Network struct {
+	XMLName    xml.Name           `xml:"network"`
+	Name       string             `xml:"name,omitempty"`
+	UUID       string             `xml:"uuid,omitempty"`
+	Forward    *NetworkForward    `xml:"forward"`
+	Bridge     *NetworkBridge     `xml:"bridge"`
+	MAC        *NetworkMAC        `xml:"mac"`
+	Domain     *NetworkDomain     `xml:"domain"`
+	DNS        *NetworkDNS        `xml:"dns"`
+	IPs        []NetworkIP        `xml:"ip"`
+	PortGroups []NetworkPortGroup `xml:"portgroup"`
+	Extra      []AnyElement       `xml:",any"`
+	ExtraAttrs []ExtraAttr        `xml:",any,attr"`
+}
+
+type NetworkForward struct {
+	Mode       string                    `xml:"mode,attr,omitempty"`
+	Dev        string                    `xml:"dev,attr,omitempty"`
+	Interfaces []NetworkForwardInterface `xml:"interface"`
+	Extra      []AnyElement              `xml:",any"`
+	ExtraAttrs []ExtraAttr               `xml:",any,attr"`
+}
+
+// NetworkForwardInterface is one of the host interfaces which a
+// network in a mode such as "bridge" or "passthrough" forwards to.
+type NetworkForwardInterface struct {
+	XMLName    xml.Name    `xml:"interface"`
+	Dev        string      `xml:"dev,attr"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type NetworkBridge struct {
+	Name       string      `xml:"name,attr,omitempty"`
+	STP        string      `xml:"stp,attr,omitempty"`
+	Delay      string      `xml:"delay,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type NetworkMAC struct {
+	Address string `xml:"address,attr"`
+}
+
+type NetworkDomain struct {
+	Name       string      `xml:"name,attr"`
+	LocalOnly  string      `xml:"localOnly,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type NetworkDNS struct {
+	Enable     string           `xml:"enable,attr,omitempty"`
+	TXTs       []NetworkDNSTXT  `xml:"txt"`
+	Hosts      []NetworkDNSHost `xml:"host"`
+	SRVs       []NetworkDNSSRV  `xml:"srv"`
+	Extra      []AnyElement     `xml:",any"`
+	ExtraAttrs []ExtraAttr      `xml:",any,attr"`
+}
+
+type NetworkDNSTXT struct {
+	XMLName xml.Name `xml:"txt"`
+	Name    string   `xml:"name,attr"`
+	Value   string   `xml:"value,attr,omitempty"`
+}
+
+type NetworkDNSHost struct {
+	XMLName    xml.Name    `xml:"host"`
+	IP         string      `xml:"ip,attr"`
+	Hostnames  []string    `xml:"hostname"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type NetworkDNSSRV struct {
+	XMLName    xml.Name    `xml:"srv"`
+	Service    string      `xml:"service,attr"`
+	Protocol   string      `xml:"protocol,attr"`
+	Domain     string      `xml:"domain,attr,omitempty"`
+	Target     string      `xml:"target,attr,omitempty"`
+	Port       uint        `xml:"port,attr,omitempty"`
+	Priority   uint        `xml:"priority,attr,omitempty"`
+	Weight     uint        `xml:"weight,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+// NetworkIP is an address of the host on the network, along with the
+// DHCP service offered on its subnet. Either Netmask or Prefix gives
+// the size of the subnet.
+type NetworkIP struct {
+	Family     string       `xml:"family,attr,omitempty"`
+	Address    string       `xml:"address,attr,omitempty"`
+	Netmask    string       `xml:"netmask,attr,omitempty"`
+	Prefix     uint         `xml:"prefix,attr,omitempty"`
+	DHCP       *NetworkDHCP `xml:"dhcp"`
+	Extra      []AnyElement `xml:",any"`
+	ExtraAttrs []ExtraAttr  `xml:",any,attr"`
+}
+
+type NetworkDHCP struct {
+	Ranges []NetworkDHCPRange `xml:"range"`
+	Hosts  []NetworkDHCPHost  `xml:"host"`
+	Extra  []AnyElement       `xml:",any"`
+}
+
+type NetworkDHCPRange struct {
+	XMLName    xml.Name     `xml:"range"`
+	Start      string       `xml:"start,attr"`
+	End        string       `xml:"end,attr"`
+	Extra      []AnyElement `xml:",any"`
+	ExtraAttrs []ExtraAttr  `xml:",any,attr"`
+}
+
+// NetworkDHCPHost is a static DHCP lease. IPv4 hosts are matched by
+// MAC, and IPv6 hosts by ID, which is their DUID.
+type NetworkDHCPHost struct {
+	XMLName    xml.Name     `xml:"host"`
+	ID         string       `xml:"id,attr,omitempty"`
+	MAC        string       `xml:"mac,attr,omitempty"`
+	Name       string       `xml:"name,attr,omitempty"`
+	IP         string       `xml:"ip,attr,omitempty"`
+	Extra      []AnyElement `xml:",any"`
+	ExtraAttrs []ExtraAttr  `xml:",any,attr"`
+}
+
+type NetworkPortGroup struct {
+	XMLName    xml.Name     `xml:"portgroup"`
+	Name       string       `xml:"name,attr"`
+	Default    string       `xml:"default,attr,omitempty"`
+	Extra      []AnyElement `xml:",any"`
+	ExtraAttrs []ExtraAttr  `xml:",any,attr"`
+}
+
+func (n *Network) Marshal() (string, error) {
+	return marshal(n)
+}
+
+func (n *Network) Unmarshal(doc string) error {
+	return unmarshal(doc, n)
+}
+
+// The sections changed by Network.Update are passed to it as
+// standalone documents
+func (i *NetworkForwardInterface) Marshal() (string, error) {
+	return marshal(i)
+}
+
+func (t *NetworkDNSTXT) Marshal() (string, error) {
+	return marshal(t)
+}
+
+func (h *NetworkDNSHost) Marshal() (string, error) {
+	return marshal(h)
+}
+
+func (s *NetworkDNSSRV) Marshal() (string, error) {
+	return marshal(s)
+}
+
+func (r *NetworkDHCPRange) Marshal() (string, error) {
+	return marshal(r)
+}
+
+func (h *NetworkDHCPHost) Marshal() (string, error) {
+	return marshal(h)
+}
+
+func (p *NetworkPortGroup) Marshal() (string, error) {
+	return marshal(p)
+}
@@ -0,0 +1,117 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNetworkRoundTrip(t *testing.T) {
+	network := &Network{}
+	err := network.Unmarshal(`<network>
+  <name>default</name>
+  <forward mode="nat">
+    <nat>
+      <port start="1024" end="65535"/>
+    </nat>
+  </forward>
+  <bridge name="virbr0" stp="on" delay="0"/>
+  <dns>
+    <host ip="192.168.122.2">
+      <hostname>gateway</hostname>
+    </host>
+  </dns>
+  <ip address="192.168.122.1" netmask="255.255.255.0">
+    <dhcp>
+      <range start="192.168.122.2" end="192.168.122.254">
+        <lease expiry="1" unit="hours"/>
+      </range>
+      <host mac="52:54:00:00:00:01" name="web" ip="192.168.122.10"/>
+    </dhcp>
+  </ip>
+</network>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(network.IPs) != 1 || network.IPs[0].DHCP == nil || len(network.IPs[0].DHCP.Hosts) != 1 {
+		t.Fatal("Expected one DHCP host")
+	}
+	if network.DNS == nil || len(network.DNS.Hosts) != 1 || network.DNS.Hosts[0].Hostnames[0] != "gateway" {
+		t.Fatalf("Unexpected DNS %v", network.DNS)
+	}
+
+	doc, err := network.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, expect := range []string{
+		`<port start="1024" end="65535"></port>`,
+		`<lease expiry="1" unit="hours"></lease>`,
+		`<host mac="52:54:00:00:00:01" name="web" ip="192.168.122.10"></host>`,
+	} {
+		if !strings.Contains(doc, expect) {
+			t.Fatalf("Missing %s in:\n%s", expect, doc)
+		}
+	}
+}
+
+func TestNetworkSectionMarshal(t *testing.T) {
+	sections := []struct {
+		section interface{ Marshal() (string, error) }
+		expect  string
+	}{
+		{
+			&NetworkDHCPHost{MAC: "52:54:00:00:00:01", IP: "192.168.122.10"},
+			`<host mac="52:54:00:00:00:01" ip="192.168.122.10"></host>`,
+		},
+		{
+			&NetworkDNSHost{IP: "192.168.122.10", Hostnames: []string{"web", "www"}},
+			`<host ip="192.168.122.10">
+  <hostname>web</hostname>
+  <hostname>www</hostname>
+</host>`,
+		},
+		{
+			&NetworkDNSSRV{Service: "ldap", Protocol: "tcp", Target: "ldap.example.com", Port: 389},
+			`<srv service="ldap" protocol="tcp" target="ldap.example.com" port="389"></srv>`,
+		},
+		{
+			&NetworkForwardInterface{Dev: "eth1"},
+			`<interface dev="eth1"></interface>`,
+		},
+	}
+
+	for _, test := range sections {
+		doc, err := test.section.Marshal()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if doc != test.expect {
+			t.Errorf("Unexpected document:\n%s\nexpected:\n%s", doc, test.expect)
+		}
+	}
+}
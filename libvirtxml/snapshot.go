@@ -0,0 +1,78 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"encoding/xml"
+)
+
+// DomainSnapshot is the <domainsnapshot> document, as used by
+// Domain.CreateSnapshotXML and returned by DomainSnapshot.GetXMLDesc.
+type DomainSnapshot struct {
+	XMLName      xml.Name              `xml:"domainsnapshot"`
+	Name         string                `xml:"name,omitempty"`
+	Description  string                `xml:"description,omitempty"`
+	State        string                `xml:"state,omitempty"`
+	CreationTime string                `xml:"creationTime,omitempty"`
+	Parent       *DomainSnapshotParent `xml:"parent"`
+	Memory       *DomainSnapshotMemory `xml:"memory"`
+	Disks        *DomainSnapshotDisks  `xml:"disks"`
+	Domain       *Domain               `xml:"domain"`
+	Extra        []AnyElement          `xml:",any"`
+	ExtraAttrs   []ExtraAttr           `xml:",any,attr"`
+}
+
+type DomainSnapshotParent struct {
+	Name string `xml:"name"`
+}
+
+type DomainSnapshotMemory struct {
+	Snapshot   string      `xml:"snapshot,attr,omitempty"`
+	File       string      `xml:"file,attr,omitempty"`
+	ExtraAttrs []ExtraAttr `xml:",any,attr"`
+}
+
+type DomainSnapshotDisks struct {
+	Disks []DomainSnapshotDisk `xml:"disk"`
+}
+
+type DomainSnapshotDisk struct {
+	Name       string            `xml:"name,attr"`
+	Snapshot   string            `xml:"snapshot,attr,omitempty"`
+	Type       string            `xml:"type,attr,omitempty"`
+	Driver     *DomainDiskDriver `xml:"driver"`
+	Source     *DomainDiskSource `xml:"source"`
+	Extra      []AnyElement      `xml:",any"`
+	ExtraAttrs []ExtraAttr       `xml:",any,attr"`
+}
+
+func (s *DomainSnapshot) Marshal() (string, error) {
+	return marshal(s)
+}
+
+func (s *DomainSnapshot) Unmarshal(doc string) error {
+	return unmarshal(doc, s)
+}
@@ -0,0 +1,123 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirtxml
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDomainSnapshotRoundTrip(t *testing.T) {
+	snap := &DomainSnapshot{}
+	err := snap.Unmarshal(`<domainsnapshot>
+  <name>before-upgrade</name>
+  <memory snapshot="no"/>
+  <disks>
+    <disk name="vda" snapshot="external">
+      <source file="/var/lib/libvirt/images/demo.before-upgrade"/>
+    </disk>
+    <disk name="vdb" snapshot="no"/>
+  </disks>
+  <domain type="kvm">
+    <name>demo</name>
+  </domain>
+</domainsnapshot>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if snap.Disks == nil || len(snap.Disks.Disks) != 2 {
+		t.Fatal("Expected two disks")
+	}
+	if snap.Domain == nil || snap.Domain.Name != "demo" {
+		t.Fatalf("Unexpected domain %v", snap.Domain)
+	}
+
+	doc, err := snap.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(doc, `<source file="/var/lib/libvirt/images/demo.before-upgrade">`) {
+		t.Fatalf("Missing disk source in:\n%s", doc)
+	}
+}
+
+func TestDomainCheckpointMarshal(t *testing.T) {
+	checkpoint := &DomainCheckpoint{
+		Name: "nightly",
+		Disks: &DomainCheckpointDisks{
+			Disks: []DomainCheckpointDisk{
+				{Name: "vda", Checkpoint: "bitmap"},
+			},
+		},
+	}
+	doc, err := checkpoint.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `<domaincheckpoint>
+  <name>nightly</name>
+  <disks>
+    <disk name="vda" checkpoint="bitmap"></disk>
+  </disks>
+</domaincheckpoint>`
+	if doc != expect {
+		t.Fatalf("Unexpected document:\n%s", doc)
+	}
+}
+
+func TestDomainBackupMarshal(t *testing.T) {
+	backup := &DomainBackup{
+		Mode:        "pull",
+		Incremental: "nightly",
+		Server:      &DomainBackupServer{Transport: "unix", Socket: "/tmp/backup.sock"},
+		Disks: &DomainBackupDisks{
+			Disks: []DomainBackupDisk{
+				{
+					Name:    "vda",
+					Backup:  "yes",
+					Type:    "file",
+					Scratch: &DomainDiskSource{File: "/tmp/vda.scratch"},
+				},
+			},
+		},
+	}
+	doc, err := backup.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := `<domainbackup mode="pull">
+  <incremental>nightly</incremental>
+  <server transport="unix" socket="/tmp/backup.sock"></server>
+  <disks>
+    <disk name="vda" backup="yes" type="file">
+      <scratch file="/tmp/vda.scratch"></scratch>
+    </disk>
+  </disks>
+</domainbackup>`
+	if doc != expect {
+		t.Fatalf("Unexpected document:\n%s", doc)
+	}
+}
@@ -0,0 +1,207 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+/*
+#cgo !libvirt_dlopen pkg-config: libvirt
+#include <libvirt/libvirt.h>
+#include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
+#include "error_wrapper.h"
+
+void ignoreErrorFunc(void *userData, virErrorPtr error);
+void loggerErrorFunc(void *userData, virErrorPtr error);
+*/
+import "C"
+
+import (
+	"reflect"
+	"sync"
+	"time"
+	"unsafe"
+)
+
+type logLevel int
+
+const (
+	logLevelDebug = logLevel(iota)
+	logLevelInfo
+	logLevelWarn
+	logLevelError
+)
+
+type logAttr struct {
+	key   string
+	value interface{}
+}
+
+type logSink interface {
+	enabled(level logLevel) bool
+	log(level logLevel, msg string, attrs []logAttr)
+}
+
+var logging = struct {
+	lock sync.RWMutex
+	sink logSink
+}{}
+
+func setLogSink(sink logSink) {
+	logging.lock.Lock()
+	logging.sink = sink
+	if sink != nil {
+		C.virSetErrorFuncWrapper(nil, (C.virErrorFunc)(C.loggerErrorFunc))
+	} else {
+		C.virSetErrorFuncWrapper(nil, (C.virErrorFunc)(C.ignoreErrorFunc))
+	}
+	logging.lock.Unlock()
+
+	updateCallHooks()
+}
+
+func getLogSink() logSink {
+	logging.lock.RLock()
+	defer logging.lock.RUnlock()
+	return logging.sink
+}
+
+//export loggerErrorFunc
+func loggerErrorFunc(userData unsafe.Pointer, cerr C.virErrorPtr) {
+	sink := getLogSink()
+	if sink == nil || cerr == nil {
+		return
+	}
+
+	level := logLevelInfo
+	switch ErrorLevel(cerr.level) {
+	case ERR_WARNING:
+		level = logLevelWarn
+	case ERR_ERROR:
+		level = logLevelError
+	}
+	if !sink.enabled(level) {
+		return
+	}
+
+	// Must not use makeError here, since that resets the error
+	// which is yet to be reported to the caller
+	sink.log(level, "libvirt error", []logAttr{
+		{"code", int(cerr.code)},
+		{"domain", int(cerr.domain)},
+		{"message", C.GoString(cerr.message)},
+	})
+}
+
+// Identifies the object a call is made on. This must be done
+// before the call, since the call may free the object.
+func callObjectAttrs(receiver interface{}) []logAttr {
+	var attrs []logAttr
+	add := func(key string, get func() (string, error)) {
+		if val, err := get(); err == nil {
+			attrs = append(attrs, logAttr{key, val})
+		}
+	}
+
+	switch obj := receiver.(type) {
+	case *Domain:
+		add("name", obj.doGetName)
+		add("uuid", obj.doGetUUIDString)
+	case *DomainCheckpoint:
+		add("name", obj.doGetName)
+	case *DomainSnapshot:
+		add("name", obj.doGetName)
+	case *Interface:
+		add("name", obj.doGetName)
+	case *Network:
+		add("name", obj.doGetName)
+		add("uuid", obj.doGetUUIDString)
+	case *NetworkPort:
+		add("uuid", obj.doGetUUIDString)
+	case *NodeDevice:
+		add("name", obj.doGetName)
+	case *NWFilter:
+		add("name", obj.doGetName)
+		add("uuid", obj.doGetUUIDString)
+	case *NWFilterBinding:
+		add("portdev", obj.doGetPortDev)
+		add("filter", obj.doGetFilterName)
+	case *Secret:
+		add("uuid", obj.doGetUUIDString)
+	case *StoragePool:
+		add("name", obj.doGetName)
+		add("uuid", obj.doGetUUIDString)
+	case *StorageVol:
+		add("name", obj.doGetName)
+		add("key", obj.doGetKey)
+	}
+	return attrs
+}
+
+func logCall(sink logSink, call *Call, fn func() error) error {
+	if !(sink.enabled(logLevelDebug) || sink.enabled(logLevelError)) {
+		return fn()
+	}
+
+	attrs := []logAttr{{"method", call.Method}}
+	attrs = append(attrs, callObjectAttrs(call.Receiver)...)
+	if flags, ok := callFlags(call); ok {
+		attrs = append(attrs, logAttr{"flags", flags})
+	}
+
+	start := time.Now()
+	err := fn()
+	attrs = append(attrs, logAttr{"duration", time.Since(start)})
+
+	level := logLevelDebug
+	if err != nil {
+		level = logLevelError
+		if virErr, ok := err.(Error); ok {
+			attrs = append(attrs,
+				logAttr{"error.code", int(virErr.Code)},
+				logAttr{"error.domain", int(virErr.Domain)})
+		} else {
+			attrs = append(attrs, logAttr{"error", err.Error()})
+		}
+	}
+	if sink.enabled(level) {
+		sink.log(level, "libvirt call", attrs)
+	}
+	return err
+}
+
+func callFlags(call *Call) (uint64, bool) {
+	flags := call.Arg("flags")
+	if flags == nil {
+		return 0, false
+	}
+	val := reflect.ValueOf(flags)
+	switch val.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return uint64(val.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return val.Uint(), true
+	}
+	return 0, false
+}
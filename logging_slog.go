@@ -0,0 +1,82 @@
+// +build go1.21
+
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+type slogSink struct {
+	handler slog.Handler
+}
+
+func (s slogSink) level(level logLevel) slog.Level {
+	switch level {
+	case logLevelDebug:
+		return slog.LevelDebug
+	case logLevelWarn:
+		return slog.LevelWarn
+	case logLevelError:
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+func (s slogSink) enabled(level logLevel) bool {
+	return s.handler.Enabled(context.Background(), s.level(level))
+}
+
+func (s slogSink) log(level logLevel, msg string, attrs []logAttr) {
+	record := slog.NewRecord(time.Now(), s.level(level), msg, 0)
+	for _, attr := range attrs {
+		record.AddAttrs(slog.Any(attr.key, attr.value))
+	}
+	s.handler.Handle(context.Background(), record)
+}
+
+// SetLogger sends a record to handler for every API call made through
+// this package, and for every error reported by libvirt.
+//
+// API calls are logged at slog.LevelDebug when they succeed, and at
+// slog.LevelError when they fail. Each record has the method name,
+// the name and/or UUID of the object the method was called on, any
+// flags, the duration of the call, and the code and domain of the
+// error if any. Errors reported by libvirt are logged at
+// slog.LevelWarn or slog.LevelError, according to their ErrorLevel.
+//
+// Passing a nil handler turns logging off again, which is the default.
+func SetLogger(handler slog.Handler) {
+	if handler == nil {
+		setLogSink(nil)
+	} else {
+		setLogSink(slogSink{handler})
+	}
+}
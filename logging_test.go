@@ -0,0 +1,152 @@
+// +build go1.21
+
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+)
+
+type testLogHandler struct {
+	lock    sync.Mutex
+	records []slog.Record
+}
+
+func (h *testLogHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return true
+}
+
+func (h *testLogHandler) Handle(ctx context.Context, record slog.Record) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	h.records = append(h.records, record)
+	return nil
+}
+
+func (h *testLogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return h
+}
+
+func (h *testLogHandler) WithGroup(name string) slog.Handler {
+	return h
+}
+
+func (h *testLogHandler) find(msg string, method string) (slog.Record, map[string]slog.Value) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	for _, record := range h.records {
+		if record.Message != msg {
+			continue
+		}
+		attrs := make(map[string]slog.Value)
+		record.Attrs(func(attr slog.Attr) bool {
+			attrs[attr.Key] = attr.Value
+			return true
+		})
+		if method == "" || attrs["method"].String() == method {
+			return record, attrs
+		}
+	}
+	return slog.Record{}, nil
+}
+
+func TestLoggerCalls(t *testing.T) {
+	handler := &testLogHandler{}
+	SetLogger(handler)
+	defer SetLogger(nil)
+
+	conn := buildTestConnection()
+	defer conn.Close()
+
+	dom, err := conn.LookupDomainByName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dom.Free()
+
+	if _, err := dom.GetXMLDesc(DOMAIN_XML_INACTIVE); err != nil {
+		t.Fatal(err)
+	}
+
+	record, attrs := handler.find("libvirt call", "Domain.GetXMLDesc")
+	if attrs == nil {
+		t.Fatal("Missing log record for Domain.GetXMLDesc")
+	}
+	if record.Level != slog.LevelDebug {
+		t.Errorf("Expected debug level, got %s", record.Level)
+	}
+	if attrs["name"].String() != "test" {
+		t.Errorf("Expected name 'test', got '%s'", attrs["name"])
+	}
+	if attrs["uuid"].String() != "6695eb01-f6a4-8304-79aa-97f2502e193f" {
+		t.Errorf("Unexpected UUID '%s'", attrs["uuid"])
+	}
+	if attrs["flags"].Uint64() != uint64(DOMAIN_XML_INACTIVE) {
+		t.Errorf("Unexpected flags %s", attrs["flags"])
+	}
+	if attrs["duration"].Kind() != slog.KindDuration {
+		t.Errorf("Missing call duration")
+	}
+}
+
+func TestLoggerErrors(t *testing.T) {
+	handler := &testLogHandler{}
+	SetLogger(handler)
+	defer SetLogger(nil)
+
+	conn := buildTestConnection()
+	defer conn.Close()
+
+	if _, err := conn.LookupDomainByName("doesnotexist"); err == nil {
+		t.Fatal("Expected lookup to fail")
+	}
+
+	record, attrs := handler.find("libvirt call", "Connect.LookupDomainByName")
+	if attrs == nil {
+		t.Fatal("Missing log record for Connect.LookupDomainByName")
+	}
+	if record.Level != slog.LevelError {
+		t.Errorf("Expected error level, got %s", record.Level)
+	}
+	if attrs["error.code"].Int64() != int64(ERR_NO_DOMAIN) {
+		t.Errorf("Unexpected error code %s", attrs["error.code"])
+	}
+
+	record, attrs = handler.find("libvirt error", "")
+	if attrs == nil {
+		t.Fatal("Missing log record for libvirt error")
+	}
+	if record.Level != slog.LevelError {
+		t.Errorf("Expected error level, got %s", record.Level)
+	}
+	if attrs["code"].Int64() != int64(ERR_NO_DOMAIN) {
+		t.Errorf("Unexpected error code %s", attrs["code"])
+	}
+}
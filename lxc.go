@@ -29,10 +29,10 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 // Can't rely on pkg-config for libvirt-lxc since it was not
 // installed until 2.6.0 onwards
-#cgo LDFLAGS: -lvirt-lxc
+#cgo !libvirt_dlopen LDFLAGS: -lvirt-lxc
 #include <stdlib.h>
 #include <string.h>
 #include "lxc_wrapper.h"
@@ -44,7 +44,7 @@ import (
 	"unsafe"
 )
 
-func (d *Domain) LxcOpenNamespace(flags uint32) ([]os.File, error) {
+func (d *Domain) doLxcOpenNamespace(flags uint32) ([]os.File, error) {
 	var cfdlist *C.int
 
 	var err C.virError
@@ -62,7 +62,7 @@ func (d *Domain) LxcOpenNamespace(flags uint32) ([]os.File, error) {
 	return fdlist, nil
 }
 
-func (d *Domain) LxcEnterNamespace(fdlist []os.File, flags uint32) ([]os.File, error) {
+func (d *Domain) doLxcEnterNamespace(fdlist []os.File, flags uint32) ([]os.File, error) {
 	var coldfdlist *C.int
 	var ncoldfdlist C.uint
 	cfdlist := make([]C.int, len(fdlist))
@@ -139,7 +139,7 @@ func DomainLxcEnterSecurityLabel(model *NodeSecurityModel, label *SecurityLabel,
 	return &oldlabel, nil
 }
 
-func (d *Domain) DomainLxcEnterCGroup(flags uint32) error {
+func (d *Domain) doDomainLxcEnterCGroup(flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 2000000 {
 		return makeNotImplementedError("virDomainLxcEnterCGroup")
 	}
@@ -27,10 +27,10 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 // Can't rely on pkg-config for libvirt-lxc since it was not
 // installed until 2.6.0 onwards
-#cgo LDFLAGS: -lvirt-lxc
+#cgo !libvirt_dlopen LDFLAGS: -lvirt-lxc
 #include <assert.h>
 #include "lxc_wrapper.h"
 
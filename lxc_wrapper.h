@@ -30,6 +30,7 @@
 #include <libvirt/libvirt.h>
 #include <libvirt/libvirt-lxc.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 
 
 int
@@ -0,0 +1,368 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"libvirt.org/libvirt-go/libvirtxml"
+)
+
+// NetworkConflictError is returned by Network.CheckDHCPHost and
+// Network.CheckDNSHost when the network already has an entry with
+// the same value for a field which must be unique.
+type NetworkConflictError struct {
+	// The XML attribute or element, such as "mac", "ip" or "hostname"
+	Field string
+	Value string
+}
+
+func (e *NetworkConflictError) Error() string {
+	return fmt.Sprintf("Network already has an entry with %s %s", e.Field, e.Value)
+}
+
+// A section of the network XML, as passed to Network.Update
+type networkSection interface {
+	Marshal() (string, error)
+}
+
+// Returns the flags which apply an update to both the running network
+// and its persistent definition, for those of them which exist
+func (n *Network) updateFlags() (NetworkUpdateFlags, error) {
+	flags := NETWORK_UPDATE_AFFECT_CURRENT
+	active, err := n.IsActive()
+	if err != nil {
+		return flags, err
+	}
+	if active {
+		flags |= NETWORK_UPDATE_AFFECT_LIVE
+	}
+	persistent, err := n.IsPersistent()
+	if err != nil {
+		return flags, err
+	}
+	if persistent {
+		flags |= NETWORK_UPDATE_AFFECT_CONFIG
+	}
+	return flags, nil
+}
+
+// Applies a change to a section of both the running network and its
+// persistent definition.
+func (n *Network) updateSection(cmd NetworkUpdateCommand, section NetworkUpdateSection, value networkSection) error {
+	xml, err := value.Marshal()
+	if err != nil {
+		return err
+	}
+	flags, err := n.updateFlags()
+	if err != nil {
+		return err
+	}
+	return n.Update(cmd, section, -1, xml, flags)
+}
+
+// Applies a change to a section within an <ip> element, such as a
+// DHCP host, of both the running network and its persistent
+// definition. The <ip> is the one whose subnet contains addr, as
+// otherwise libvirt picks the first IPv4 one.
+func (n *Network) updateIPSection(cmd NetworkUpdateCommand, section NetworkUpdateSection, addr string, value networkSection) error {
+	xml, err := value.Marshal()
+	if err != nil {
+		return err
+	}
+	flags, err := n.updateFlags()
+	if err != nil {
+		return err
+	}
+	specs, err := n.specsFor(flags)
+	if err != nil {
+		return err
+	}
+	indexes := make([]int, len(specs))
+	for i, spec := range specs {
+		indexes[i] = networkIPIndex(spec, addr)
+	}
+
+	if len(indexes) == 2 && indexes[0] != indexes[1] {
+		// The running network and its persistent definition list
+		// their <ip> elements differently
+		if err := n.Update(cmd, section, indexes[0], xml, NETWORK_UPDATE_AFFECT_LIVE); err != nil {
+			return err
+		}
+		return n.Update(cmd, section, indexes[1], xml, NETWORK_UPDATE_AFFECT_CONFIG)
+	}
+	index := -1
+	if len(indexes) != 0 {
+		index = indexes[0]
+	}
+	return n.Update(cmd, section, index, xml, flags)
+}
+
+// Returns the index of the <ip> element of spec whose subnet contains
+// addr, or -1 if there is none
+func networkIPIndex(spec *NetworkSpec, addr string) int {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return -1
+	}
+	for i := range spec.IPs {
+		if subnet := networkIPSubnet(&spec.IPs[i]); subnet != nil && subnet.Contains(ip) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Returns the subnet of an <ip> element, defaulting the prefix as
+// libvirt does
+func networkIPSubnet(ip *libvirtxml.NetworkIP) *net.IPNet {
+	addr := net.ParseIP(ip.Address)
+	if addr == nil {
+		return nil
+	}
+	bits := 128
+	if addr4 := addr.To4(); addr4 != nil {
+		addr = addr4
+		bits = 32
+	}
+
+	var mask net.IPMask
+	switch {
+	case ip.Prefix != 0:
+		mask = net.CIDRMask(int(ip.Prefix), bits)
+	case ip.Netmask != "":
+		if netmask := net.ParseIP(ip.Netmask).To4(); netmask != nil && bits == 32 {
+			mask = net.IPMask(netmask)
+		}
+	case bits == 32:
+		mask = addr.DefaultMask()
+	default:
+		mask = net.CIDRMask(64, bits)
+	}
+	if mask == nil {
+		return nil
+	}
+	return &net.IPNet{IP: addr.Mask(mask), Mask: mask}
+}
+
+// Returns the running network and its persistent definition, for
+// those of them which exist
+func (n *Network) updateSpecs() ([]*NetworkSpec, error) {
+	flags, err := n.updateFlags()
+	if err != nil {
+		return nil, err
+	}
+	return n.specsFor(flags)
+}
+
+// Returns the running network and its persistent definition, for
+// those of them which the update flags affect
+func (n *Network) specsFor(flags NetworkUpdateFlags) ([]*NetworkSpec, error) {
+	var specs []*NetworkSpec
+	if flags&NETWORK_UPDATE_AFFECT_LIVE != 0 {
+		spec, err := n.GetSpec(0)
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, spec)
+	}
+	if flags&NETWORK_UPDATE_AFFECT_CONFIG != 0 {
+		spec, err := n.GetSpec(NETWORK_XML_INACTIVE)
+		if err != nil {
+			return nil, err
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// Compares IP addresses, allowing for different ways of writing
+// IPv6 addresses
+func sameIP(a, b string) bool {
+	ipA, ipB := net.ParseIP(a), net.ParseIP(b)
+	if ipA == nil || ipB == nil {
+		return a == b
+	}
+	return ipA.Equal(ipB)
+}
+
+// AddDHCPHost adds a static DHCP lease to the <ip> element of the
+// network whose subnet contains the address of the host, or if it has
+// none, to the first IPv4 <ip>. The change is made to both the running
+// network and its persistent definition.
+//
+// libvirt rejects some duplicate hosts, but CheckDHCPHost can be used
+// first to report any host sharing the MAC address, ID, name or IP
+// address.
+func (n *Network) doAddDHCPHost(host *libvirtxml.NetworkDHCPHost) error {
+	return n.updateIPSection(NETWORK_UPDATE_COMMAND_ADD_LAST, NETWORK_SECTION_IP_DHCP_HOST, host.IP, host)
+}
+
+// RemoveDHCPHost removes a static DHCP lease from both the running
+// network and its persistent definition. The host is matched on the
+// fields which are set, so one returned by GetSpec can be passed. A
+// host without an address is looked for in the first IPv4 <ip>.
+func (n *Network) doRemoveDHCPHost(host *libvirtxml.NetworkDHCPHost) error {
+	return n.updateIPSection(NETWORK_UPDATE_COMMAND_DELETE, NETWORK_SECTION_IP_DHCP_HOST, host.IP, host)
+}
+
+// CheckDHCPHost returns a *NetworkConflictError if the running network
+// or its persistent definition already has a static DHCP lease with
+// the MAC address, ID, name or IP address of host.
+func (n *Network) doCheckDHCPHost(host *libvirtxml.NetworkDHCPHost) error {
+	specs, err := n.updateSpecs()
+	if err != nil {
+		return err
+	}
+	for _, spec := range specs {
+		for _, ip := range spec.IPs {
+			if ip.DHCP == nil {
+				continue
+			}
+			for _, existing := range ip.DHCP.Hosts {
+				switch {
+				case host.MAC != "" && strings.EqualFold(existing.MAC, host.MAC):
+					return &NetworkConflictError{Field: "mac", Value: host.MAC}
+				case host.ID != "" && strings.EqualFold(existing.ID, host.ID):
+					return &NetworkConflictError{Field: "id", Value: host.ID}
+				case host.Name != "" && existing.Name == host.Name:
+					return &NetworkConflictError{Field: "name", Value: host.Name}
+				case host.IP != "" && existing.IP != "" && sameIP(existing.IP, host.IP):
+					return &NetworkConflictError{Field: "ip", Value: host.IP}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ModifyDHCPRange replaces the DHCP range from with to, in both the
+// running network and its persistent definition. libvirt cannot
+// modify a range in place, so from is removed and to added. If to is
+// rejected, from is put back.
+func (n *Network) doModifyDHCPRange(from, to *libvirtxml.NetworkDHCPRange) error {
+	if err := n.updateIPSection(NETWORK_UPDATE_COMMAND_DELETE, NETWORK_SECTION_IP_DHCP_RANGE, from.Start, from); err != nil {
+		return err
+	}
+	if err := n.updateIPSection(NETWORK_UPDATE_COMMAND_ADD_LAST, NETWORK_SECTION_IP_DHCP_RANGE, to.Start, to); err != nil {
+		n.updateIPSection(NETWORK_UPDATE_COMMAND_ADD_LAST, NETWORK_SECTION_IP_DHCP_RANGE, from.Start, from)
+		return err
+	}
+	return nil
+}
+
+// AddDNSHost adds host names for an IP address to the DNS server of
+// both the running network and its persistent definition.
+func (n *Network) doAddDNSHost(host *libvirtxml.NetworkDNSHost) error {
+	return n.updateSection(NETWORK_UPDATE_COMMAND_ADD_LAST, NETWORK_SECTION_DNS_HOST, host)
+}
+
+// CheckDNSHost returns a *NetworkConflictError if the running network
+// or its persistent definition already has a DNS host entry with the
+// IP address of host, or with any of its host names.
+func (n *Network) doCheckDNSHost(host *libvirtxml.NetworkDNSHost) error {
+	specs, err := n.updateSpecs()
+	if err != nil {
+		return err
+	}
+	for _, spec := range specs {
+		if spec.DNS == nil {
+			continue
+		}
+		for _, existing := range spec.DNS.Hosts {
+			if sameIP(existing.IP, host.IP) {
+				return &NetworkConflictError{Field: "ip", Value: host.IP}
+			}
+			for _, name := range host.Hostnames {
+				for _, existingName := range existing.Hostnames {
+					if strings.EqualFold(existingName, name) {
+						return &NetworkConflictError{Field: "hostname", Value: name}
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// AddDNSSRV adds a service record to the DNS server of both the
+// running network and its persistent definition.
+func (n *Network) doAddDNSSRV(srv *libvirtxml.NetworkDNSSRV) error {
+	return n.updateSection(NETWORK_UPDATE_COMMAND_ADD_LAST, NETWORK_SECTION_DNS_SRV, srv)
+}
+
+// AddDNSTXT adds a text record to the DNS server of both the running
+// network and its persistent definition.
+func (n *Network) doAddDNSTXT(txt *libvirtxml.NetworkDNSTXT) error {
+	return n.updateSection(NETWORK_UPDATE_COMMAND_ADD_LAST, NETWORK_SECTION_DNS_TXT, txt)
+}
+
+// AddPortGroup adds a port group to both the running network and its
+// persistent definition.
+func (n *Network) doAddPortGroup(group *libvirtxml.NetworkPortGroup) error {
+	return n.updateSection(NETWORK_UPDATE_COMMAND_ADD_LAST, NETWORK_SECTION_PORTGROUP, group)
+}
+
+// SetForwardInterface makes the host interface dev the only one the
+// network forwards to, in both the running network and its persistent
+// definition. dev is added before the other interfaces are removed,
+// so the network always has one. libvirt refuses to remove an
+// interface which a guest is using.
+func (n *Network) doSetForwardInterface(dev string) error {
+	spec, err := n.GetSpec(0)
+	if err != nil {
+		return err
+	}
+	var existing []libvirtxml.NetworkForwardInterface
+	if spec.Forward != nil {
+		existing = spec.Forward.Interfaces
+	}
+
+	found := false
+	for _, iface := range existing {
+		if iface.Dev == dev {
+			found = true
+		}
+	}
+	if !found {
+		iface := &libvirtxml.NetworkForwardInterface{Dev: dev}
+		if err := n.updateSection(NETWORK_UPDATE_COMMAND_ADD_LAST, NETWORK_SECTION_FORWARD_INTERFACE, iface); err != nil {
+			return err
+		}
+	}
+	for _, iface := range existing {
+		if iface.Dev == dev {
+			continue
+		}
+		remove := &libvirtxml.NetworkForwardInterface{Dev: iface.Dev}
+		if err := n.updateSection(NETWORK_UPDATE_COMMAND_DELETE, NETWORK_SECTION_FORWARD_INTERFACE, remove); err != nil {
+			return err
+		}
+	}
+	return nil
+}
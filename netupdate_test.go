@@ -0,0 +1,192 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"testing"
+	"time"
+
+	"libvirt.org/libvirt-go/libvirtxml"
+)
+
+func TestNetworkDHCPHostUpdate(t *testing.T) {
+	conn := buildTestConnection()
+	net, err := conn.NetworkDefineXML(`<network>
+    <name>` + time.Now().String() + `</name>
+    <bridge name="testbr1"/>
+    <ip address="192.168.10.1" netmask="255.255.255.0">
+      <dhcp>
+        <range start="192.168.10.100" end="192.168.10.200"/>
+      </dhcp>
+    </ip>
+    </network>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		net.Undefine()
+		net.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	host := &libvirtxml.NetworkDHCPHost{MAC: "52:54:00:00:00:10", Name: "web", IP: "192.168.10.10"}
+	if err := net.CheckDHCPHost(host); err != nil {
+		t.Fatal(err)
+	}
+	if err := net.AddDHCPHost(host); err != nil {
+		if virErr, ok := err.(Error); ok && virErr.Code == ERR_NO_SUPPORT {
+			t.Skip("Network updates are not supported")
+		}
+		t.Fatal(err)
+	}
+
+	spec, err := net.GetSpec(NETWORK_XML_INACTIVE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.IPs) != 1 || spec.IPs[0].DHCP == nil || len(spec.IPs[0].DHCP.Hosts) != 1 {
+		t.Fatalf("Expected one DHCP host in %v", spec.IPs)
+	}
+
+	err = net.CheckDHCPHost(&libvirtxml.NetworkDHCPHost{MAC: "52:54:00:00:00:11", IP: "192.168.10.10"})
+	if conflict, ok := err.(*NetworkConflictError); !ok || conflict.Field != "ip" {
+		t.Fatalf("Expected an IP address conflict, got %v", err)
+	}
+	err = net.CheckDHCPHost(&libvirtxml.NetworkDHCPHost{MAC: "52:54:00:00:00:10", IP: "192.168.10.11"})
+	if conflict, ok := err.(*NetworkConflictError); !ok || conflict.Field != "mac" {
+		t.Fatalf("Expected a MAC address conflict, got %v", err)
+	}
+
+	if err := net.RemoveDHCPHost(host); err != nil {
+		t.Fatal(err)
+	}
+	if err := net.CheckDHCPHost(host); err != nil {
+		t.Fatal(err)
+	}
+
+	err = net.ModifyDHCPRange(
+		&libvirtxml.NetworkDHCPRange{Start: "192.168.10.100", End: "192.168.10.200"},
+		&libvirtxml.NetworkDHCPRange{Start: "192.168.10.50", End: "192.168.10.150"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec, err = net.GetSpec(NETWORK_XML_INACTIVE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ranges := spec.IPs[0].DHCP.Ranges; len(ranges) != 1 || ranges[0].Start != "192.168.10.50" {
+		t.Fatalf("Unexpected DHCP ranges %v", ranges)
+	}
+}
+
+func TestNetworkIPIndex(t *testing.T) {
+	spec := &NetworkSpec{
+		IPs: []libvirtxml.NetworkIP{
+			{Address: "192.168.10.1", Netmask: "255.255.255.0"},
+			{Family: "ipv6", Address: "fd00:10::1", Prefix: 64},
+			{Address: "10.0.0.1", Prefix: 16},
+			{Address: "172.16.0.1"},
+		},
+	}
+	tests := map[string]int{
+		"192.168.10.50":   0,
+		"fd00:10::50":     1,
+		"FD00:0010::0050": 1,
+		"10.0.200.1":      2,
+		"172.16.200.1":    3,
+		"192.168.11.1":    -1,
+		"fd00:11::1":      -1,
+		"":                -1,
+	}
+	for addr, expected := range tests {
+		if index := networkIPIndex(spec, addr); index != expected {
+			t.Errorf("Address %q in <ip> %d, expected %d", addr, index, expected)
+		}
+	}
+}
+
+func TestNetworkDHCPv6HostUpdate(t *testing.T) {
+	conn := buildTestConnection()
+	net, err := conn.NetworkDefineXML(`<network>
+    <name>` + time.Now().String() + `</name>
+    <bridge name="testbr2"/>
+    <ip address="192.168.20.1" netmask="255.255.255.0">
+      <dhcp>
+        <range start="192.168.20.100" end="192.168.20.200"/>
+      </dhcp>
+    </ip>
+    <ip family="ipv6" address="fd00:20::1" prefix="64">
+      <dhcp>
+        <range start="fd00:20::100" end="fd00:20::200"/>
+      </dhcp>
+    </ip>
+    </network>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		net.Undefine()
+		net.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	host := &libvirtxml.NetworkDHCPHost{ID: "0:3:0:1:0:16:3e:11:22:33", Name: "web6", IP: "fd00:20::10"}
+	if err := net.AddDHCPHost(host); err != nil {
+		if virErr, ok := err.(Error); ok && virErr.Code == ERR_NO_SUPPORT {
+			t.Skip("Network updates are not supported")
+		}
+		t.Fatal(err)
+	}
+	spec, err := net.GetSpec(NETWORK_XML_INACTIVE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.IPs) != 2 || spec.IPs[0].DHCP == nil || len(spec.IPs[0].DHCP.Hosts) != 0 ||
+		spec.IPs[1].DHCP == nil || len(spec.IPs[1].DHCP.Hosts) != 1 {
+		t.Fatalf("Expected one DHCP host in the IPv6 <ip> of %v", spec.IPs)
+	}
+
+	if err := net.RemoveDHCPHost(host); err != nil {
+		t.Fatal(err)
+	}
+	err = net.ModifyDHCPRange(
+		&libvirtxml.NetworkDHCPRange{Start: "fd00:20::100", End: "fd00:20::200"},
+		&libvirtxml.NetworkDHCPRange{Start: "fd00:20::50", End: "fd00:20::150"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec, err = net.GetSpec(NETWORK_XML_INACTIVE)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ranges := spec.IPs[1].DHCP.Ranges; len(ranges) != 1 || ranges[0].Start != "fd00:20::50" {
+		t.Fatalf("Unexpected DHCPv6 ranges %v", ranges)
+	}
+}
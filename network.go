@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "network_wrapper.h"
 */
@@ -53,6 +53,26 @@ const (
 	NETWORK_XML_INACTIVE = NetworkXMLFlags(C.VIR_NETWORK_XML_INACTIVE)
 )
 
+type NetworkDefineFlags int
+
+const (
+	NETWORK_DEFINE_VALIDATE = NetworkDefineFlags(C.VIR_NETWORK_DEFINE_VALIDATE)
+)
+
+type NetworkCreateFlags int
+
+const (
+	NETWORK_CREATE_VALIDATE = NetworkCreateFlags(C.VIR_NETWORK_CREATE_VALIDATE)
+)
+
+type NetworkMetadataType int
+
+const (
+	NETWORK_METADATA_DESCRIPTION = NetworkMetadataType(C.VIR_NETWORK_METADATA_DESCRIPTION)
+	NETWORK_METADATA_TITLE       = NetworkMetadataType(C.VIR_NETWORK_METADATA_TITLE)
+	NETWORK_METADATA_ELEMENT     = NetworkMetadataType(C.VIR_NETWORK_METADATA_ELEMENT)
+)
+
 type NetworkUpdateCommand int
 
 const (
@@ -101,7 +121,8 @@ const (
 type NetworkEventID int
 
 const (
-	NETWORK_EVENT_ID_LIFECYCLE = NetworkEventID(C.VIR_NETWORK_EVENT_ID_LIFECYCLE)
+	NETWORK_EVENT_ID_LIFECYCLE       = NetworkEventID(C.VIR_NETWORK_EVENT_ID_LIFECYCLE)
+	NETWORK_EVENT_ID_METADATA_CHANGE = NetworkEventID(C.VIR_NETWORK_EVENT_ID_METADATA_CHANGE)
 )
 
 type Network struct {
@@ -121,7 +142,10 @@ type NetworkDHCPLease struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkFree
-func (n *Network) Free() error {
+func (n *Network) doFree() error {
+	if err := trackHandleFree("Network", unsafe.Pointer(n.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virNetworkFreeWrapper(n.ptr, &err)
 	if ret == -1 {
@@ -131,17 +155,18 @@ func (n *Network) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkRef
-func (c *Network) Ref() error {
+func (c *Network) doRef() error {
 	var err C.virError
 	ret := C.virNetworkRefWrapper(c.ptr, &err)
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("Network", unsafe.Pointer(c.ptr))
 	return nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkCreate
-func (n *Network) Create() error {
+func (n *Network) doCreate() error {
 	var err C.virError
 	result := C.virNetworkCreateWrapper(n.ptr, &err)
 	if result == -1 {
@@ -151,7 +176,7 @@ func (n *Network) Create() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkDestroy
-func (n *Network) Destroy() error {
+func (n *Network) doDestroy() error {
 	var err C.virError
 	result := C.virNetworkDestroyWrapper(n.ptr, &err)
 	if result == -1 {
@@ -161,7 +186,7 @@ func (n *Network) Destroy() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkIsActive
-func (n *Network) IsActive() (bool, error) {
+func (n *Network) doIsActive() (bool, error) {
 	var err C.virError
 	result := C.virNetworkIsActiveWrapper(n.ptr, &err)
 	if result == -1 {
@@ -174,7 +199,7 @@ func (n *Network) IsActive() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkIsPersistent
-func (n *Network) IsPersistent() (bool, error) {
+func (n *Network) doIsPersistent() (bool, error) {
 	var err C.virError
 	result := C.virNetworkIsPersistentWrapper(n.ptr, &err)
 	if result == -1 {
@@ -187,7 +212,7 @@ func (n *Network) IsPersistent() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetAutostart
-func (n *Network) GetAutostart() (bool, error) {
+func (n *Network) doGetAutostart() (bool, error) {
 	var out C.int
 	var err C.virError
 	result := C.virNetworkGetAutostartWrapper(n.ptr, (*C.int)(unsafe.Pointer(&out)), &err)
@@ -203,7 +228,7 @@ func (n *Network) GetAutostart() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkSetAutostart
-func (n *Network) SetAutostart(autostart bool) error {
+func (n *Network) doSetAutostart(autostart bool) error {
 	var cAutostart C.int
 	switch autostart {
 	case true:
@@ -220,7 +245,7 @@ func (n *Network) SetAutostart(autostart bool) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetName
-func (n *Network) GetName() (string, error) {
+func (n *Network) doGetName() (string, error) {
 	var err C.virError
 	name := C.virNetworkGetNameWrapper(n.ptr, &err)
 	if name == nil {
@@ -230,7 +255,7 @@ func (n *Network) GetName() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetUUID
-func (n *Network) GetUUID() ([]byte, error) {
+func (n *Network) doGetUUID() ([]byte, error) {
 	var cUuid [C.VIR_UUID_BUFLEN](byte)
 	cuidPtr := unsafe.Pointer(&cUuid)
 	var err C.virError
@@ -242,7 +267,7 @@ func (n *Network) GetUUID() ([]byte, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetUUIDString
-func (n *Network) GetUUIDString() (string, error) {
+func (n *Network) doGetUUIDString() (string, error) {
 	var cUuid [C.VIR_UUID_STRING_BUFLEN](C.char)
 	cuidPtr := unsafe.Pointer(&cUuid)
 	var err C.virError
@@ -254,7 +279,7 @@ func (n *Network) GetUUIDString() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetBridgeName
-func (n *Network) GetBridgeName() (string, error) {
+func (n *Network) doGetBridgeName() (string, error) {
 	var err C.virError
 	result := C.virNetworkGetBridgeNameWrapper(n.ptr, &err)
 	if result == nil {
@@ -266,7 +291,7 @@ func (n *Network) GetBridgeName() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetXMLDesc
-func (n *Network) GetXMLDesc(flags NetworkXMLFlags) (string, error) {
+func (n *Network) doGetXMLDesc(flags NetworkXMLFlags) (string, error) {
 	var err C.virError
 	result := C.virNetworkGetXMLDescWrapper(n.ptr, C.uint(flags), &err)
 	if result == nil {
@@ -278,7 +303,7 @@ func (n *Network) GetXMLDesc(flags NetworkXMLFlags) (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkUndefine
-func (n *Network) Undefine() error {
+func (n *Network) doUndefine() error {
 	var err C.virError
 	result := C.virNetworkUndefineWrapper(n.ptr, &err)
 	if result == -1 {
@@ -288,7 +313,7 @@ func (n *Network) Undefine() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkUpdate
-func (n *Network) Update(cmd NetworkUpdateCommand, section NetworkUpdateSection, parentIndex int, xml string, flags NetworkUpdateFlags) error {
+func (n *Network) doUpdate(cmd NetworkUpdateCommand, section NetworkUpdateSection, parentIndex int, xml string, flags NetworkUpdateFlags) error {
 	cxml := C.CString(xml)
 	defer C.free(unsafe.Pointer(cxml))
 	var err C.virError
@@ -299,8 +324,60 @@ func (n *Network) Update(cmd NetworkUpdateCommand, section NetworkUpdateSection,
 	return nil
 }
 
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetMetadata
+func (n *Network) doGetMetadata(metaDataType NetworkMetadataType, uri string, flags NetworkUpdateFlags) (string, error) {
+	if C.LIBVIR_VERSION_NUMBER < 9007000 {
+		return "", makeNotImplementedError("virNetworkGetMetadata")
+	}
+
+	var cUri *C.char
+	if uri != "" {
+		cUri = C.CString(uri)
+		defer C.free(unsafe.Pointer(cUri))
+	}
+
+	var err C.virError
+	result := C.virNetworkGetMetadataWrapper(n.ptr, C.int(metaDataType), cUri, C.uint(flags), &err)
+	if result == nil {
+		return "", makeError(&err)
+	}
+	defer C.free(unsafe.Pointer(result))
+	return C.GoString(result), nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkSetMetadata
+func (n *Network) doSetMetadata(metaDataType NetworkMetadataType, metaDataCont, uriKey, uri string, flags NetworkUpdateFlags) error {
+	if C.LIBVIR_VERSION_NUMBER < 9007000 {
+		return makeNotImplementedError("virNetworkSetMetadata")
+	}
+
+	var cMetaDataCont *C.char
+	var cUriKey *C.char
+	var cUri *C.char
+
+	if metaDataCont != "" {
+		cMetaDataCont = C.CString(metaDataCont)
+		defer C.free(unsafe.Pointer(cMetaDataCont))
+	}
+
+	if metaDataType == NETWORK_METADATA_ELEMENT {
+		if uriKey != "" {
+			cUriKey = C.CString(uriKey)
+			defer C.free(unsafe.Pointer(cUriKey))
+		}
+		cUri = C.CString(uri)
+		defer C.free(unsafe.Pointer(cUri))
+	}
+	var err C.virError
+	result := C.virNetworkSetMetadataWrapper(n.ptr, C.int(metaDataType), cMetaDataCont, cUriKey, cUri, C.uint(flags), &err)
+	if result == -1 {
+		return makeError(&err)
+	}
+	return nil
+}
+
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetDHCPLeases
-func (n *Network) GetDHCPLeases() ([]NetworkDHCPLease, error) {
+func (n *Network) doGetDHCPLeases() ([]NetworkDHCPLease, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002006 {
 		return []NetworkDHCPLease{}, makeNotImplementedError("virNetworkGetDHCPLeases")
 	}
@@ -336,7 +413,7 @@ func (n *Network) GetDHCPLeases() ([]NetworkDHCPLease, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkPortLookupByUUIDString
-func (n *Network) LookupNetworkPortByUUIDString(uuid string) (*NetworkPort, error) {
+func (n *Network) doLookupNetworkPortByUUIDString(uuid string) (*NetworkPort, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return nil, makeNotImplementedError("virNetworkPortLookupByUUIDString")
 	}
@@ -348,11 +425,12 @@ func (n *Network) LookupNetworkPortByUUIDString(uuid string) (*NetworkPort, erro
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NetworkPort", unsafe.Pointer(ptr))
 	return &NetworkPort{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkPortLookupByUUID
-func (n *Network) LookupNetworkPortByUUID(uuid []byte) (*NetworkPort, error) {
+func (n *Network) doLookupNetworkPortByUUID(uuid []byte) (*NetworkPort, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return nil, makeNotImplementedError("virNetworkPortLookupByUUID")
 	}
@@ -370,11 +448,12 @@ func (n *Network) LookupNetworkPortByUUID(uuid []byte) (*NetworkPort, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NetworkPort", unsafe.Pointer(ptr))
 	return &NetworkPort{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkPortCreateXML
-func (n *Network) PortCreateXML(xmlConfig string, flags uint) (*NetworkPort, error) {
+func (n *Network) doPortCreateXML(xmlConfig string, flags uint) (*NetworkPort, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return nil, makeNotImplementedError("virNetworkPortCreateXML")
 	}
@@ -385,11 +464,12 @@ func (n *Network) PortCreateXML(xmlConfig string, flags uint) (*NetworkPort, err
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("NetworkPort", unsafe.Pointer(ptr))
 	return &NetworkPort{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkListAllPorts
-func (n *Network) ListAllPorts(flags uint) ([]NetworkPort, error) {
+func (n *Network) doListAllPorts(flags uint) ([]NetworkPort, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return []NetworkPort{}, makeNotImplementedError("virNetworkListAllPorts")
 	}
@@ -408,6 +488,7 @@ func (n *Network) ListAllPorts(flags uint) ([]NetworkPort, error) {
 	var ports []NetworkPort
 	slice := *(*[]C.virNetworkPortPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("NetworkPort", unsafe.Pointer(ptr))
 		ports = append(ports, NetworkPort{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
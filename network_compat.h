@@ -83,4 +83,36 @@ struct _virNetworkDHCPLease {
 };
 #endif
 
+/* 7.8.0 */
+
+#ifndef VIR_NETWORK_DEFINE_VALIDATE
+#define VIR_NETWORK_DEFINE_VALIDATE (1 << 0)
+#endif
+
+#ifndef VIR_NETWORK_CREATE_VALIDATE
+#define VIR_NETWORK_CREATE_VALIDATE (1 << 0)
+#endif
+
+
+/* 9.7.0 */
+
+#ifndef VIR_NETWORK_METADATA_DESCRIPTION
+#define VIR_NETWORK_METADATA_DESCRIPTION 0
+#endif
+
+#ifndef VIR_NETWORK_METADATA_TITLE
+#define VIR_NETWORK_METADATA_TITLE 1
+#endif
+
+#ifndef VIR_NETWORK_METADATA_ELEMENT
+#define VIR_NETWORK_METADATA_ELEMENT 2
+#endif
+
+
+/* 9.8.0 */
+
+#ifndef VIR_NETWORK_EVENT_ID_METADATA_CHANGE
+#define VIR_NETWORK_EVENT_ID_METADATA_CHANGE 1
+#endif
+
 #endif /* LIBVIRT_GO_NETWORK_COMPAT_H__ */
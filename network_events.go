@@ -32,7 +32,7 @@ import (
 )
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include "network_events_wrapper.h"
 */
 import "C"
@@ -45,6 +45,13 @@ type NetworkEventLifecycle struct {
 
 type NetworkEventLifecycleCallback func(c *Connect, n *Network, event *NetworkEventLifecycle)
 
+type NetworkEventMetadataChange struct {
+	Type  NetworkMetadataType
+	NSURI string
+}
+
+type NetworkEventMetadataChangeCallback func(c *Connect, n *Network, event *NetworkEventMetadataChange)
+
 //export networkEventLifecycleCallback
 func networkEventLifecycleCallback(c C.virConnectPtr, n C.virNetworkPtr,
 	event int, detail int,
@@ -66,7 +73,27 @@ func networkEventLifecycleCallback(c C.virConnectPtr, n C.virNetworkPtr,
 	callback(connection, network, eventDetails)
 }
 
-func (c *Connect) NetworkEventLifecycleRegister(net *Network, callback NetworkEventLifecycleCallback) (int, error) {
+//export networkEventMetadataChangeCallback
+func networkEventMetadataChangeCallback(c C.virConnectPtr, n C.virNetworkPtr,
+	mtype int, nsuri *C.char, goCallbackId int) {
+
+	network := &Network{ptr: n}
+	connection := &Connect{ptr: c}
+
+	eventDetails := &NetworkEventMetadataChange{
+		Type:  NetworkMetadataType(mtype),
+		NSURI: C.GoString(nsuri),
+	}
+
+	callbackFunc := getCallbackId(goCallbackId)
+	callback, ok := callbackFunc.(NetworkEventMetadataChangeCallback)
+	if !ok {
+		panic("Inappropriate callback type called")
+	}
+	callback(connection, network, eventDetails)
+}
+
+func (c *Connect) doNetworkEventLifecycleRegister(net *Network, callback NetworkEventLifecycleCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 	if C.LIBVIR_VERSION_NUMBER < 1002001 {
 		return 0, makeNotImplementedError("virConnectNetworkEventRegisterAny")
@@ -89,7 +116,30 @@ func (c *Connect) NetworkEventLifecycleRegister(net *Network, callback NetworkEv
 	return int(ret), nil
 }
 
-func (c *Connect) NetworkEventDeregister(callbackId int) error {
+func (c *Connect) doNetworkEventMetadataChangeRegister(net *Network, callback NetworkEventMetadataChangeCallback) (int, error) {
+	if C.LIBVIR_VERSION_NUMBER < 9008000 {
+		return 0, makeNotImplementedError("virConnectNetworkEventRegisterAny")
+	}
+	goCallBackId := registerCallbackId(callback)
+
+	callbackPtr := unsafe.Pointer(C.networkEventMetadataChangeCallbackHelper)
+	var cnet C.virNetworkPtr
+	if net != nil {
+		cnet = net.ptr
+	}
+	var err C.virError
+	ret := C.virConnectNetworkEventRegisterAnyWrapper(c.ptr, cnet,
+		C.VIR_NETWORK_EVENT_ID_METADATA_CHANGE,
+		C.virConnectNetworkEventGenericCallback(callbackPtr),
+		C.long(goCallBackId), &err)
+	if ret == -1 {
+		freeCallbackId(goCallBackId)
+		return 0, makeError(&err)
+	}
+	return int(ret), nil
+}
+
+func (c *Connect) doNetworkEventDeregister(callbackId int) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002001 {
 		return makeNotImplementedError("virConnectNetworkEventDeregisterAny")
 	}
@@ -123,3 +173,22 @@ func (e NetworkEventLifecycle) String() string {
 
 	return fmt.Sprintf("Network event=%q", event)
 }
+
+func (e NetworkEventMetadataChange) String() string {
+	var mtype string
+	switch e.Type {
+	case NETWORK_METADATA_DESCRIPTION:
+		mtype = "description"
+
+	case NETWORK_METADATA_TITLE:
+		mtype = "title"
+
+	case NETWORK_METADATA_ELEMENT:
+		mtype = "element"
+
+	default:
+		mtype = "unknown"
+	}
+
+	return fmt.Sprintf("Network metadata type=%q nsuri=%q", mtype, e.NSURI)
+}
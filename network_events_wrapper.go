@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <assert.h>
 #include <stdint.h>
 #include "network_events_wrapper.h"
@@ -40,6 +40,13 @@ void networkEventLifecycleCallbackHelper(virConnectPtr c, virNetworkPtr d,
     networkEventLifecycleCallback(c, d, event, detail, (int)(intptr_t)data);
 }
 
+extern void networkEventMetadataChangeCallback(virConnectPtr, virNetworkPtr, int, const char *, int);
+void networkEventMetadataChangeCallbackHelper(virConnectPtr c, virNetworkPtr d,
+                                              int type, const char *nsuri, void *data)
+{
+    networkEventMetadataChangeCallback(c, d, type, nsuri, (int)(intptr_t)data);
+}
+
 int
 virConnectNetworkEventRegisterAnyWrapper(virConnectPtr c,
                                          virNetworkPtr d,
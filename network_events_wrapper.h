@@ -29,6 +29,7 @@
 
 #include <libvirt/libvirt.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 #include "network_compat.h"
 
 void
@@ -38,6 +39,13 @@ networkEventLifecycleCallbackHelper(virConnectPtr c,
                                     int detail,
                                     void* data);
 
+void
+networkEventMetadataChangeCallbackHelper(virConnectPtr c,
+                                         virNetworkPtr d,
+                                         int type,
+                                         const char *nsuri,
+                                         void* data);
+
 int
 virConnectNetworkEventRegisterAnyWrapper(virConnectPtr c,
                                          virNetworkPtr d,
@@ -26,10 +26,11 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "network_wrapper.h"
 #include "network_port_wrapper.h"
+#include "typedparams_wrapper.h"
 */
 import "C"
 
@@ -48,11 +49,14 @@ type NetworkPort struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkPortFree
-func (n *NetworkPort) Free() error {
+func (n *NetworkPort) doFree() error {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return makeNotImplementedError("virNetworkPortFree")
 	}
 
+	if err := trackHandleFree("NetworkPort", unsafe.Pointer(n.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virNetworkPortFreeWrapper(n.ptr, &err)
 	if ret == -1 {
@@ -62,7 +66,7 @@ func (n *NetworkPort) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkPortRef
-func (c *NetworkPort) Ref() error {
+func (c *NetworkPort) doRef() error {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return makeNotImplementedError("virNetworkPortRef")
 	}
@@ -72,6 +76,7 @@ func (c *NetworkPort) Ref() error {
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("NetworkPort", unsafe.Pointer(c.ptr))
 	return nil
 }
 
@@ -80,7 +85,7 @@ func (c *NetworkPort) Ref() error {
 // Contrary to the native C API behaviour, the Go API will
 // acquire a reference on the returned Network, which must
 // be released by calling Free()
-func (n *NetworkPort) GetNetwork() (*Network, error) {
+func (n *NetworkPort) doGetNetwork() (*Network, error) {
 	var err C.virError
 	ptr := C.virNetworkPortGetNetworkWrapper(n.ptr, &err)
 	if ptr == nil {
@@ -92,11 +97,12 @@ func (n *NetworkPort) GetNetwork() (*Network, error) {
 		return nil, makeError(&err)
 	}
 
+	trackHandle("Network", unsafe.Pointer(ptr))
 	return &Network{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkPortGetUUID
-func (n *NetworkPort) GetUUID() ([]byte, error) {
+func (n *NetworkPort) doGetUUID() ([]byte, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return []byte{}, makeNotImplementedError("virNetworkPortGetUUID")
 	}
@@ -112,7 +118,7 @@ func (n *NetworkPort) GetUUID() ([]byte, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkPortGetUUIDString
-func (n *NetworkPort) GetUUIDString() (string, error) {
+func (n *NetworkPort) doGetUUIDString() (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return "", makeNotImplementedError("virNetworkPortGetUUIDString")
 	}
@@ -128,7 +134,7 @@ func (n *NetworkPort) GetUUIDString() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkPortDelete
-func (n *NetworkPort) Delete(flags uint) error {
+func (n *NetworkPort) doDelete(flags uint) error {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return makeNotImplementedError("virNetworkPortDelete")
 	}
@@ -142,7 +148,7 @@ func (n *NetworkPort) Delete(flags uint) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkPortGetXMLDesc
-func (d *NetworkPort) GetXMLDesc(flags uint) (string, error) {
+func (d *NetworkPort) doGetXMLDesc(flags uint) (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return "", makeNotImplementedError("virNetworkPortDelete")
 	}
@@ -208,7 +214,7 @@ func getNetworkPortParametersFieldInfo(params *NetworkPortParameters) map[string
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkPortGetParameters
-func (d *NetworkPort) GetParameters(flags uint) (*NetworkPortParameters, error) {
+func (d *NetworkPort) doGetParameters(flags uint) (*NetworkPortParameters, error) {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return nil, makeNotImplementedError("virNetworkPortGetParameters")
 	}
@@ -224,7 +230,7 @@ func (d *NetworkPort) GetParameters(flags uint) (*NetworkPortParameters, error)
 		return nil, makeError(&err)
 	}
 
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	_, gerr := typedParamsUnpack(cparams, cnparams, info)
 	if gerr != nil {
@@ -235,7 +241,7 @@ func (d *NetworkPort) GetParameters(flags uint) (*NetworkPortParameters, error)
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkPortSetParameters
-func (d *NetworkPort) SetParameters(params *NetworkPortParameters, flags uint) error {
+func (d *NetworkPort) doSetParameters(params *NetworkPortParameters, flags uint) error {
 	if C.LIBVIR_VERSION_NUMBER < 5005000 {
 		return makeNotImplementedError("virNetworkPortSetParameters")
 	}
@@ -246,7 +252,7 @@ func (d *NetworkPort) SetParameters(params *NetworkPortParameters, flags uint) e
 	if gerr != nil {
 		return gerr
 	}
-	defer C.virTypedParamsFree(cparams, cnparams)
+	defer C.virTypedParamsFreeWrapper(cparams, cnparams)
 
 	var err C.virError
 	ret := C.virNetworkPortSetParametersWrapper(d.ptr, cparams, cnparams, C.uint(flags), &err)
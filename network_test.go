@@ -264,3 +264,57 @@ func TestNetworkCreateXML(t *testing.T) {
 		}
 	}
 }
+
+func TestNetworkSetMetadata(t *testing.T) {
+	xmlns := "http://libvirt.org/xmlns/libvirt-go/test"
+	xmlprefix := "test"
+	meta := "<blob/>"
+
+	net, conn := buildTestNetwork("")
+	defer func() {
+		net.Undefine()
+		net.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	_, err := net.GetMetadata(NETWORK_METADATA_ELEMENT, xmlns, NETWORK_UPDATE_AFFECT_CONFIG)
+	if err == nil {
+		t.Errorf("Expected an error for missing metadata")
+		return
+	}
+	lverr, ok := err.(Error)
+	if ok && lverr.Code == ERR_NO_SUPPORT {
+		return
+	}
+
+	err = net.SetMetadata(NETWORK_METADATA_ELEMENT, meta, xmlprefix, xmlns, NETWORK_UPDATE_AFFECT_CONFIG)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	data, err := net.GetMetadata(NETWORK_METADATA_ELEMENT, xmlns, NETWORK_UPDATE_AFFECT_CONFIG)
+	if err != nil {
+		t.Errorf("Unexpected an error for metadata")
+		return
+	}
+
+	if data != meta {
+		t.Errorf("Metadata %s doesn't match %s", data, meta)
+		return
+	}
+
+	err = net.SetMetadata(NETWORK_METADATA_ELEMENT, "", "", xmlns, NETWORK_UPDATE_AFFECT_CONFIG)
+	if err != nil {
+		t.Error(err)
+		return
+	}
+
+	_, err = net.GetMetadata(NETWORK_METADATA_ELEMENT, xmlns, NETWORK_UPDATE_AFFECT_CONFIG)
+	if err == nil {
+		t.Errorf("Expected an error for deleted metadata")
+		return
+	}
+}
@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <assert.h>
 #include "network_wrapper.h"
 
@@ -122,6 +122,25 @@ virNetworkGetDHCPLeasesWrapper(virNetworkPtr network,
 }
 
 
+char *
+virNetworkGetMetadataWrapper(virNetworkPtr network,
+                             int type,
+                             const char *uri,
+                             unsigned int flags,
+                             virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 9007000
+    assert(0); // Caller should have checked version
+#else
+    char * ret = virNetworkGetMetadata(network, type, uri, flags);
+    if (!ret) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
 const char *
 virNetworkGetNameWrapper(virNetworkPtr network,
                          virErrorPtr err)
@@ -222,6 +241,27 @@ virNetworkSetAutostartWrapper(virNetworkPtr network,
 }
 
 
+int
+virNetworkSetMetadataWrapper(virNetworkPtr network,
+                             int type,
+                             const char *metadata,
+                             const char *key,
+                             const char *uri,
+                             unsigned int flags,
+                             virErrorPtr err)
+{
+#if LIBVIR_VERSION_NUMBER < 9007000
+    assert(0); // Caller should have checked version
+#else
+    int ret = virNetworkSetMetadata(network, type, metadata, key, uri, flags);
+    if (ret < 0) {
+        virCopyLastError(err);
+    }
+    return ret;
+#endif
+}
+
+
 int
 virNetworkUndefineWrapper(virNetworkPtr network,
                           virErrorPtr err)
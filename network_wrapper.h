@@ -29,6 +29,7 @@
 
 #include <libvirt/libvirt.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 #include "network_compat.h"
 #include "network_port_compat.h"
 
@@ -63,6 +64,13 @@ virNetworkGetDHCPLeasesWrapper(virNetworkPtr network,
                                unsigned int flags,
                                virErrorPtr err);
 
+char *
+virNetworkGetMetadataWrapper(virNetworkPtr network,
+                             int type,
+                             const char *uri,
+                             unsigned int flags,
+                             virErrorPtr err);
+
 const char *
 virNetworkGetNameWrapper(virNetworkPtr network,
                          virErrorPtr err);
@@ -99,6 +107,15 @@ virNetworkSetAutostartWrapper(virNetworkPtr network,
                               int autostart,
                               virErrorPtr err);
 
+int
+virNetworkSetMetadataWrapper(virNetworkPtr network,
+                             int type,
+                             const char *metadata,
+                             const char *key,
+                             const char *uri,
+                             unsigned int flags,
+                             virErrorPtr err);
+
 int
 virNetworkUndefineWrapper(virNetworkPtr network,
                           virErrorPtr err);
@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "node_device_wrapper.h"
 */
@@ -56,7 +56,10 @@ type NodeDevice struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceFree
-func (n *NodeDevice) Free() error {
+func (n *NodeDevice) doFree() error {
+	if err := trackHandleFree("NodeDevice", unsafe.Pointer(n.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virNodeDeviceFreeWrapper(n.ptr, &err)
 	if ret == -1 {
@@ -66,17 +69,18 @@ func (n *NodeDevice) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceRef
-func (c *NodeDevice) Ref() error {
+func (c *NodeDevice) doRef() error {
 	var err C.virError
 	ret := C.virNodeDeviceRefWrapper(c.ptr, &err)
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("NodeDevice", unsafe.Pointer(c.ptr))
 	return nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceDestroy
-func (n *NodeDevice) Destroy() error {
+func (n *NodeDevice) doDestroy() error {
 	var err C.virError
 	result := C.virNodeDeviceDestroyWrapper(n.ptr, &err)
 	if result == -1 {
@@ -86,7 +90,7 @@ func (n *NodeDevice) Destroy() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceReset
-func (n *NodeDevice) Reset() error {
+func (n *NodeDevice) doReset() error {
 	var err C.virError
 	result := C.virNodeDeviceResetWrapper(n.ptr, &err)
 	if result == -1 {
@@ -96,7 +100,7 @@ func (n *NodeDevice) Reset() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceDettach
-func (n *NodeDevice) Detach() error {
+func (n *NodeDevice) doDetach() error {
 	var err C.virError
 	result := C.virNodeDeviceDettachWrapper(n.ptr, &err)
 	if result == -1 {
@@ -106,7 +110,7 @@ func (n *NodeDevice) Detach() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceDetachFlags
-func (n *NodeDevice) DetachFlags(driverName string, flags uint32) error {
+func (n *NodeDevice) doDetachFlags(driverName string, flags uint32) error {
 	cDriverName := C.CString(driverName)
 	defer C.free(unsafe.Pointer(cDriverName))
 	var err C.virError
@@ -118,7 +122,7 @@ func (n *NodeDevice) DetachFlags(driverName string, flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceReAttach
-func (n *NodeDevice) ReAttach() error {
+func (n *NodeDevice) doReAttach() error {
 	var err C.virError
 	result := C.virNodeDeviceReAttachWrapper(n.ptr, &err)
 	if result == -1 {
@@ -128,7 +132,7 @@ func (n *NodeDevice) ReAttach() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceGetName
-func (n *NodeDevice) GetName() (string, error) {
+func (n *NodeDevice) doGetName() (string, error) {
 	var err C.virError
 	name := C.virNodeDeviceGetNameWrapper(n.ptr, &err)
 	if name == nil {
@@ -138,7 +142,7 @@ func (n *NodeDevice) GetName() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceGetXMLDesc
-func (n *NodeDevice) GetXMLDesc(flags uint32) (string, error) {
+func (n *NodeDevice) doGetXMLDesc(flags uint32) (string, error) {
 	var err C.virError
 	result := C.virNodeDeviceGetXMLDescWrapper(n.ptr, C.uint(flags), &err)
 	if result == nil {
@@ -150,7 +154,7 @@ func (n *NodeDevice) GetXMLDesc(flags uint32) (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceGetParent
-func (n *NodeDevice) GetParent() (string, error) {
+func (n *NodeDevice) doGetParent() (string, error) {
 	var err C.virError
 	result := C.virNodeDeviceGetParentWrapper(n.ptr, &err)
 	if result == nil {
@@ -161,7 +165,7 @@ func (n *NodeDevice) GetParent() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceNumOfCaps
-func (p *NodeDevice) NumOfCaps() (int, error) {
+func (p *NodeDevice) doNumOfCaps() (int, error) {
 	var err C.virError
 	result := int(C.virNodeDeviceNumOfCapsWrapper(p.ptr, &err))
 	if result == -1 {
@@ -171,7 +175,7 @@ func (p *NodeDevice) NumOfCaps() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nodedev.html#virNodeDeviceListCaps
-func (p *NodeDevice) ListCaps() ([]string, error) {
+func (p *NodeDevice) doListCaps() ([]string, error) {
 	const maxCaps = 1024
 	var names [maxCaps](*C.char)
 	namesPtr := unsafe.Pointer(&names)
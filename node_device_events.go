@@ -32,7 +32,7 @@ import (
 )
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include "node_device_events_wrapper.h"
 */
 import "C"
@@ -83,7 +83,7 @@ func nodeDeviceEventGenericCallback(c C.virConnectPtr, d C.virNodeDevicePtr,
 	callback(connection, node_device)
 }
 
-func (c *Connect) NodeDeviceEventLifecycleRegister(device *NodeDevice, callback NodeDeviceEventLifecycleCallback) (int, error) {
+func (c *Connect) doNodeDeviceEventLifecycleRegister(device *NodeDevice, callback NodeDeviceEventLifecycleCallback) (int, error) {
 	if C.LIBVIR_VERSION_NUMBER < 2002000 {
 		return 0, makeNotImplementedError("virConnectNodeDeviceEventRegisterAny")
 	}
@@ -106,7 +106,7 @@ func (c *Connect) NodeDeviceEventLifecycleRegister(device *NodeDevice, callback
 	return int(ret), nil
 }
 
-func (c *Connect) NodeDeviceEventUpdateRegister(device *NodeDevice, callback NodeDeviceEventGenericCallback) (int, error) {
+func (c *Connect) doNodeDeviceEventUpdateRegister(device *NodeDevice, callback NodeDeviceEventGenericCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 
 	callbackPtr := unsafe.Pointer(C.nodeDeviceEventGenericCallbackHelper)
@@ -126,7 +126,7 @@ func (c *Connect) NodeDeviceEventUpdateRegister(device *NodeDevice, callback Nod
 	return int(ret), nil
 }
 
-func (c *Connect) NodeDeviceEventDeregister(callbackId int) error {
+func (c *Connect) doNodeDeviceEventDeregister(callbackId int) error {
 	if C.LIBVIR_VERSION_NUMBER < 2002000 {
 		return makeNotImplementedError("virConnectNodeDeviceEventDeregisterAny")
 	}
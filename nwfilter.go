@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "nwfilter_wrapper.h"
 */
@@ -42,7 +42,10 @@ type NWFilter struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterFree
-func (f *NWFilter) Free() error {
+func (f *NWFilter) doFree() error {
+	if err := trackHandleFree("NWFilter", unsafe.Pointer(f.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virNWFilterFreeWrapper(f.ptr, &err)
 	if ret == -1 {
@@ -52,17 +55,18 @@ func (f *NWFilter) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterRef
-func (c *NWFilter) Ref() error {
+func (c *NWFilter) doRef() error {
 	var err C.virError
 	ret := C.virNWFilterRefWrapper(c.ptr, &err)
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("NWFilter", unsafe.Pointer(c.ptr))
 	return nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterGetName
-func (f *NWFilter) GetName() (string, error) {
+func (f *NWFilter) doGetName() (string, error) {
 	var err C.virError
 	name := C.virNWFilterGetNameWrapper(f.ptr, &err)
 	if name == nil {
@@ -72,7 +76,7 @@ func (f *NWFilter) GetName() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterUndefine
-func (f *NWFilter) Undefine() error {
+func (f *NWFilter) doUndefine() error {
 	var err C.virError
 	result := C.virNWFilterUndefineWrapper(f.ptr, &err)
 	if result == -1 {
@@ -82,7 +86,7 @@ func (f *NWFilter) Undefine() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterGetUUID
-func (f *NWFilter) GetUUID() ([]byte, error) {
+func (f *NWFilter) doGetUUID() ([]byte, error) {
 	var cUuid [C.VIR_UUID_BUFLEN](byte)
 	cuidPtr := unsafe.Pointer(&cUuid)
 	var err C.virError
@@ -94,7 +98,7 @@ func (f *NWFilter) GetUUID() ([]byte, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterGetUUIDString
-func (f *NWFilter) GetUUIDString() (string, error) {
+func (f *NWFilter) doGetUUIDString() (string, error) {
 	var cUuid [C.VIR_UUID_STRING_BUFLEN](C.char)
 	cuidPtr := unsafe.Pointer(&cUuid)
 	var err C.virError
@@ -106,7 +110,7 @@ func (f *NWFilter) GetUUIDString() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterGetXMLDesc
-func (f *NWFilter) GetXMLDesc(flags uint32) (string, error) {
+func (f *NWFilter) doGetXMLDesc(flags uint32) (string, error) {
 	var err C.virError
 	result := C.virNWFilterGetXMLDescWrapper(f.ptr, C.uint(flags), &err)
 	if result == nil {
@@ -26,7 +26,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "nwfilter_binding_wrapper.h"
 */
@@ -41,10 +41,13 @@ type NWFilterBinding struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterBindingFree
-func (f *NWFilterBinding) Free() error {
+func (f *NWFilterBinding) doFree() error {
 	if C.LIBVIR_VERSION_NUMBER < 4005000 {
 		return makeNotImplementedError("virNWFilterBindingFree")
 	}
+	if err := trackHandleFree("NWFilterBinding", unsafe.Pointer(f.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virNWFilterBindingFreeWrapper(f.ptr, &err)
 	if ret == -1 {
@@ -54,7 +57,7 @@ func (f *NWFilterBinding) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterBindingRef
-func (c *NWFilterBinding) Ref() error {
+func (c *NWFilterBinding) doRef() error {
 	if C.LIBVIR_VERSION_NUMBER < 4005000 {
 		return makeNotImplementedError("virNWFilterBindingRef")
 	}
@@ -63,11 +66,12 @@ func (c *NWFilterBinding) Ref() error {
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("NWFilterBinding", unsafe.Pointer(c.ptr))
 	return nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterBindingDelete
-func (f *NWFilterBinding) Delete() error {
+func (f *NWFilterBinding) doDelete() error {
 	if C.LIBVIR_VERSION_NUMBER < 4005000 {
 		return makeNotImplementedError("virNWFilterBindingDelete")
 	}
@@ -80,7 +84,7 @@ func (f *NWFilterBinding) Delete() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterBindingGetPortDev
-func (f *NWFilterBinding) GetPortDev() (string, error) {
+func (f *NWFilterBinding) doGetPortDev() (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 4005000 {
 		return "", makeNotImplementedError("virNWFilterBindingGetPortDev")
 	}
@@ -95,7 +99,7 @@ func (f *NWFilterBinding) GetPortDev() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterBindingGetFilterName
-func (f *NWFilterBinding) GetFilterName() (string, error) {
+func (f *NWFilterBinding) doGetFilterName() (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 4005000 {
 		return "", makeNotImplementedError("virNWFilterBindingGetFilterName")
 	}
@@ -110,7 +114,7 @@ func (f *NWFilterBinding) GetFilterName() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-nwfilter.html#virNWFilterBindingGetXMLDesc
-func (f *NWFilterBinding) GetXMLDesc(flags uint32) (string, error) {
+func (f *NWFilterBinding) doGetXMLDesc(flags uint32) (string, error) {
 	if C.LIBVIR_VERSION_NUMBER < 4005000 {
 		return "", makeNotImplementedError("virNWFilterBindingGetXMLDesc")
 	}
@@ -0,0 +1,382 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ConnectPoolConfig describes how a ConnectPool opens and
+// manages its connections.
+type ConnectPoolConfig struct {
+	// URI of the hypervisor each pooled connection is opened to
+	URI string
+
+	// Credentials passed to NewConnectWithAuth. If nil, the
+	// connections are opened with NewConnect instead
+	Auth  *ConnectAuth
+	Flags ConnectFlags
+
+	// Maximum number of open connections. Defaults to 1
+	MaxConnections int
+
+	// Maximum number of concurrent Do calls sharing a single
+	// connection. Defaults to 1
+	MaxInFlight int
+
+	// Connections which have not been used for this long are
+	// closed. Zero means connections are never closed for
+	// being idle
+	IdleTimeout time.Duration
+
+	// How often idle connections are checked with IsAlive.
+	// Zero means they are only checked when handed out
+	HealthCheckInterval time.Duration
+}
+
+type pooledConnect struct {
+	conn     *Connect
+	inFlight int
+	lastUsed time.Time
+	broken   bool
+}
+
+// ConnectPool manages a set of connections to the same URI, so that
+// a slow API call on one connection does not hold up the callers
+// waiting on another.
+//
+// Object handles such as Domain, Network or StorageVol belong to the
+// connection they were obtained from. They must be obtained, used and
+// freed within a single Do callback and never be kept after it
+// returns, since the next Do call may run on a different connection.
+type ConnectPool struct {
+	config ConnectPoolConfig
+
+	lock    sync.Mutex
+	conns   []*pooledConnect
+	opening int
+	closed  bool
+	wakeup  chan struct{}
+
+	quit chan struct{}
+	done chan struct{}
+}
+
+// NewConnectPool creates a pool as described by config. No connection
+// is opened until the first call to Do.
+func NewConnectPool(config ConnectPoolConfig) (*ConnectPool, error) {
+	if config.MaxConnections < 0 {
+		return nil, fmt.Errorf("Maximum connection count %d must not be negative", config.MaxConnections)
+	}
+	if config.MaxInFlight < 0 {
+		return nil, fmt.Errorf("Maximum in flight count %d must not be negative", config.MaxInFlight)
+	}
+	if config.MaxConnections == 0 {
+		config.MaxConnections = 1
+	}
+	if config.MaxInFlight == 0 {
+		config.MaxInFlight = 1
+	}
+
+	p := &ConnectPool{
+		config: config,
+		wakeup: make(chan struct{}),
+		quit:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+
+	interval := config.HealthCheckInterval
+	if interval == 0 || (config.IdleTimeout != 0 && config.IdleTimeout < interval) {
+		interval = config.IdleTimeout
+	}
+	if interval != 0 {
+		go p.housekeeping(interval)
+	} else {
+		close(p.done)
+	}
+
+	return p, nil
+}
+
+func (p *ConnectPool) open() (*Connect, error) {
+	if p.config.Auth != nil {
+		return NewConnectWithAuth(p.config.URI, p.config.Auth, p.config.Flags)
+	}
+	if p.config.Flags&CONNECT_RO != 0 {
+		return NewConnectReadOnly(p.config.URI)
+	}
+	return NewConnect(p.config.URI)
+}
+
+// Must be called with the lock held
+func (p *ConnectPool) notify() {
+	close(p.wakeup)
+	p.wakeup = make(chan struct{})
+}
+
+// Must be called with the lock held
+func (p *ConnectPool) remove(pc *pooledConnect) {
+	for i, other := range p.conns {
+		if other == pc {
+			p.conns = append(p.conns[:i], p.conns[i+1:]...)
+			break
+		}
+	}
+}
+
+func (p *ConnectPool) acquire(ctx context.Context) (*pooledConnect, error) {
+	for {
+		p.lock.Lock()
+		if p.closed {
+			p.lock.Unlock()
+			return nil, fmt.Errorf("Connection pool is closed")
+		}
+
+		var best *pooledConnect
+		var stale []*pooledConnect
+		for _, pc := range p.conns {
+			if pc.broken {
+				continue
+			}
+			if pc.inFlight == 0 {
+				alive, err := pc.conn.IsAlive()
+				if err != nil || !alive {
+					pc.broken = true
+					stale = append(stale, pc)
+					continue
+				}
+			}
+			if pc.inFlight < p.config.MaxInFlight &&
+				(best == nil || pc.inFlight < best.inFlight) {
+				best = pc
+			}
+		}
+		for _, pc := range stale {
+			p.remove(pc)
+		}
+
+		if best != nil {
+			best.inFlight++
+			best.lastUsed = time.Now()
+			p.lock.Unlock()
+			closeAll(stale)
+			return best, nil
+		}
+
+		if len(p.conns)+p.opening < p.config.MaxConnections {
+			p.opening++
+			p.lock.Unlock()
+			closeAll(stale)
+
+			conn, err := p.open()
+
+			p.lock.Lock()
+			p.opening--
+			if err != nil {
+				p.notify()
+				p.lock.Unlock()
+				return nil, err
+			}
+			if p.closed {
+				p.lock.Unlock()
+				conn.Close()
+				return nil, fmt.Errorf("Connection pool is closed")
+			}
+			pc := &pooledConnect{
+				conn:     conn,
+				inFlight: 1,
+				lastUsed: time.Now(),
+			}
+			p.conns = append(p.conns, pc)
+			p.lock.Unlock()
+			return pc, nil
+		}
+
+		wakeup := p.wakeup
+		p.lock.Unlock()
+		closeAll(stale)
+
+		select {
+		case <-wakeup:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (p *ConnectPool) release(pc *pooledConnect, failed bool) {
+	if failed {
+		alive, err := pc.conn.IsAlive()
+		failed = err != nil || !alive
+	}
+
+	p.lock.Lock()
+	pc.inFlight--
+	pc.lastUsed = time.Now()
+	if failed && !pc.broken {
+		pc.broken = true
+		p.remove(pc)
+	}
+	discard := pc.broken && pc.inFlight == 0
+	p.notify()
+	p.lock.Unlock()
+
+	if discard {
+		pc.conn.Close()
+	}
+}
+
+func closeAll(conns []*pooledConnect) {
+	for _, pc := range conns {
+		pc.conn.Close()
+	}
+}
+
+// Do runs fn with a connection from the pool, waiting until one is
+// available or ctx is done. At most MaxInFlight calls share any
+// one connection.
+//
+// The Connect passed to fn is only valid until fn returns, and must
+// not be retained or passed to other goroutines that outlive it. Any
+// object handles obtained from it must be freed before fn returns.
+func (p *ConnectPool) Do(ctx context.Context, fn func(conn *Connect) error) error {
+	pc, err := p.acquire(ctx)
+	if err != nil {
+		return err
+	}
+
+	lease := &Connect{ptr: pc.conn.ptr}
+	failed := true
+	defer func() {
+		lease.ptr = nil
+		p.release(pc, failed)
+	}()
+
+	err = fn(lease)
+	failed = err != nil
+	return err
+}
+
+// DoDomain looks up the domain with the given UUID on a connection
+// from the pool and runs fn with it. The Domain is freed when fn
+// returns, so it is never used beyond the connection it came from.
+func (p *ConnectPool) DoDomain(ctx context.Context, uuid string, fn func(dom *Domain) error) error {
+	return p.Do(ctx, func(conn *Connect) error {
+		dom, err := conn.LookupDomainByUUIDString(uuid)
+		if err != nil {
+			return err
+		}
+		defer dom.Free()
+
+		return fn(dom)
+	})
+}
+
+func (p *ConnectPool) housekeeping(interval time.Duration) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-p.quit:
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		var discard []*pooledConnect
+
+		p.lock.Lock()
+		for _, pc := range p.conns {
+			if pc.inFlight != 0 {
+				continue
+			}
+			if p.config.IdleTimeout != 0 && now.Sub(pc.lastUsed) >= p.config.IdleTimeout {
+				discard = append(discard, pc)
+				continue
+			}
+			alive, err := pc.conn.IsAlive()
+			if err != nil || !alive {
+				discard = append(discard, pc)
+			}
+		}
+		for _, pc := range discard {
+			pc.broken = true
+			p.remove(pc)
+		}
+		if len(discard) != 0 {
+			p.notify()
+		}
+		p.lock.Unlock()
+
+		closeAll(discard)
+	}
+}
+
+// Len reports the number of connections currently open in the pool.
+func (p *ConnectPool) Len() int {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return len(p.conns)
+}
+
+// Close closes every idle connection in the pool and stops its
+// housekeeping. Connections still in use by a Do call are closed
+// as soon as that call returns. Subsequent Do calls fail.
+func (p *ConnectPool) Close() error {
+	p.lock.Lock()
+	if p.closed {
+		p.lock.Unlock()
+		return nil
+	}
+	p.closed = true
+	var idle []*pooledConnect
+	for _, pc := range p.conns {
+		pc.broken = true
+		if pc.inFlight == 0 {
+			idle = append(idle, pc)
+		}
+	}
+	p.conns = nil
+	p.notify()
+	p.lock.Unlock()
+
+	close(p.quit)
+	<-p.done
+
+	var firsterr error
+	for _, pc := range idle {
+		if _, err := pc.conn.Close(); err != nil && firsterr == nil {
+			firsterr = err
+		}
+	}
+	return firsterr
+}
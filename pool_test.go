@@ -0,0 +1,187 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func buildTestConnectPool(t *testing.T, config ConnectPoolConfig) *ConnectPool {
+	config.URI = "test:///default"
+	pool, err := NewConnectPool(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pool
+}
+
+func TestConnectPoolDo(t *testing.T) {
+	pool := buildTestConnectPool(t, ConnectPoolConfig{})
+	defer pool.Close()
+
+	var hostname string
+	err := pool.Do(context.Background(), func(conn *Connect) error {
+		var err error
+		hostname, err = conn.GetHostname()
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hostname == "" {
+		t.Fatal("Hostname was empty")
+	}
+	if pool.Len() != 1 {
+		t.Fatalf("Expected 1 open connection, got %d", pool.Len())
+	}
+}
+
+func TestConnectPoolLeaseExpires(t *testing.T) {
+	pool := buildTestConnectPool(t, ConnectPoolConfig{})
+	defer pool.Close()
+
+	var leaked *Connect
+	err := pool.Do(context.Background(), func(conn *Connect) error {
+		leaked = conn
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := leaked.GetHostname(); err == nil {
+		t.Fatal("Expected an error using a connection after Do returned")
+	}
+}
+
+func TestConnectPoolLimits(t *testing.T) {
+	pool := buildTestConnectPool(t, ConnectPoolConfig{
+		MaxConnections: 2,
+		MaxInFlight:    1,
+	})
+	defer pool.Close()
+
+	started := make(chan *Connect, 2)
+	unblock := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			err := pool.Do(context.Background(), func(conn *Connect) error {
+				started <- conn
+				<-unblock
+				return nil
+			})
+			if err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	first := <-started
+	second := <-started
+	if first.ptr == second.ptr {
+		t.Fatal("Expected concurrent calls to use different connections")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := pool.Do(ctx, func(conn *Connect) error {
+		t.Error("Expected no connection to be available")
+		return nil
+	})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected deadline exceeded, got %v", err)
+	}
+
+	close(unblock)
+	wg.Wait()
+
+	if pool.Len() != 2 {
+		t.Fatalf("Expected 2 open connections, got %d", pool.Len())
+	}
+}
+
+func TestConnectPoolIdleTimeout(t *testing.T) {
+	pool := buildTestConnectPool(t, ConnectPoolConfig{
+		IdleTimeout: 10 * time.Millisecond,
+	})
+	defer pool.Close()
+
+	err := pool.Do(context.Background(), func(conn *Connect) error {
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 100 && pool.Len() != 0; i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if pool.Len() != 0 {
+		t.Fatalf("Expected idle connection to be closed, got %d", pool.Len())
+	}
+}
+
+func TestConnectPoolDoDomain(t *testing.T) {
+	pool := buildTestConnectPool(t, ConnectPoolConfig{})
+	defer pool.Close()
+
+	err := pool.DoDomain(context.Background(), "6695eb01-f6a4-8304-79aa-97f2502e193f",
+		func(dom *Domain) error {
+			name, err := dom.GetName()
+			if err != nil {
+				return err
+			}
+			if name != "test" {
+				t.Errorf("Expected domain 'test', got '%s'", name)
+			}
+			return nil
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConnectPoolClosed(t *testing.T) {
+	pool := buildTestConnectPool(t, ConnectPoolConfig{})
+	if err := pool.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	err := pool.Do(context.Background(), func(conn *Connect) error {
+		t.Error("Expected Do to fail on a closed pool")
+		return nil
+	})
+	if err == nil {
+		t.Fatal("Expected an error from a closed pool")
+	}
+}
@@ -0,0 +1,171 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+)
+
+// The largest image decodePPM accepts, to bound the memory used. This
+// leaves room for an 8K display
+const ppmMaxPixels = 1 << 25
+
+// Decodes a binary (P6) PPM image, as produced by QEMU and other
+// hypervisors for screenshots. Images with a maximum value above 255
+// are decoded with 16 bits per channel.
+func decodePPM(data []byte) (image.Image, error) {
+	if len(data) < 2 {
+		return nil, fmt.Errorf("Unable to read PPM header: too short")
+	}
+	if magic := data[:2]; string(magic) != "P6" {
+		return nil, fmt.Errorf("Unsupported PPM type %q", magic)
+	}
+	br := bytes.NewReader(data[2:])
+
+	var fields [3]int
+	for i := range fields {
+		value, err := readPPMInt(br)
+		if err != nil {
+			return nil, err
+		}
+		fields[i] = value
+	}
+	width, height, maxval := fields[0], fields[1], fields[2]
+	if width <= 0 || height <= 0 || width > ppmMaxPixels/height {
+		return nil, fmt.Errorf("Invalid PPM size %dx%d", width, height)
+	}
+	if maxval <= 0 || maxval > 65535 {
+		return nil, fmt.Errorf("Invalid PPM maximum value %d", maxval)
+	}
+	// A single whitespace byte separates the header from the pixels,
+	// and was consumed by readPPMInt
+	pixels := data[len(data)-br.Len():]
+	bytesPerPixel := 3
+	if maxval >= 256 {
+		bytesPerPixel = 6
+	}
+	// Checked before allocating the image, so that a header claiming a
+	// large image costs nothing
+	rowSize := width * bytesPerPixel
+	if len(pixels) < rowSize*height {
+		return nil, fmt.Errorf("PPM pixels are truncated, expected %d bytes, got %d",
+			rowSize*height, len(pixels))
+	}
+
+	rect := image.Rect(0, 0, width, height)
+	if maxval < 256 {
+		img := image.NewRGBA(rect)
+		for y := 0; y < height; y++ {
+			row := pixels[y*rowSize:]
+			pix := img.Pix[y*img.Stride:]
+			for x := 0; x < width; x++ {
+				pix[x*4] = scalePPM8(row[x*3], maxval)
+				pix[x*4+1] = scalePPM8(row[x*3+1], maxval)
+				pix[x*4+2] = scalePPM8(row[x*3+2], maxval)
+				pix[x*4+3] = 0xff
+			}
+		}
+		return img, nil
+	}
+
+	img := image.NewRGBA64(rect)
+	for y := 0; y < height; y++ {
+		row := pixels[y*rowSize:]
+		for x := 0; x < width; x++ {
+			img.SetRGBA64(x, y, color.RGBA64{
+				R: scalePPM16(row[x*6:], maxval),
+				G: scalePPM16(row[x*6+2:], maxval),
+				B: scalePPM16(row[x*6+4:], maxval),
+				A: 0xffff,
+			})
+		}
+	}
+	return img, nil
+}
+
+func scalePPM8(value byte, maxval int) byte {
+	if maxval == 255 {
+		return value
+	}
+	if int(value) >= maxval {
+		return 0xff
+	}
+	return byte(int(value) * 255 / maxval)
+}
+
+func scalePPM16(data []byte, maxval int) uint16 {
+	value := int(data[0])<<8 | int(data[1])
+	if value >= maxval {
+		return 0xffff
+	}
+	return uint16(value * 65535 / maxval)
+}
+
+// Reads a decimal number from a PPM header, skipping whitespace and
+// comments before it, and the whitespace byte after it
+func readPPMInt(br *bytes.Reader) (int, error) {
+	value, digits := 0, 0
+	for {
+		c, err := br.ReadByte()
+		if err != nil {
+			return 0, fmt.Errorf("Unable to read PPM header: %v", err)
+		}
+		switch {
+		case c >= '0' && c <= '9':
+			if digits == 6 {
+				return 0, fmt.Errorf("PPM header value is too large")
+			}
+			value = value*10 + int(c-'0')
+			digits++
+		case c == '#' && digits == 0:
+			if err := skipPPMComment(br); err != nil {
+				return 0, err
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\v' || c == '\f':
+			if digits != 0 {
+				return value, nil
+			}
+		default:
+			return 0, fmt.Errorf("Unexpected byte %q in PPM header", c)
+		}
+	}
+}
+
+// Skips the rest of a comment line in a PPM header
+func skipPPMComment(br *bytes.Reader) error {
+	for {
+		c, err := br.ReadByte()
+		if err != nil {
+			return fmt.Errorf("Unable to read PPM header: %v", err)
+		}
+		if c == '\n' {
+			return nil
+		}
+	}
+}
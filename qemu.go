@@ -29,10 +29,10 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 // Can't rely on pkg-config for libvirt-qemu since it was not
 // installed until 2.6.0 onwards
-#cgo LDFLAGS: -lvirt-qemu
+#cgo !libvirt_dlopen LDFLAGS: -lvirt-qemu
 #include <stdlib.h>
 #include "qemu_wrapper.h"
 */
@@ -77,7 +77,7 @@ const (
 	CONNECT_DOMAIN_QEMU_MONITOR_EVENT_REGISTER_NOCASE = DomainQemuMonitorEventFlags(C.VIR_CONNECT_DOMAIN_QEMU_MONITOR_EVENT_REGISTER_NOCASE)
 )
 
-func (d *Domain) QemuMonitorCommand(command string, flags DomainQemuMonitorCommandFlags) (string, error) {
+func (d *Domain) doQemuMonitorCommand(command string, flags DomainQemuMonitorCommandFlags) (string, error) {
 	var cResult *C.char
 	cCommand := C.CString(command)
 	defer C.free(unsafe.Pointer(cCommand))
@@ -93,7 +93,7 @@ func (d *Domain) QemuMonitorCommand(command string, flags DomainQemuMonitorComma
 	return rstring, nil
 }
 
-func (d *Domain) QemuAgentCommand(command string, timeout DomainQemuAgentCommandTimeout, flags uint32) (string, error) {
+func (d *Domain) doQemuAgentCommand(command string, timeout DomainQemuAgentCommandTimeout, flags uint32) (string, error) {
 	cCommand := C.CString(command)
 	defer C.free(unsafe.Pointer(cCommand))
 	var err C.virError
@@ -108,12 +108,13 @@ func (d *Domain) QemuAgentCommand(command string, timeout DomainQemuAgentCommand
 	return rstring, nil
 }
 
-func (c *Connect) DomainQemuAttach(pid uint32, flags uint32) (*Domain, error) {
+func (c *Connect) doDomainQemuAttach(pid uint32, flags uint32) (*Domain, error) {
 	var err C.virError
 	ptr := C.virDomainQemuAttachWrapper(c.ptr, C.uint(pid), C.uint(flags), &err)
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("Domain", unsafe.Pointer(ptr))
 	return &Domain{ptr: ptr}, nil
 }
 
@@ -149,7 +150,7 @@ func domainQemuMonitorEventCallback(c C.virConnectPtr, d C.virDomainPtr,
 
 }
 
-func (c *Connect) DomainQemuMonitorEventRegister(dom *Domain, event string, callback DomainQemuMonitorEventCallback, flags DomainQemuMonitorEventFlags) (int, error) {
+func (c *Connect) doDomainQemuMonitorEventRegister(dom *Domain, event string, callback DomainQemuMonitorEventCallback, flags DomainQemuMonitorEventFlags) (int, error) {
 	if C.LIBVIR_VERSION_NUMBER < 1002003 {
 		return 0, makeNotImplementedError("virConnectDomainQemuMonitorEventRegister")
 	}
@@ -174,7 +175,7 @@ func (c *Connect) DomainQemuMonitorEventRegister(dom *Domain, event string, call
 	return int(ret), nil
 }
 
-func (c *Connect) DomainQemuEventDeregister(callbackId int) error {
+func (c *Connect) doDomainQemuEventDeregister(callbackId int) error {
 	if C.LIBVIR_VERSION_NUMBER < 1002003 {
 		return makeNotImplementedError("virConnectDomainQemuMonitorEventDeregister")
 	}
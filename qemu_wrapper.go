@@ -27,10 +27,10 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 // Can't rely on pkg-config for libvirt-qemu since it was not
 // installed until 2.6.0 onwards
-#cgo LDFLAGS: -lvirt-qemu
+#cgo !libvirt_dlopen LDFLAGS: -lvirt-qemu
 #include <assert.h>
 #include <stdint.h>
 #include "qemu_wrapper.h"
@@ -30,6 +30,7 @@
 #include <libvirt/libvirt.h>
 #include <libvirt/libvirt-qemu.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 #include "qemu_compat.h"
 
 void
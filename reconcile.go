@@ -0,0 +1,601 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"libvirt.org/libvirt-go/libvirtxml"
+)
+
+// DesiredDomain describes the state a DomainReconciler converges a
+// domain to. Only the Spec is required. Any other field left nil is
+// not managed, and is left as it is found on the host.
+type DesiredDomain struct {
+	// The persistent definition of the domain, which is also used to
+	// find the domain by its Name. See DomainReconciler for how it is
+	// compared with the current definition.
+	Spec *DomainSpec
+
+	// Flags used when defining the domain
+	DefineFlags DomainDefineFlags
+
+	Autostart *bool
+
+	// Whether the domain should be running. A domain which is to be
+	// stopped is asked to shut down, which the guest may take some
+	// time to act on.
+	Running *bool
+
+	Metadata []DesiredDomainMetadata
+
+	// Tunables to apply to the domain. Only the fields whose "Set"
+	// field is true are managed.
+	BlkioParameters     *DomainBlkioParameters
+	MemoryParameters    *DomainMemoryParameters
+	SchedulerParameters *DomainSchedulerParameters
+}
+
+// DesiredDomainMetadata is one of the metadata items of a domain. An
+// empty Value means the item should not be present. Key and URI are
+// only used with DOMAIN_METADATA_ELEMENT.
+type DesiredDomainMetadata struct {
+	Type  DomainMetadataType
+	Key   string
+	URI   string
+	Value string
+}
+
+// DomainChange is one of the steps of a DomainPlan.
+type DomainChange struct {
+	// Human readable summary of the change
+	Description string
+
+	apply func(target *reconcileTarget) error
+}
+
+// DomainPlan lists the changes needed to bring a domain to its
+// desired state.
+type DomainPlan struct {
+	Name    string
+	Changes []DomainChange
+}
+
+// String formats the plan with one change per line.
+func (p *DomainPlan) String() string {
+	if len(p.Changes) == 0 {
+		return fmt.Sprintf("domain %q: no changes\n", p.Name)
+	}
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "domain %q:\n", p.Name)
+	for _, change := range p.Changes {
+		fmt.Fprintf(&buf, "  %s\n", change.Description)
+	}
+	return buf.String()
+}
+
+// DomainReconciler converges a domain to a DesiredDomain, making only
+// the calls needed for the parts which differ. Running it again once
+// the domain is converged makes no changes, so it is safe to run
+// repeatedly, eg from a configuration management tool.
+//
+// The definition is compared with the inactive XML of the domain,
+// which libvirt fills out with defaults such as addresses and
+// controllers. So only the fields which are set in the Spec are
+// compared: empty strings, zero numbers and nil pointers are ignored,
+// while lists which are not empty must match element by element.
+// Values must be written the way libvirt reports them, eg a machine
+// type of "pc-q35-8.2" rather than "q35", apart from memory sizes
+// which may use any unit. The custom metadata of the Spec is ignored,
+// and should be given in the Metadata of the DesiredDomain instead.
+//
+// When the definition differs, the domain is defined again from the
+// Spec. The UUID, title, description and metadata of the current
+// definition are kept if the Spec has none. Any other part of the
+// definition missing from the Spec is lost, so the Spec should be
+// complete.
+type DomainReconciler struct {
+	conn    *Connect
+	desired DesiredDomain
+}
+
+// NewDomainReconciler creates a reconciler for a domain on conn. The
+// desired state is copied, so later changes to it have no effect.
+func NewDomainReconciler(conn *Connect, desired *DesiredDomain) *DomainReconciler {
+	return &DomainReconciler{
+		conn:    conn,
+		desired: *desired,
+	}
+}
+
+type reconcileTarget struct {
+	conn *Connect
+	dom  *Domain
+}
+
+// Plan reports the changes Apply would make, without making them.
+func (r *DomainReconciler) Plan() (*DomainPlan, error) {
+	plan, dom, err := r.plan()
+	if dom != nil {
+		dom.Free()
+	}
+	return plan, err
+}
+
+// Apply makes the changes needed to converge the domain, and returns
+// the plan it carried out. The plan is worked out afresh, so it may
+// differ from one returned by an earlier call to Plan. If a change
+// fails, the error is returned along with a plan listing the changes
+// which were made before it.
+func (r *DomainReconciler) Apply() (*DomainPlan, error) {
+	plan, dom, err := r.plan()
+	if err != nil {
+		if dom != nil {
+			dom.Free()
+		}
+		return nil, err
+	}
+
+	target := &reconcileTarget{conn: r.conn, dom: dom}
+	defer func() {
+		if target.dom != nil {
+			target.dom.Free()
+		}
+	}()
+
+	for i, change := range plan.Changes {
+		if err := change.apply(target); err != nil {
+			return &DomainPlan{Name: plan.Name, Changes: plan.Changes[:i]}, err
+		}
+	}
+	return plan, nil
+}
+
+func (r *DomainReconciler) plan() (*DomainPlan, *Domain, error) {
+	desired := &r.desired
+	if desired.Spec == nil || desired.Spec.Name == "" {
+		return nil, nil, fmt.Errorf("Desired domain must have a spec with a name")
+	}
+	plan := &DomainPlan{Name: desired.Spec.Name}
+	add := func(description string, apply func(target *reconcileTarget) error) {
+		plan.Changes = append(plan.Changes, DomainChange{description, apply})
+	}
+
+	dom, err := r.conn.LookupDomainByName(desired.Spec.Name)
+	if err != nil {
+		if virErr, ok := err.(Error); !ok || virErr.Code != ERR_NO_DOMAIN {
+			return nil, nil, err
+		}
+		dom = nil
+	}
+
+	active := false
+	define := false
+	if dom == nil {
+		define = true
+		spec := desired.Spec
+		add("define new domain", func(target *reconcileTarget) error {
+			return target.define(spec, desired.DefineFlags)
+		})
+	} else {
+		if active, err = dom.IsActive(); err != nil {
+			return nil, dom, err
+		}
+
+		xml, err := dom.GetXMLDesc(DOMAIN_XML_INACTIVE | DOMAIN_XML_SECURE)
+		if err != nil {
+			return nil, dom, err
+		}
+		current := &DomainSpec{}
+		if err := current.Unmarshal(xml); err != nil {
+			return nil, dom, err
+		}
+
+		if fields := specDiff(desired.Spec, current); len(fields) != 0 {
+			define = true
+			spec := *desired.Spec
+			if spec.UUID == "" {
+				spec.UUID = current.UUID
+			}
+			if spec.Title == "" {
+				spec.Title = current.Title
+			}
+			if spec.Description == "" {
+				spec.Description = current.Description
+			}
+			if spec.Metadata == nil {
+				spec.Metadata = current.Metadata
+			}
+			description := "redefine domain, changing " + strings.Join(fields, ", ")
+			if active {
+				description += " (from next boot)"
+			}
+			add(description, func(target *reconcileTarget) error {
+				return target.define(&spec, desired.DefineFlags)
+			})
+		}
+	}
+
+	for _, item := range desired.Metadata {
+		item := item
+		current := ""
+		if dom != nil {
+			current, err = dom.GetMetadata(item.Type, item.URI, DOMAIN_AFFECT_CONFIG)
+			if err != nil {
+				if virErr, ok := err.(Error); !ok || virErr.Code != ERR_NO_DOMAIN_METADATA {
+					return nil, dom, err
+				}
+				current = ""
+			}
+		}
+		if metadataMatches(item.Type, item.Value, current) {
+			continue
+		}
+		add("set "+metadataName(item), func(target *reconcileTarget) error {
+			return target.dom.SetMetadata(item.Type, item.Value, item.Key, item.URI, DOMAIN_AFFECT_CONFIG)
+		})
+	}
+
+	// Once the domain is shut down, its live tunables no longer matter
+	live := active && (desired.Running == nil || *desired.Running)
+	for _, tunable := range desired.tunables() {
+		var current interface{}
+		if !define {
+			if current, err = tunable.get(dom, DOMAIN_AFFECT_CONFIG); err != nil {
+				return nil, dom, err
+			}
+		}
+		if err := tunable.plan(add, current, DOMAIN_AFFECT_CONFIG, "config"); err != nil {
+			return nil, dom, err
+		}
+	}
+
+	if desired.Autostart != nil {
+		autostart := *desired.Autostart
+		current := false
+		if dom != nil {
+			if current, err = dom.GetAutostart(); err != nil {
+				return nil, dom, err
+			}
+		}
+		if current != autostart {
+			add(fmt.Sprintf("set autostart to %t", autostart), func(target *reconcileTarget) error {
+				return target.dom.SetAutostart(autostart)
+			})
+		}
+	}
+
+	if live {
+		for _, tunable := range desired.tunables() {
+			current, err := tunable.get(dom, DOMAIN_AFFECT_LIVE)
+			if err != nil {
+				return nil, dom, err
+			}
+			if err := tunable.plan(add, current, DOMAIN_AFFECT_LIVE, "live"); err != nil {
+				return nil, dom, err
+			}
+		}
+	}
+
+	if desired.Running != nil {
+		if *desired.Running && !active {
+			add("start domain", func(target *reconcileTarget) error {
+				return target.dom.Create()
+			})
+		} else if !*desired.Running && active {
+			add("shut down domain", func(target *reconcileTarget) error {
+				return target.dom.Shutdown()
+			})
+		}
+	}
+
+	return plan, dom, nil
+}
+
+func (t *reconcileTarget) define(spec *DomainSpec, flags DomainDefineFlags) error {
+	var dom *Domain
+	var err error
+	if flags != 0 {
+		dom, err = t.conn.DefineDomainFlags(spec, flags)
+	} else {
+		dom, err = t.conn.DefineDomain(spec)
+	}
+	if err != nil {
+		return err
+	}
+	if t.dom != nil {
+		t.dom.Free()
+	}
+	t.dom = dom
+	return nil
+}
+
+func metadataName(item DesiredDomainMetadata) string {
+	switch item.Type {
+	case DOMAIN_METADATA_TITLE:
+		return "title"
+	case DOMAIN_METADATA_DESCRIPTION:
+		return "description"
+	default:
+		return fmt.Sprintf("metadata %s", item.URI)
+	}
+}
+
+// Custom metadata elements are compared ignoring namespace prefixes
+// and declarations, since libvirt replaces them when storing them
+func metadataMatches(typ DomainMetadataType, want, have string) bool {
+	if typ != DOMAIN_METADATA_ELEMENT || want == "" || have == "" {
+		return want == have
+	}
+	var wantElem, haveElem libvirtxml.AnyElement
+	if xml.Unmarshal([]byte(want), &wantElem) != nil ||
+		xml.Unmarshal([]byte(have), &haveElem) != nil {
+		return false
+	}
+	return specSubset(reflect.ValueOf(wantElem), reflect.ValueOf(haveElem)) &&
+		specSubset(reflect.ValueOf(haveElem), reflect.ValueOf(wantElem))
+}
+
+type domainTunable struct {
+	name string
+	want interface{}
+	get  func(dom *Domain, flags DomainModificationImpact) (interface{}, error)
+	set  func(dom *Domain, params interface{}, flags DomainModificationImpact) error
+}
+
+func (d *DesiredDomain) tunables() []domainTunable {
+	var tunables []domainTunable
+	if d.BlkioParameters != nil {
+		tunables = append(tunables, domainTunable{
+			name: "blkio",
+			want: d.BlkioParameters,
+			get: func(dom *Domain, flags DomainModificationImpact) (interface{}, error) {
+				return dom.GetBlkioParameters(flags)
+			},
+			set: func(dom *Domain, params interface{}, flags DomainModificationImpact) error {
+				return dom.SetBlkioParameters(params.(*DomainBlkioParameters), flags)
+			},
+		})
+	}
+	if d.MemoryParameters != nil {
+		tunables = append(tunables, domainTunable{
+			name: "memory",
+			want: d.MemoryParameters,
+			get: func(dom *Domain, flags DomainModificationImpact) (interface{}, error) {
+				return dom.GetMemoryParameters(flags)
+			},
+			set: func(dom *Domain, params interface{}, flags DomainModificationImpact) error {
+				return dom.SetMemoryParameters(params.(*DomainMemoryParameters), flags)
+			},
+		})
+	}
+	if d.SchedulerParameters != nil {
+		tunables = append(tunables, domainTunable{
+			name: "scheduler",
+			want: d.SchedulerParameters,
+			get: func(dom *Domain, flags DomainModificationImpact) (interface{}, error) {
+				return dom.GetSchedulerParametersFlags(flags)
+			},
+			set: func(dom *Domain, params interface{}, flags DomainModificationImpact) error {
+				return dom.SetSchedulerParametersFlags(params.(*DomainSchedulerParameters), flags)
+			},
+		})
+	}
+	return tunables
+}
+
+// Adds a change setting the tunables which differ from current, or
+// all the desired tunables if current is nil
+func (t domainTunable) plan(add func(string, func(*reconcileTarget) error), current interface{}, flags DomainModificationImpact, where string) error {
+	if current == nil {
+		current = reflect.New(reflect.TypeOf(t.want).Elem()).Interface()
+	}
+	params, changed := tunableDiff(t.want, current)
+	if params == nil {
+		return nil
+	}
+	add(fmt.Sprintf("set %s tunables %s (%s)", t.name, strings.Join(changed, ", "), where),
+		func(target *reconcileTarget) error {
+			return t.set(target.dom, params, flags)
+		})
+	return nil
+}
+
+// Returns a copy of want holding only the fields which are set in
+// want, but not set to the same value in have, along with a
+// description of each such field. Fields come in pairs, eg Weight
+// and WeightSet.
+func tunableDiff(want, have interface{}) (interface{}, []string) {
+	wantVal := reflect.ValueOf(want).Elem()
+	haveVal := reflect.ValueOf(have).Elem()
+	params := reflect.New(wantVal.Type())
+
+	var changed []string
+	for i := 0; i < wantVal.NumField(); i++ {
+		field := wantVal.Type().Field(i)
+		if field.Type.Kind() != reflect.Bool ||
+			!strings.HasSuffix(field.Name, "Set") ||
+			!wantVal.Field(i).Bool() {
+			continue
+		}
+		name := strings.TrimSuffix(field.Name, "Set")
+		value := wantVal.FieldByName(name)
+		if !value.IsValid() {
+			continue
+		}
+		if haveVal.Field(i).Bool() &&
+			reflect.DeepEqual(value.Interface(), haveVal.FieldByName(name).Interface()) {
+			continue
+		}
+		params.Elem().Field(i).SetBool(true)
+		params.Elem().FieldByName(name).Set(value)
+		changed = append(changed, fmt.Sprintf("%s=%v", name, value.Interface()))
+	}
+
+	if len(changed) == 0 {
+		return nil, nil
+	}
+	return params.Interface(), changed
+}
+
+// Returns the names of the top level fields of want which are not
+// matched by have
+func specDiff(want, have *DomainSpec) []string {
+	wantVal := reflect.ValueOf(want).Elem()
+	haveVal := reflect.ValueOf(have).Elem()
+
+	var fields []string
+	for i := 0; i < wantVal.NumField(); i++ {
+		switch wantVal.Type().Field(i).Name {
+		case "XMLName", "ID", "Metadata":
+			continue
+		}
+		if !specSubset(wantVal.Field(i), haveVal.Field(i)) {
+			fields = append(fields, wantVal.Type().Field(i).Name)
+		}
+	}
+	return fields
+}
+
+var xmlNameType = reflect.TypeOf(xml.Name{})
+var extraAttrsType = reflect.TypeOf([]libvirtxml.ExtraAttr(nil))
+
+// Reports whether every value set in want has the same value in have
+func specSubset(want, have reflect.Value) bool {
+	switch want.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if want.IsNil() {
+			return true
+		}
+		if have.IsNil() {
+			return false
+		}
+		return specSubset(want.Elem(), have.Elem())
+
+	case reflect.Slice:
+		if want.Type() == extraAttrsType {
+			want = withoutNamespaceAttrs(want)
+			have = withoutNamespaceAttrs(have)
+		}
+		if want.Len() == 0 {
+			return true
+		}
+		if want.Len() != have.Len() {
+			return false
+		}
+		for i := 0; i < want.Len(); i++ {
+			if !specSubset(want.Index(i), have.Index(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.Struct:
+		if want.Type() == xmlNameType {
+			wantName := want.Interface().(xml.Name)
+			return wantName.Local == "" || wantName.Local == have.Interface().(xml.Name).Local
+		}
+
+		// Memory sizes are compared in bytes, as libvirt reports
+		// them in KiB whatever unit they were defined with
+		sized := false
+		unit := want.FieldByName("Unit")
+		value := want.FieldByName("Value")
+		if unit.IsValid() && unit.Kind() == reflect.String &&
+			value.IsValid() && value.Kind() == reflect.Uint {
+			sized = true
+			if value.Uint() != 0 {
+				wantSize, ok1 := memoryBytes(value.Uint(), unit.String())
+				haveSize, ok2 := memoryBytes(have.FieldByName("Value").Uint(), have.FieldByName("Unit").String())
+				if !ok1 || !ok2 || wantSize != haveSize {
+					return false
+				}
+			}
+		}
+
+		for i := 0; i < want.NumField(); i++ {
+			name := want.Type().Field(i).Name
+			if sized && (name == "Unit" || name == "Value") {
+				continue
+			}
+			if !specSubset(want.Field(i), have.Field(i)) {
+				return false
+			}
+		}
+		return true
+
+	case reflect.String:
+		wantStr := strings.TrimSpace(want.String())
+		return wantStr == "" || wantStr == strings.TrimSpace(have.String())
+
+	default:
+		if reflect.DeepEqual(want.Interface(), reflect.Zero(want.Type()).Interface()) {
+			return true
+		}
+		return reflect.DeepEqual(want.Interface(), have.Interface())
+	}
+}
+
+func withoutNamespaceAttrs(attrs reflect.Value) reflect.Value {
+	var kept []libvirtxml.ExtraAttr
+	for _, attr := range attrs.Interface().([]libvirtxml.ExtraAttr) {
+		if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			continue
+		}
+		kept = append(kept, attr)
+	}
+	return reflect.ValueOf(kept)
+}
+
+func memoryBytes(value uint64, unit string) (uint64, bool) {
+	scale := uint64(0)
+	switch unit {
+	case "b", "bytes":
+		scale = 1
+	case "KB":
+		scale = 1000
+	case "", "k", "K", "KiB":
+		scale = 1024
+	case "MB":
+		scale = 1000 * 1000
+	case "m", "M", "MiB":
+		scale = 1024 * 1024
+	case "GB":
+		scale = 1000 * 1000 * 1000
+	case "g", "G", "GiB":
+		scale = 1024 * 1024 * 1024
+	case "TB":
+		scale = 1000 * 1000 * 1000 * 1000
+	case "t", "T", "TiB":
+		scale = 1024 * 1024 * 1024 * 1024
+	default:
+		return 0, false
+	}
+	return value * scale, true
+}
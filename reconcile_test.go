@@ -0,0 +1,159 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"libvirt.org/libvirt-go/libvirtxml"
+)
+
+func buildDesiredDomain(name string) *DesiredDomain {
+	running := true
+	autostart := true
+	return &DesiredDomain{
+		Spec: &DomainSpec{
+			Type:   "test",
+			Name:   name,
+			Memory: &libvirtxml.DomainMemory{Value: 8, Unit: "MiB"},
+			OS: &libvirtxml.DomainOS{
+				Type: &libvirtxml.DomainOSType{Type: "hvm"},
+			},
+		},
+		Autostart: &autostart,
+		Running:   &running,
+		Metadata: []DesiredDomainMetadata{
+			{Type: DOMAIN_METADATA_TITLE, Value: "Reconciled"},
+			{
+				Type:  DOMAIN_METADATA_ELEMENT,
+				Key:   "app",
+				URI:   "http://example.org/app/1.0",
+				Value: "<owner>alice</owner>",
+			},
+		},
+	}
+}
+
+func TestDomainReconcilerApply(t *testing.T) {
+	conn := buildTestConnection()
+	defer func() {
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	desired := buildDesiredDomain(time.Now().String())
+	reconciler := NewDomainReconciler(conn, desired)
+
+	plan, err := reconciler.Plan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Changes) != 5 {
+		t.Fatalf("Expected 5 changes, got:\n%s", plan)
+	}
+	if !strings.Contains(plan.Changes[0].Description, "define") {
+		t.Fatalf("Expected define as first change, got:\n%s", plan)
+	}
+	if _, err := conn.LookupDomainByName(desired.Spec.Name); err == nil {
+		t.Fatal("Plan should not have defined the domain")
+	}
+
+	if _, err := reconciler.Apply(); err != nil {
+		t.Fatal(err)
+	}
+
+	dom, err := conn.LookupDomainByName(desired.Spec.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		dom.Destroy()
+		dom.Undefine()
+		dom.Free()
+	}()
+	if active, err := dom.IsActive(); err != nil || !active {
+		t.Fatalf("Domain should be running: %v", err)
+	}
+	if autostart, err := dom.GetAutostart(); err != nil || !autostart {
+		t.Fatalf("Domain should autostart: %v", err)
+	}
+
+	// A second run must find nothing to do
+	plan, err = reconciler.Apply()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Changes) != 0 {
+		t.Fatalf("Expected no changes, got:\n%s", plan)
+	}
+}
+
+func TestDomainReconcilerPlan(t *testing.T) {
+	conn := buildTestConnection()
+	defer func() {
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	desired := buildDesiredDomain(time.Now().String())
+	if _, err := NewDomainReconciler(conn, desired).Apply(); err != nil {
+		t.Fatal(err)
+	}
+	dom, err := conn.LookupDomainByName(desired.Spec.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		dom.Destroy()
+		dom.Undefine()
+		dom.Free()
+	}()
+
+	running := false
+	desired.Running = &running
+	desired.Spec.Memory = &libvirtxml.DomainMemory{Value: 16, Unit: "MiB"}
+	plan, err := NewDomainReconciler(conn, desired).Plan()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := []string{
+		"redefine domain, changing Memory (from next boot)",
+		"shut down domain",
+	}
+	if len(plan.Changes) != len(expect) {
+		t.Fatalf("Unexpected plan:\n%s", plan)
+	}
+	for i, change := range plan.Changes {
+		if change.Description != expect[i] {
+			t.Fatalf("Change %d is %q, expected %q", i, change.Description, expect[i])
+		}
+	}
+}
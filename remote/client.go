@@ -0,0 +1,372 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+const (
+	// Default location of the daemon sockets for privileged
+	// connections. The modular per-driver daemon is preferred
+	// when present.
+	libvirtdSocket  = "/var/run/libvirt/libvirt-sock"
+	virtqemudSocket = "/var/run/libvirt/virtqemud-sock"
+
+	defaultTCPPort = "16509"
+)
+
+type ConnectFlags int
+
+const (
+	CONNECT_RO = ConnectFlags(1 << 0)
+)
+
+// Connect is a connection to a libvirt daemon, speaking the remote
+// protocol directly rather than going through libvirt.so. It is safe
+// for concurrent use by multiple goroutines, and RPC calls issued
+// from different goroutines are pipelined on the one socket.
+type Connect struct {
+	conn net.Conn
+
+	writeLock sync.Mutex
+
+	lock    sync.Mutex
+	serial  uint32
+	calls   map[uint32]chan *packet
+	streams map[uint32]*Stream
+	err     error
+	done    chan struct{}
+
+	eventLock     sync.RWMutex
+	domainEvents  map[int32]DomainEventLifecycleCallback
+	networkEvents map[int32]NetworkEventLifecycleCallback
+	events        *eventQueue
+}
+
+// NewConnect opens a connection to the daemon identified by uri,
+// which uses the same syntax as virConnectOpen. Only the 'unix'
+// (the default for URIs without a hostname) and 'tcp' transports
+// are supported.
+func NewConnect(uri string) (*Connect, error) {
+	return newConnect(uri, 0)
+}
+
+// NewConnectReadOnly opens a read only connection, as with NewConnect
+func NewConnectReadOnly(uri string) (*Connect, error) {
+	return newConnect(uri, CONNECT_RO)
+}
+
+func newConnect(uri string, flags ConnectFlags) (*Connect, error) {
+	network, address, driverURI, err := parseURI(uri, flags)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := net.Dial(network, address)
+	if err != nil {
+		return nil, makeRPCError("unable to connect to '%s': %s", address, err)
+	}
+
+	return NewConnectFromNetConn(conn, driverURI, flags)
+}
+
+// NewConnectFromNetConn opens a libvirt connection over an already
+// established transport. The uri is passed to the daemon as is, so
+// must not contain any transport specific parts.
+func NewConnectFromNetConn(conn net.Conn, uri string, flags ConnectFlags) (*Connect, error) {
+	c := &Connect{
+		conn:          conn,
+		calls:         make(map[uint32]chan *packet),
+		streams:       make(map[uint32]*Stream),
+		done:          make(chan struct{}),
+		domainEvents:  make(map[int32]DomainEventLifecycleCallback),
+		networkEvents: make(map[int32]NetworkEventLifecycleCallback),
+		events:        newEventQueue(),
+	}
+
+	go c.dispatch()
+	go c.events.run()
+
+	enc := &xdrEncoder{}
+	enc.optionalString(uri)
+	enc.uint32(uint32(flags))
+	if _, err := c.call(procConnectOpen, enc.buf); err != nil {
+		c.shutdown(err)
+		conn.Close()
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// parseURI works out how to reach the daemon for uri, returning the
+// network and address to dial and the URI to open on the daemon
+func parseURI(uri string, flags ConnectFlags) (string, string, string, error) {
+	if uri == "" {
+		uri = os.Getenv("LIBVIRT_DEFAULT_URI")
+	}
+	if uri == "" {
+		uri = "qemu:///system"
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", "", "", Error{
+			Code:    ERR_INVALID_ARG,
+			Domain:  FROM_NONE,
+			Message: err.Error(),
+			Level:   ERR_ERROR,
+		}
+	}
+
+	transport := ""
+	if idx := strings.Index(u.Scheme, "+"); idx != -1 {
+		transport = u.Scheme[idx+1:]
+		u.Scheme = u.Scheme[:idx]
+	}
+	if transport == "" {
+		if u.Host == "" {
+			transport = "unix"
+		} else {
+			transport = "tls"
+		}
+	}
+
+	query := u.Query()
+	socket := query.Get("socket")
+	query.Del("socket")
+	u.RawQuery = query.Encode()
+
+	var network, address string
+	switch transport {
+	case "unix":
+		network = "unix"
+		address = socket
+		if address == "" {
+			address = libvirtdSocket
+			if _, err := os.Stat(virtqemudSocket); err == nil && u.Scheme == "qemu" {
+				address = virtqemudSocket
+			}
+		}
+	case "tcp":
+		network = "tcp"
+		host := u.Hostname()
+		port := u.Port()
+		if port == "" {
+			port = defaultTCPPort
+		}
+		address = net.JoinHostPort(host, port)
+	default:
+		return "", "", "", Error{
+			Code:    ERR_NO_SUPPORT,
+			Domain:  FROM_NONE,
+			Message: "transport '" + transport + "' is not supported",
+			Level:   ERR_ERROR,
+		}
+	}
+
+	// The daemon is always local to itself, so it is only
+	// given the driver part of the URI
+	u.Host = ""
+	u.User = nil
+	return network, address, u.String(), nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectClose
+func (c *Connect) Close() (int, error) {
+	_, err := c.call(procConnectClose, nil)
+	c.shutdown(makeRPCError("connection closed"))
+	c.conn.Close()
+	if err != nil {
+		return -1, err
+	}
+	return 0, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectIsAlive
+func (c *Connect) IsAlive() (bool, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.err == nil, nil
+}
+
+func (c *Connect) send(p *packet) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	if _, err := c.conn.Write(p.encode()); err != nil {
+		return makeRPCError("unable to send packet: %s", err)
+	}
+	return nil
+}
+
+func (c *Connect) nextSerial() uint32 {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.serial++
+	return c.serial
+}
+
+// call invokes proc with an encoded argument struct and returns
+// the encoded reply struct
+func (c *Connect) call(proc procedure, args []byte) ([]byte, error) {
+	return c.callSerial(c.nextSerial(), proc, args)
+}
+
+func (c *Connect) callSerial(serial uint32, proc procedure, args []byte) ([]byte, error) {
+	ch := make(chan *packet, 1)
+
+	c.lock.Lock()
+	if c.err != nil {
+		err := c.err
+		c.lock.Unlock()
+		return nil, err
+	}
+	c.calls[serial] = ch
+	c.lock.Unlock()
+
+	err := c.send(&packet{
+		header: packetHeader{
+			Program:   remoteProgram,
+			Version:   remoteProtocolVersion,
+			Procedure: proc,
+			Type:      packetCall,
+			Serial:    serial,
+			Status:    statusOK,
+		},
+		payload: args,
+	})
+	if err != nil {
+		c.lock.Lock()
+		delete(c.calls, serial)
+		c.lock.Unlock()
+		return nil, err
+	}
+
+	var reply *packet
+	select {
+	case reply = <-ch:
+	case <-c.done:
+		// dispatch may have delivered the reply just before it saw
+		// the connection close, and it delivers nothing after that
+		select {
+		case reply = <-ch:
+		default:
+			c.lock.Lock()
+			delete(c.calls, serial)
+			err := c.err
+			c.lock.Unlock()
+			return nil, err
+		}
+	}
+	if reply.header.Status == statusError {
+		return nil, decodeError(reply.payload)
+	}
+	return reply.payload, nil
+}
+
+// dispatch reads all incoming packets, routing replies to the
+// goroutine waiting in call, stream data to its Stream, and
+// events to the event queue
+func (c *Connect) dispatch() {
+	for {
+		p, err := readPacket(c.conn)
+		if err != nil {
+			c.shutdown(makeRPCError("connection lost: %s", err))
+			return
+		}
+
+		if p.header.Program == keepaliveProgram {
+			c.handleKeepalive(p)
+			continue
+		}
+		if p.header.Program != remoteProgram {
+			continue
+		}
+
+		switch p.header.Type {
+		case packetReply, packetReplyWithFDs:
+			c.lock.Lock()
+			ch, ok := c.calls[p.header.Serial]
+			delete(c.calls, p.header.Serial)
+			c.lock.Unlock()
+			if ok {
+				ch <- p
+			}
+
+		case packetStream, packetStreamHole:
+			c.lock.Lock()
+			st, ok := c.streams[p.header.Serial]
+			c.lock.Unlock()
+			if ok {
+				st.push(p)
+			}
+
+		case packetMessage:
+			c.handleEvent(p)
+		}
+	}
+}
+
+func (c *Connect) handleKeepalive(p *packet) {
+	if p.header.Procedure != keepaliveProcPing {
+		return
+	}
+	c.send(&packet{
+		header: packetHeader{
+			Program:   keepaliveProgram,
+			Version:   keepaliveProtocolVersion,
+			Procedure: keepaliveProcPong,
+			Type:      packetMessage,
+			Serial:    0,
+			Status:    statusOK,
+		},
+	})
+}
+
+// shutdown marks the connection as dead, failing all outstanding
+// calls and streams with err
+func (c *Connect) shutdown(err error) {
+	c.lock.Lock()
+	if c.err != nil {
+		c.lock.Unlock()
+		return
+	}
+	c.err = err
+	streams := c.streams
+	c.streams = make(map[uint32]*Stream)
+	close(c.done)
+	c.lock.Unlock()
+
+	for _, st := range streams {
+		st.fail(err)
+	}
+	c.events.stop()
+}
@@ -0,0 +1,454 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+type ConnectListAllDomainsFlags int
+
+const (
+	CONNECT_LIST_DOMAINS_ACTIVE         = ConnectListAllDomainsFlags(1 << 0)
+	CONNECT_LIST_DOMAINS_INACTIVE       = ConnectListAllDomainsFlags(1 << 1)
+	CONNECT_LIST_DOMAINS_PERSISTENT     = ConnectListAllDomainsFlags(1 << 2)
+	CONNECT_LIST_DOMAINS_TRANSIENT      = ConnectListAllDomainsFlags(1 << 3)
+	CONNECT_LIST_DOMAINS_RUNNING        = ConnectListAllDomainsFlags(1 << 4)
+	CONNECT_LIST_DOMAINS_PAUSED         = ConnectListAllDomainsFlags(1 << 5)
+	CONNECT_LIST_DOMAINS_SHUTOFF        = ConnectListAllDomainsFlags(1 << 6)
+	CONNECT_LIST_DOMAINS_OTHER          = ConnectListAllDomainsFlags(1 << 7)
+	CONNECT_LIST_DOMAINS_MANAGEDSAVE    = ConnectListAllDomainsFlags(1 << 8)
+	CONNECT_LIST_DOMAINS_NO_MANAGEDSAVE = ConnectListAllDomainsFlags(1 << 9)
+	CONNECT_LIST_DOMAINS_AUTOSTART      = ConnectListAllDomainsFlags(1 << 10)
+	CONNECT_LIST_DOMAINS_NO_AUTOSTART   = ConnectListAllDomainsFlags(1 << 11)
+	CONNECT_LIST_DOMAINS_HAS_SNAPSHOT   = ConnectListAllDomainsFlags(1 << 12)
+	CONNECT_LIST_DOMAINS_NO_SNAPSHOT    = ConnectListAllDomainsFlags(1 << 13)
+)
+
+type ConnectListAllNetworksFlags int
+
+const (
+	CONNECT_LIST_NETWORKS_INACTIVE     = ConnectListAllNetworksFlags(1 << 0)
+	CONNECT_LIST_NETWORKS_ACTIVE       = ConnectListAllNetworksFlags(1 << 1)
+	CONNECT_LIST_NETWORKS_PERSISTENT   = ConnectListAllNetworksFlags(1 << 2)
+	CONNECT_LIST_NETWORKS_TRANSIENT    = ConnectListAllNetworksFlags(1 << 3)
+	CONNECT_LIST_NETWORKS_AUTOSTART    = ConnectListAllNetworksFlags(1 << 4)
+	CONNECT_LIST_NETWORKS_NO_AUTOSTART = ConnectListAllNetworksFlags(1 << 5)
+)
+
+type ConnectListAllStoragePoolsFlags int
+
+const (
+	CONNECT_LIST_STORAGE_POOLS_INACTIVE     = ConnectListAllStoragePoolsFlags(1 << 0)
+	CONNECT_LIST_STORAGE_POOLS_ACTIVE       = ConnectListAllStoragePoolsFlags(1 << 1)
+	CONNECT_LIST_STORAGE_POOLS_PERSISTENT   = ConnectListAllStoragePoolsFlags(1 << 2)
+	CONNECT_LIST_STORAGE_POOLS_TRANSIENT    = ConnectListAllStoragePoolsFlags(1 << 3)
+	CONNECT_LIST_STORAGE_POOLS_AUTOSTART    = ConnectListAllStoragePoolsFlags(1 << 4)
+	CONNECT_LIST_STORAGE_POOLS_NO_AUTOSTART = ConnectListAllStoragePoolsFlags(1 << 5)
+)
+
+const uuidBuflen = 16
+
+func parseUUIDString(uuid string) ([uuidBuflen]byte, error) {
+	var ret [uuidBuflen]byte
+	raw, err := hex.DecodeString(strings.Replace(uuid, "-", "", -1))
+	if err != nil || len(raw) != uuidBuflen {
+		return ret, Error{
+			Code:    ERR_INVALID_ARG,
+			Domain:  FROM_NONE,
+			Message: fmt.Sprintf("malformed UUID '%s'", uuid),
+			Level:   ERR_ERROR,
+		}
+	}
+	copy(ret[:], raw)
+	return ret, nil
+}
+
+func parseUUID(uuid []byte) ([uuidBuflen]byte, error) {
+	var ret [uuidBuflen]byte
+	if len(uuid) != uuidBuflen {
+		return ret, fmt.Errorf("UUID must be exactly %d bytes in size", uuidBuflen)
+	}
+	copy(ret[:], uuid)
+	return ret, nil
+}
+
+func formatUUID(uuid [uuidBuflen]byte) string {
+	s := hex.EncodeToString(uuid[:])
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:32]
+}
+
+func (c *Connect) callString(proc procedure, args []byte) (string, error) {
+	ret, err := c.call(proc, args)
+	if err != nil {
+		return "", err
+	}
+	dec := &xdrDecoder{buf: ret}
+	val := dec.string()
+	if dec.err != nil {
+		return "", makeRPCError("%s", dec.err)
+	}
+	return val, nil
+}
+
+func (c *Connect) callInt(proc procedure, args []byte) (int32, error) {
+	ret, err := c.call(proc, args)
+	if err != nil {
+		return 0, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	val := dec.int32()
+	if dec.err != nil {
+		return 0, makeRPCError("%s", dec.err)
+	}
+	return val, nil
+}
+
+func (c *Connect) callUint64(proc procedure, args []byte) (uint64, error) {
+	ret, err := c.call(proc, args)
+	if err != nil {
+		return 0, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	val := dec.uint64()
+	if dec.err != nil {
+		return 0, makeRPCError("%s", dec.err)
+	}
+	return val, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetType
+func (c *Connect) GetType() (string, error) {
+	return c.callString(procConnectGetType, nil)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetVersion
+func (c *Connect) GetVersion() (uint32, error) {
+	ver, err := c.callUint64(procConnectGetVersion, nil)
+	return uint32(ver), err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetLibVersion
+func (c *Connect) GetLibVersion() (uint32, error) {
+	ver, err := c.callUint64(procConnectGetLibVersion, nil)
+	return uint32(ver), err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetHostname
+func (c *Connect) GetHostname() (string, error) {
+	return c.callString(procConnectGetHostname, nil)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetURI
+func (c *Connect) GetURI() (string, error) {
+	return c.callString(procConnectGetURI, nil)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-host.html#virConnectGetCapabilities
+func (c *Connect) GetCapabilities() (string, error) {
+	return c.callString(procConnectGetCapabilities, nil)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectListAllDomains
+func (c *Connect) ListAllDomains(flags ConnectListAllDomainsFlags) ([]Domain, error) {
+	enc := &xdrEncoder{}
+	enc.int32(1)
+	enc.uint32(uint32(flags))
+	ret, err := c.call(procConnectListAllDomains, enc.buf)
+	if err != nil {
+		return []Domain{}, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	n := dec.arrayLen(domainListMax)
+	doms := make([]Domain, n)
+	for i := 0; i < n; i++ {
+		doms[i] = *dec.domain(c)
+	}
+	dec.uint32()
+	if dec.err != nil {
+		return []Domain{}, makeRPCError("%s", dec.err)
+	}
+	return doms, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virConnectListAllNetworks
+func (c *Connect) ListAllNetworks(flags ConnectListAllNetworksFlags) ([]Network, error) {
+	enc := &xdrEncoder{}
+	enc.int32(1)
+	enc.uint32(uint32(flags))
+	ret, err := c.call(procConnectListAllNetworks, enc.buf)
+	if err != nil {
+		return []Network{}, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	n := dec.arrayLen(networkListMax)
+	nets := make([]Network, n)
+	for i := 0; i < n; i++ {
+		nets[i] = *dec.network(c)
+	}
+	dec.uint32()
+	if dec.err != nil {
+		return []Network{}, makeRPCError("%s", dec.err)
+	}
+	return nets, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virConnectListAllStoragePools
+func (c *Connect) ListAllStoragePools(flags ConnectListAllStoragePoolsFlags) ([]StoragePool, error) {
+	enc := &xdrEncoder{}
+	enc.int32(1)
+	enc.uint32(uint32(flags))
+	ret, err := c.call(procConnectListAllStoragePools, enc.buf)
+	if err != nil {
+		return []StoragePool{}, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	n := dec.arrayLen(storagePoolListMax)
+	pools := make([]StoragePool, n)
+	for i := 0; i < n; i++ {
+		pools[i] = *dec.storagePool(c)
+	}
+	dec.uint32()
+	if dec.err != nil {
+		return []StoragePool{}, makeRPCError("%s", dec.err)
+	}
+	return pools, nil
+}
+
+func (c *Connect) callDomain(proc procedure, args []byte) (*Domain, error) {
+	ret, err := c.call(proc, args)
+	if err != nil {
+		return nil, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	dom := dec.domain(c)
+	if dec.err != nil {
+		return nil, makeRPCError("%s", dec.err)
+	}
+	return dom, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainLookupByID
+func (c *Connect) LookupDomainById(id uint32) (*Domain, error) {
+	enc := &xdrEncoder{}
+	enc.int32(int32(id))
+	return c.callDomain(procDomainLookupByID, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainLookupByName
+func (c *Connect) LookupDomainByName(id string) (*Domain, error) {
+	enc := &xdrEncoder{}
+	enc.string(id)
+	return c.callDomain(procDomainLookupByName, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainLookupByUUIDString
+func (c *Connect) LookupDomainByUUIDString(uuid string) (*Domain, error) {
+	raw, err := parseUUIDString(uuid)
+	if err != nil {
+		return nil, err
+	}
+	enc := &xdrEncoder{}
+	enc.fixedOpaque(raw[:])
+	return c.callDomain(procDomainLookupByUUID, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainLookupByUUID
+func (c *Connect) LookupDomainByUUID(uuid []byte) (*Domain, error) {
+	raw, err := parseUUID(uuid)
+	if err != nil {
+		return nil, err
+	}
+	enc := &xdrEncoder{}
+	enc.fixedOpaque(raw[:])
+	return c.callDomain(procDomainLookupByUUID, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainCreateXML
+func (c *Connect) DomainCreateXML(xmlConfig string, flags DomainCreateFlags) (*Domain, error) {
+	enc := &xdrEncoder{}
+	enc.string(xmlConfig)
+	enc.uint32(uint32(flags))
+	return c.callDomain(procDomainCreateXML, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDefineXML
+func (c *Connect) DomainDefineXML(xmlConfig string) (*Domain, error) {
+	enc := &xdrEncoder{}
+	enc.string(xmlConfig)
+	return c.callDomain(procDomainDefineXML, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDefineXMLFlags
+func (c *Connect) DomainDefineXMLFlags(xmlConfig string, flags DomainDefineFlags) (*Domain, error) {
+	enc := &xdrEncoder{}
+	enc.string(xmlConfig)
+	enc.uint32(uint32(flags))
+	return c.callDomain(procDomainDefineXMLFlags, enc.buf)
+}
+
+func (c *Connect) callNetwork(proc procedure, args []byte) (*Network, error) {
+	ret, err := c.call(proc, args)
+	if err != nil {
+		return nil, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	net := dec.network(c)
+	if dec.err != nil {
+		return nil, makeRPCError("%s", dec.err)
+	}
+	return net, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkLookupByName
+func (c *Connect) LookupNetworkByName(name string) (*Network, error) {
+	enc := &xdrEncoder{}
+	enc.string(name)
+	return c.callNetwork(procNetworkLookupByName, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkLookupByUUIDString
+func (c *Connect) LookupNetworkByUUIDString(uuid string) (*Network, error) {
+	raw, err := parseUUIDString(uuid)
+	if err != nil {
+		return nil, err
+	}
+	enc := &xdrEncoder{}
+	enc.fixedOpaque(raw[:])
+	return c.callNetwork(procNetworkLookupByUUID, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkLookupByUUID
+func (c *Connect) LookupNetworkByUUID(uuid []byte) (*Network, error) {
+	raw, err := parseUUID(uuid)
+	if err != nil {
+		return nil, err
+	}
+	enc := &xdrEncoder{}
+	enc.fixedOpaque(raw[:])
+	return c.callNetwork(procNetworkLookupByUUID, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkDefineXML
+func (c *Connect) NetworkDefineXML(xmlConfig string) (*Network, error) {
+	enc := &xdrEncoder{}
+	enc.string(xmlConfig)
+	return c.callNetwork(procNetworkDefineXML, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkCreateXML
+func (c *Connect) NetworkCreateXML(xmlConfig string) (*Network, error) {
+	enc := &xdrEncoder{}
+	enc.string(xmlConfig)
+	return c.callNetwork(procNetworkCreateXML, enc.buf)
+}
+
+func (c *Connect) callStoragePool(proc procedure, args []byte) (*StoragePool, error) {
+	ret, err := c.call(proc, args)
+	if err != nil {
+		return nil, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	pool := dec.storagePool(c)
+	if dec.err != nil {
+		return nil, makeRPCError("%s", dec.err)
+	}
+	return pool, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolLookupByName
+func (c *Connect) LookupStoragePoolByName(name string) (*StoragePool, error) {
+	enc := &xdrEncoder{}
+	enc.string(name)
+	return c.callStoragePool(procStoragePoolLookupByName, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolLookupByUUIDString
+func (c *Connect) LookupStoragePoolByUUIDString(uuid string) (*StoragePool, error) {
+	raw, err := parseUUIDString(uuid)
+	if err != nil {
+		return nil, err
+	}
+	enc := &xdrEncoder{}
+	enc.fixedOpaque(raw[:])
+	return c.callStoragePool(procStoragePoolLookupByUUID, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolLookupByUUID
+func (c *Connect) LookupStoragePoolByUUID(uuid []byte) (*StoragePool, error) {
+	raw, err := parseUUID(uuid)
+	if err != nil {
+		return nil, err
+	}
+	enc := &xdrEncoder{}
+	enc.fixedOpaque(raw[:])
+	return c.callStoragePool(procStoragePoolLookupByUUID, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolDefineXML
+func (c *Connect) StoragePoolDefineXML(xmlConfig string, flags uint32) (*StoragePool, error) {
+	enc := &xdrEncoder{}
+	enc.string(xmlConfig)
+	enc.uint32(flags)
+	return c.callStoragePool(procStoragePoolDefineXML, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolCreateXML
+func (c *Connect) StoragePoolCreateXML(xmlConfig string, flags StoragePoolCreateFlags) (*StoragePool, error) {
+	enc := &xdrEncoder{}
+	enc.string(xmlConfig)
+	enc.uint32(uint32(flags))
+	return c.callStoragePool(procStoragePoolCreateXML, enc.buf)
+}
+
+func (c *Connect) callStorageVol(proc procedure, args []byte) (*StorageVol, error) {
+	ret, err := c.call(proc, args)
+	if err != nil {
+		return nil, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	vol := dec.storageVol(c)
+	if dec.err != nil {
+		return nil, makeRPCError("%s", dec.err)
+	}
+	return vol, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolLookupByKey
+func (c *Connect) LookupStorageVolByKey(key string) (*StorageVol, error) {
+	enc := &xdrEncoder{}
+	enc.string(key)
+	return c.callStorageVol(procStorageVolLookupByKey, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolLookupByPath
+func (c *Connect) LookupStorageVolByPath(path string) (*StorageVol, error) {
+	enc := &xdrEncoder{}
+	enc.string(path)
+	return c.callStorageVol(procStorageVolLookupByPath, enc.buf)
+}
@@ -0,0 +1,133 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseURI(t *testing.T) {
+	tests := []struct {
+		uri     string
+		network string
+		address string
+		driver  string
+	}{
+		{"qemu:///session?socket=/tmp/sock", "unix", "/tmp/sock", "qemu:///session"},
+		{"qemu+unix:///system?socket=/tmp/sock", "unix", "/tmp/sock", "qemu:///system"},
+		{"qemu+tcp://example.com/system", "tcp", "example.com:16509", "qemu:///system"},
+		{"test+tcp://user@example.com:1234/default", "tcp", "example.com:1234", "test:///default"},
+	}
+
+	for _, test := range tests {
+		network, address, driver, err := parseURI(test.uri, 0)
+		if err != nil {
+			t.Errorf("%s: %s", test.uri, err)
+			continue
+		}
+		if network != test.network || address != test.address || driver != test.driver {
+			t.Errorf("%s: got %s %s %s", test.uri, network, address, driver)
+		}
+	}
+
+	_, _, _, err := parseURI("qemu+ssh://example.com/system", 0)
+	if !errors.Is(err, ERR_NO_SUPPORT) {
+		t.Errorf("Expected unsupported transport, got %v", err)
+	}
+}
+
+func TestConnect(t *testing.T) {
+	conn, wait := replayConnect(t, "connect.txt")
+
+	typ, err := conn.GetType()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if typ != "Test" {
+		t.Errorf("Unexpected type %q", typ)
+	}
+
+	ver, err := conn.GetLibVersion()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ver != 9008000 {
+		t.Errorf("Unexpected lib version %d", ver)
+	}
+
+	hostname, err := conn.GetHostname()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hostname != "replay.example.com" {
+		t.Errorf("Unexpected hostname %q", hostname)
+	}
+
+	uri, err := conn.GetURI()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uri != "test:///default" {
+		t.Errorf("Unexpected URI %q", uri)
+	}
+
+	if _, err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wait()
+
+	if alive, _ := conn.IsAlive(); alive {
+		t.Error("Connection should not be alive after close")
+	}
+	if _, err := conn.GetType(); err == nil {
+		t.Error("Expected error calling a closed connection")
+	}
+}
+
+func TestConnectError(t *testing.T) {
+	conn, wait := replayConnect(t, "error.txt")
+
+	_, err := conn.LookupDomainByName("missing")
+	if err == nil {
+		t.Fatal("Expected lookup of missing domain to fail")
+	}
+	lverr, ok := err.(Error)
+	if !ok {
+		t.Fatalf("Unexpected error type %T", err)
+	}
+	if lverr.Code != 42 || lverr.Domain != 10 || lverr.Level != ERR_ERROR {
+		t.Errorf("Unexpected error %v", lverr)
+	}
+	if lverr.Message != "Domain not found: no domain with matching name 'missing'" {
+		t.Errorf("Unexpected error message %q", lverr.Message)
+	}
+
+	if _, err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wait()
+}
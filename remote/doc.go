@@ -0,0 +1,46 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+// Package remote provides a pure Go client for the libvirt daemons
+//
+// Rather than calling into libvirt.so via cgo, it speaks the libvirt
+// RPC protocol directly over the UNIX socket of libvirtd or virtqemud,
+// or over a plain TCP socket. This makes it possible to build static
+// binaries, and to cross-compile, without the libvirt headers.
+//
+// The types and methods are named after those in the main libvirt
+// package, so that code using the subset of APIs covered here can
+// switch between the two with few changes. Only the 'unix' and 'tcp'
+// transports are handled; SASL and TLS are not implemented, so the
+// daemon must allow unauthenticated access on the chosen socket.
+// Other transports may be layered on top with NewConnectFromNetConn.
+//
+// Object handles such as Domain are lightweight references which hold
+// no client side resources, so calling Free on them is optional.
+//
+// Event callbacks are invoked in the order they are received, on a
+// goroutine owned by the Connect. There is no need to run an event
+// loop implementation as there is with the cgo binding.
+package remote
@@ -0,0 +1,349 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+type DomainState int
+
+const (
+	DOMAIN_NOSTATE     = DomainState(0)
+	DOMAIN_RUNNING     = DomainState(1)
+	DOMAIN_BLOCKED     = DomainState(2)
+	DOMAIN_PAUSED      = DomainState(3)
+	DOMAIN_SHUTDOWN    = DomainState(4)
+	DOMAIN_SHUTOFF     = DomainState(5)
+	DOMAIN_CRASHED     = DomainState(6)
+	DOMAIN_PMSUSPENDED = DomainState(7)
+)
+
+type DomainCreateFlags int
+
+const (
+	DOMAIN_NONE               = DomainCreateFlags(0)
+	DOMAIN_START_PAUSED       = DomainCreateFlags(1 << 0)
+	DOMAIN_START_AUTODESTROY  = DomainCreateFlags(1 << 1)
+	DOMAIN_START_BYPASS_CACHE = DomainCreateFlags(1 << 2)
+	DOMAIN_START_FORCE_BOOT   = DomainCreateFlags(1 << 3)
+	DOMAIN_START_VALIDATE     = DomainCreateFlags(1 << 4)
+	DOMAIN_START_RESET_NVRAM  = DomainCreateFlags(1 << 5)
+)
+
+type DomainDefineFlags int
+
+const (
+	DOMAIN_DEFINE_VALIDATE = DomainDefineFlags(1 << 0)
+)
+
+type DomainDestroyFlags int
+
+const (
+	DOMAIN_DESTROY_DEFAULT  = DomainDestroyFlags(0)
+	DOMAIN_DESTROY_GRACEFUL = DomainDestroyFlags(1 << 0)
+)
+
+type DomainShutdownFlags int
+
+const (
+	DOMAIN_SHUTDOWN_DEFAULT        = DomainShutdownFlags(0)
+	DOMAIN_SHUTDOWN_ACPI_POWER_BTN = DomainShutdownFlags(1 << 0)
+	DOMAIN_SHUTDOWN_GUEST_AGENT    = DomainShutdownFlags(1 << 1)
+	DOMAIN_SHUTDOWN_INITCTL        = DomainShutdownFlags(1 << 2)
+	DOMAIN_SHUTDOWN_SIGNAL         = DomainShutdownFlags(1 << 3)
+	DOMAIN_SHUTDOWN_PARAVIRT       = DomainShutdownFlags(1 << 4)
+)
+
+type DomainRebootFlagValues int
+
+const (
+	DOMAIN_REBOOT_DEFAULT        = DomainRebootFlagValues(0)
+	DOMAIN_REBOOT_ACPI_POWER_BTN = DomainRebootFlagValues(1 << 0)
+	DOMAIN_REBOOT_GUEST_AGENT    = DomainRebootFlagValues(1 << 1)
+	DOMAIN_REBOOT_INITCTL        = DomainRebootFlagValues(1 << 2)
+	DOMAIN_REBOOT_SIGNAL         = DomainRebootFlagValues(1 << 3)
+	DOMAIN_REBOOT_PARAVIRT       = DomainRebootFlagValues(1 << 4)
+)
+
+type DomainUndefineFlagsValues int
+
+const (
+	DOMAIN_UNDEFINE_MANAGED_SAVE         = DomainUndefineFlagsValues(1 << 0) // Also remove any managed save
+	DOMAIN_UNDEFINE_SNAPSHOTS_METADATA   = DomainUndefineFlagsValues(1 << 1) // If last use of domain, then also remove any snapshot metadata
+	DOMAIN_UNDEFINE_NVRAM                = DomainUndefineFlagsValues(1 << 2) // Also remove any nvram file
+	DOMAIN_UNDEFINE_KEEP_NVRAM           = DomainUndefineFlagsValues(1 << 3) // Keep nvram file
+	DOMAIN_UNDEFINE_CHECKPOINTS_METADATA = DomainUndefineFlagsValues(1 << 4) // If last use of domain, then also remove any checkpoint metadata
+)
+
+type DomainXMLFlags int
+
+const (
+	DOMAIN_XML_SECURE     = DomainXMLFlags(1 << 0) /* dump security sensitive information too */
+	DOMAIN_XML_INACTIVE   = DomainXMLFlags(1 << 1) /* dump inactive domain information */
+	DOMAIN_XML_UPDATE_CPU = DomainXMLFlags(1 << 2) /* update guest CPU requirements according to host CPU */
+	DOMAIN_XML_MIGRATABLE = DomainXMLFlags(1 << 3) /* dump XML suitable for migration */
+)
+
+// Domain is a reference to a domain on the daemon. Unlike the cgo
+// binding there is no client side object to release, but Free is
+// provided so that code can be switched between the two.
+type Domain struct {
+	conn *Connect
+	name string
+	uuid [uuidBuflen]byte
+	id   int32
+}
+
+type DomainInfo struct {
+	State     DomainState
+	MaxMem    uint64
+	Memory    uint64
+	NrVirtCpu uint
+	CpuTime   uint64
+}
+
+func (e *xdrEncoder) domain(d *Domain) {
+	e.string(d.name)
+	e.fixedOpaque(d.uuid[:])
+	e.int32(d.id)
+}
+
+func (d *xdrDecoder) domain(c *Connect) *Domain {
+	dom := &Domain{conn: c}
+	dom.name = d.string()
+	copy(dom.uuid[:], d.fixedOpaque(uuidBuflen))
+	dom.id = d.int32()
+	return dom
+}
+
+func (d *Domain) call(proc procedure, flags ...uint32) ([]byte, error) {
+	enc := &xdrEncoder{}
+	enc.domain(d)
+	for _, flag := range flags {
+		enc.uint32(flag)
+	}
+	return d.conn.call(proc, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainFree
+func (d *Domain) Free() error {
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainRef
+func (d *Domain) Ref() error {
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetName
+func (d *Domain) GetName() (string, error) {
+	return d.name, nil
+}
+
+// GetID returns the ID the domain had when this reference was
+// obtained, as the cgo binding does. It is -1 (as a uint) for
+// inactive domains.
+//
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetID
+func (d *Domain) GetID() (uint, error) {
+	if d.id == -1 {
+		return ^uint(0), Error{
+			Code:    ERR_INVALID_ARG,
+			Domain:  FROM_NONE,
+			Message: "domain is not running",
+			Level:   ERR_ERROR,
+		}
+	}
+	return uint(d.id), nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetUUID
+func (d *Domain) GetUUID() ([]byte, error) {
+	uuid := make([]byte, uuidBuflen)
+	copy(uuid, d.uuid[:])
+	return uuid, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetUUIDString
+func (d *Domain) GetUUIDString() (string, error) {
+	return formatUUID(d.uuid), nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainCreate
+func (d *Domain) Create() error {
+	_, err := d.call(procDomainCreate)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainCreateWithFlags
+func (d *Domain) CreateWithFlags(flags DomainCreateFlags) error {
+	ret, err := d.call(procDomainCreateWithFlags, uint32(flags))
+	if err != nil {
+		return err
+	}
+	// The reply carries the domain again so that the new ID is
+	// known without a further lookup
+	dec := &xdrDecoder{buf: ret}
+	dom := dec.domain(d.conn)
+	if dec.err != nil {
+		return makeRPCError("%s", dec.err)
+	}
+	d.id = dom.id
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDestroy
+func (d *Domain) Destroy() error {
+	_, err := d.call(procDomainDestroy)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainDestroyFlags
+func (d *Domain) DestroyFlags(flags DomainDestroyFlags) error {
+	_, err := d.call(procDomainDestroyFlags, uint32(flags))
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainShutdown
+func (d *Domain) Shutdown() error {
+	_, err := d.call(procDomainShutdown)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainShutdownFlags
+func (d *Domain) ShutdownFlags(flags DomainShutdownFlags) error {
+	_, err := d.call(procDomainShutdownFlags, uint32(flags))
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainReboot
+func (d *Domain) Reboot(flags DomainRebootFlagValues) error {
+	_, err := d.call(procDomainReboot, uint32(flags))
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSuspend
+func (d *Domain) Suspend() error {
+	_, err := d.call(procDomainSuspend)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainResume
+func (d *Domain) Resume() error {
+	_, err := d.call(procDomainResume)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainUndefine
+func (d *Domain) Undefine() error {
+	_, err := d.call(procDomainUndefine)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainUndefineFlags
+func (d *Domain) UndefineFlags(flags DomainUndefineFlagsValues) error {
+	_, err := d.call(procDomainUndefineFlags, uint32(flags))
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetXMLDesc
+func (d *Domain) GetXMLDesc(flags DomainXMLFlags) (string, error) {
+	ret, err := d.call(procDomainGetXMLDesc, uint32(flags))
+	if err != nil {
+		return "", err
+	}
+	dec := &xdrDecoder{buf: ret}
+	xml := dec.string()
+	if dec.err != nil {
+		return "", makeRPCError("%s", dec.err)
+	}
+	return xml, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetState
+func (d *Domain) GetState() (DomainState, int, error) {
+	ret, err := d.call(procDomainGetState, 0)
+	if err != nil {
+		return 0, 0, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	state := dec.int32()
+	reason := dec.int32()
+	if dec.err != nil {
+		return 0, 0, makeRPCError("%s", dec.err)
+	}
+	return DomainState(state), int(reason), nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetInfo
+func (d *Domain) GetInfo() (*DomainInfo, error) {
+	ret, err := d.call(procDomainGetInfo)
+	if err != nil {
+		return nil, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	info := &DomainInfo{
+		State:     DomainState(dec.uint32()),
+		MaxMem:    dec.uint64(),
+		Memory:    dec.uint64(),
+		NrVirtCpu: uint(dec.uint32()),
+		CpuTime:   dec.uint64(),
+	}
+	if dec.err != nil {
+		return nil, makeRPCError("%s", dec.err)
+	}
+	return info, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainIsActive
+func (d *Domain) IsActive() (bool, error) {
+	state, _, err := d.GetState()
+	if err != nil {
+		return false, err
+	}
+	return state != DOMAIN_SHUTOFF, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainGetAutostart
+func (d *Domain) GetAutostart() (bool, error) {
+	ret, err := d.call(procDomainGetAutostart)
+	if err != nil {
+		return false, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	autostart := dec.int32()
+	if dec.err != nil {
+		return false, makeRPCError("%s", dec.err)
+	}
+	return autostart != 0, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virDomainSetAutostart
+func (d *Domain) SetAutostart(autostart bool) error {
+	var cAutostart uint32
+	if autostart {
+		cAutostart = 1
+	}
+	_, err := d.call(procDomainSetAutostart, cAutostart)
+	return err
+}
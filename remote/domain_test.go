@@ -0,0 +1,130 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"testing"
+)
+
+func TestDomain(t *testing.T) {
+	conn, wait := replayConnect(t, "domain.txt")
+
+	dom, err := conn.LookupDomainByName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	uuid, err := dom.GetUUIDString()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uuid != "6695eb01-f6a4-8304-79aa-97f2502e193f" {
+		t.Errorf("Unexpected UUID %s", uuid)
+	}
+	id, err := dom.GetID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 1 {
+		t.Errorf("Unexpected ID %d", id)
+	}
+
+	state, reason, err := dom.GetState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != DOMAIN_RUNNING || reason != 1 {
+		t.Errorf("Unexpected state %d reason %d", state, reason)
+	}
+
+	info, err := dom.GetInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.State != DOMAIN_RUNNING || info.MaxMem != 8388608 || info.Memory != 2097152 ||
+		info.NrVirtCpu != 2 || info.CpuTime != 123456789 {
+		t.Errorf("Unexpected info %+v", info)
+	}
+
+	xml, err := dom.GetXMLDesc(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if xml != "<domain type='test'><name>test</name></domain>" {
+		t.Errorf("Unexpected XML %s", xml)
+	}
+
+	if err := dom.Suspend(); err != nil {
+		t.Fatal(err)
+	}
+	if err := dom.Resume(); err != nil {
+		t.Fatal(err)
+	}
+
+	doms, err := conn.ListAllDomains(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(doms) != 2 {
+		t.Fatalf("Expected 2 domains, got %d", len(doms))
+	}
+	name, _ := doms[1].GetName()
+	if name != "other" {
+		t.Errorf("Unexpected domain name %s", name)
+	}
+	if _, err := doms[1].GetID(); err == nil {
+		t.Error("Expected error getting ID of inactive domain")
+	}
+
+	other, err := conn.LookupDomainByUUIDString("a7a2ddd6-6a4c-4c37-8f3e-9c3d6a6ec5e1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.CreateWithFlags(0); err != nil {
+		t.Fatal(err)
+	}
+	id, err = other.GetID()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 2 {
+		t.Errorf("Unexpected ID %d after start", id)
+	}
+
+	if _, err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wait()
+}
+
+func TestDomainLookupBadUUID(t *testing.T) {
+	conn := &Connect{}
+	if _, err := conn.LookupDomainByUUIDString("not-a-uuid"); err == nil {
+		t.Error("Expected error for malformed UUID")
+	}
+	if _, err := conn.LookupDomainByUUID([]byte{1, 2, 3}); err == nil {
+		t.Error("Expected error for short UUID")
+	}
+}
@@ -0,0 +1,114 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"fmt"
+)
+
+type ErrorLevel int
+
+const (
+	ERR_NONE    = ErrorLevel(0)
+	ERR_WARNING = ErrorLevel(1)
+	ERR_ERROR   = ErrorLevel(2)
+)
+
+type ErrorNumber int
+
+// The subset of libvirt error codes generated on the client side.
+// Errors reported by the daemon carry whatever code it sent.
+const (
+	ERR_OK             = ErrorNumber(0)
+	ERR_INTERNAL_ERROR = ErrorNumber(1)
+	ERR_NO_SUPPORT     = ErrorNumber(3)
+	ERR_INVALID_ARG    = ErrorNumber(8)
+	ERR_RPC            = ErrorNumber(39)
+)
+
+type ErrorDomain int
+
+const (
+	FROM_NONE = ErrorDomain(0)
+	FROM_RPC  = ErrorDomain(7)
+)
+
+// Error mirrors the Error type of the cgo binding, so that callers
+// can inspect failures the same way with either implementation.
+type Error struct {
+	Code    ErrorNumber
+	Domain  ErrorDomain
+	Message string
+	Level   ErrorLevel
+}
+
+func (err Error) Error() string {
+	return fmt.Sprintf("virError(Code=%d, Domain=%d, Message='%s')",
+		err.Code, err.Domain, err.Message)
+}
+
+func (err Error) Is(target error) bool {
+	n, ok := target.(ErrorNumber)
+	if !ok {
+		return false
+	}
+
+	return err.Code == n
+}
+
+func (err ErrorNumber) Error() string {
+	return fmt.Sprintf("virErrorNumber(%d)", int(err))
+}
+
+func makeRPCError(format string, args ...interface{}) Error {
+	return Error{
+		Code:    ERR_RPC,
+		Domain:  FROM_RPC,
+		Message: fmt.Sprintf(format, args...),
+		Level:   ERR_ERROR,
+	}
+}
+
+// decodeError parses a 'remote_error' struct sent in the payload
+// of a packet with an error status
+func decodeError(payload []byte) Error {
+	dec := &xdrDecoder{buf: payload}
+	code := dec.int32()
+	domain := dec.int32()
+	message := dec.optionalString()
+	level := dec.int32()
+	// The remaining fields (dom, str1-3, int1-2, net) are not
+	// exposed by the public Error type
+	if dec.err != nil {
+		return makeRPCError("unable to decode error from daemon: %s", dec.err)
+	}
+	return Error{
+		Code:    ErrorNumber(code),
+		Domain:  ErrorDomain(domain),
+		Message: message,
+		Level:   ErrorLevel(level),
+	}
+}
@@ -0,0 +1,245 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"sync"
+)
+
+type DomainEventType int
+
+const (
+	DOMAIN_EVENT_DEFINED     = DomainEventType(0)
+	DOMAIN_EVENT_UNDEFINED   = DomainEventType(1)
+	DOMAIN_EVENT_STARTED     = DomainEventType(2)
+	DOMAIN_EVENT_SUSPENDED   = DomainEventType(3)
+	DOMAIN_EVENT_RESUMED     = DomainEventType(4)
+	DOMAIN_EVENT_STOPPED     = DomainEventType(5)
+	DOMAIN_EVENT_SHUTDOWN    = DomainEventType(6)
+	DOMAIN_EVENT_PMSUSPENDED = DomainEventType(7)
+	DOMAIN_EVENT_CRASHED     = DomainEventType(8)
+)
+
+type DomainEventLifecycle struct {
+	Event DomainEventType
+	// TODO: we can make Detail typesafe somehow ?
+	Detail int
+}
+
+type DomainEventLifecycleCallback func(c *Connect, d *Domain, event *DomainEventLifecycle)
+
+type NetworkEventLifecycleType int
+
+const (
+	NETWORK_EVENT_DEFINED   = NetworkEventLifecycleType(0)
+	NETWORK_EVENT_UNDEFINED = NetworkEventLifecycleType(1)
+	NETWORK_EVENT_STARTED   = NetworkEventLifecycleType(2)
+	NETWORK_EVENT_STOPPED   = NetworkEventLifecycleType(3)
+)
+
+type NetworkEventLifecycle struct {
+	Event NetworkEventLifecycleType
+	// TODO: we can make Detail typesafe somehow ?
+	Detail int
+}
+
+type NetworkEventLifecycleCallback func(c *Connect, n *Network, event *NetworkEventLifecycle)
+
+const (
+	domainEventIDLifecycle  = 0
+	networkEventIDLifecycle = 0
+)
+
+// eventQueue runs event callbacks in order on a goroutine of its
+// own, so that a slow callback never stalls RPC replies, and so
+// that callbacks are free to make API calls on the connection.
+type eventQueue struct {
+	lock    sync.Mutex
+	cond    *sync.Cond
+	pending []func()
+	stopped bool
+}
+
+func newEventQueue() *eventQueue {
+	q := &eventQueue{}
+	q.cond = sync.NewCond(&q.lock)
+	return q
+}
+
+func (q *eventQueue) push(fn func()) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.stopped {
+		return
+	}
+	q.pending = append(q.pending, fn)
+	q.cond.Signal()
+}
+
+func (q *eventQueue) stop() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.stopped = true
+	q.cond.Signal()
+}
+
+func (q *eventQueue) run() {
+	for {
+		q.lock.Lock()
+		for len(q.pending) == 0 && !q.stopped {
+			q.cond.Wait()
+		}
+		if len(q.pending) == 0 {
+			q.lock.Unlock()
+			return
+		}
+		fn := q.pending[0]
+		q.pending = q.pending[1:]
+		q.lock.Unlock()
+
+		fn()
+	}
+}
+
+// handleEvent decodes an event message from the daemon and queues
+// delivery to the matching callback
+func (c *Connect) handleEvent(p *packet) {
+	dec := &xdrDecoder{buf: p.payload}
+
+	switch p.header.Procedure {
+	case procDomainEventCallbackLifecycle:
+		callbackID := dec.int32()
+		dom := dec.domain(c)
+		event := &DomainEventLifecycle{
+			Event:  DomainEventType(dec.int32()),
+			Detail: int(dec.int32()),
+		}
+		if dec.err != nil {
+			return
+		}
+		c.events.push(func() {
+			c.eventLock.RLock()
+			callback, ok := c.domainEvents[callbackID]
+			c.eventLock.RUnlock()
+			if ok {
+				callback(c, dom, event)
+			}
+		})
+
+	case procNetworkEventLifecycle:
+		callbackID := dec.int32()
+		net := dec.network(c)
+		event := &NetworkEventLifecycle{
+			Event:  NetworkEventLifecycleType(dec.int32()),
+			Detail: int(dec.int32()),
+		}
+		if dec.err != nil {
+			return
+		}
+		c.events.push(func() {
+			c.eventLock.RLock()
+			callback, ok := c.networkEvents[callbackID]
+			c.eventLock.RUnlock()
+			if ok {
+				callback(c, net, event)
+			}
+		})
+	}
+}
+
+// registerEvent issues a register call, with the event lock held
+// until the callback is recorded so that no event can be delivered
+// before its callback is known
+func (c *Connect) registerEvent(proc procedure, args []byte, record func(int32)) (int, error) {
+	c.eventLock.Lock()
+	defer c.eventLock.Unlock()
+
+	ret, err := c.call(proc, args)
+	if err != nil {
+		return 0, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	callbackID := dec.int32()
+	if dec.err != nil {
+		return 0, makeRPCError("%s", dec.err)
+	}
+	record(callbackID)
+	return int(callbackID), nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectDomainEventRegisterAny
+func (c *Connect) DomainEventLifecycleRegister(dom *Domain, callback DomainEventLifecycleCallback) (int, error) {
+	enc := &xdrEncoder{}
+	enc.int32(domainEventIDLifecycle)
+	enc.bool(dom != nil)
+	if dom != nil {
+		enc.domain(dom)
+	}
+	return c.registerEvent(procConnectDomainEventCallbackRegisterAny, enc.buf, func(callbackID int32) {
+		c.domainEvents[callbackID] = callback
+	})
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-domain.html#virConnectDomainEventDeregisterAny
+func (c *Connect) DomainEventDeregister(callbackId int) error {
+	enc := &xdrEncoder{}
+	enc.int32(int32(callbackId))
+	if _, err := c.call(procConnectDomainEventCallbackDeregisterAny, enc.buf); err != nil {
+		return err
+	}
+
+	c.eventLock.Lock()
+	delete(c.domainEvents, int32(callbackId))
+	c.eventLock.Unlock()
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virConnectNetworkEventRegisterAny
+func (c *Connect) NetworkEventLifecycleRegister(net *Network, callback NetworkEventLifecycleCallback) (int, error) {
+	enc := &xdrEncoder{}
+	enc.int32(networkEventIDLifecycle)
+	enc.bool(net != nil)
+	if net != nil {
+		enc.network(net)
+	}
+	return c.registerEvent(procConnectNetworkEventRegisterAny, enc.buf, func(callbackID int32) {
+		c.networkEvents[callbackID] = callback
+	})
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virConnectNetworkEventDeregisterAny
+func (c *Connect) NetworkEventDeregister(callbackId int) error {
+	enc := &xdrEncoder{}
+	enc.int32(int32(callbackId))
+	if _, err := c.call(procConnectNetworkEventDeregisterAny, enc.buf); err != nil {
+		return err
+	}
+
+	c.eventLock.Lock()
+	delete(c.networkEvents, int32(callbackId))
+	c.eventLock.Unlock()
+	return nil
+}
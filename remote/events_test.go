@@ -0,0 +1,88 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLifecycleEvents(t *testing.T) {
+	conn, wait := replayConnect(t, "events.txt")
+
+	domEvents := make(chan *DomainEventLifecycle, 1)
+	domID, err := conn.DomainEventLifecycleRegister(nil, func(c *Connect, d *Domain, event *DomainEventLifecycle) {
+		name, _ := d.GetName()
+		if name != "test" {
+			t.Errorf("Unexpected domain %s", name)
+		}
+		domEvents <- event
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case event := <-domEvents:
+		if event.Event != DOMAIN_EVENT_STARTED || event.Detail != 0 {
+			t.Errorf("Unexpected event %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for domain event")
+	}
+
+	netEvents := make(chan *NetworkEventLifecycle, 1)
+	net := &Network{conn: conn, name: "default"}
+	copy(net.uuid[:], []byte{0xdd, 0x8f, 0xe8, 0x84, 0x6c, 0x02, 0x60, 0x1e,
+		0x75, 0x51, 0xcc, 0xa9, 0x7d, 0xf1, 0xc5, 0xdf})
+	netID, err := conn.NetworkEventLifecycleRegister(net, func(c *Connect, n *Network, event *NetworkEventLifecycle) {
+		netEvents <- event
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case event := <-netEvents:
+		if event.Event != NETWORK_EVENT_STOPPED {
+			t.Errorf("Unexpected event %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for network event")
+	}
+
+	if err := conn.DomainEventDeregister(domID); err != nil {
+		t.Fatal(err)
+	}
+	if err := conn.NetworkEventDeregister(netID); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wait()
+}
@@ -0,0 +1,157 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+type NetworkXMLFlags int
+
+const (
+	NETWORK_XML_INACTIVE = NetworkXMLFlags(1 << 0)
+)
+
+// Network is a reference to a virtual network on the daemon
+type Network struct {
+	conn *Connect
+	name string
+	uuid [uuidBuflen]byte
+}
+
+func (e *xdrEncoder) network(n *Network) {
+	e.string(n.name)
+	e.fixedOpaque(n.uuid[:])
+}
+
+func (d *xdrDecoder) network(c *Connect) *Network {
+	net := &Network{conn: c}
+	net.name = d.string()
+	copy(net.uuid[:], d.fixedOpaque(uuidBuflen))
+	return net
+}
+
+func (n *Network) call(proc procedure, flags ...uint32) ([]byte, error) {
+	enc := &xdrEncoder{}
+	enc.network(n)
+	for _, flag := range flags {
+		enc.uint32(flag)
+	}
+	return n.conn.call(proc, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkFree
+func (n *Network) Free() error {
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkRef
+func (n *Network) Ref() error {
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetName
+func (n *Network) GetName() (string, error) {
+	return n.name, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetUUID
+func (n *Network) GetUUID() ([]byte, error) {
+	uuid := make([]byte, uuidBuflen)
+	copy(uuid, n.uuid[:])
+	return uuid, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetUUIDString
+func (n *Network) GetUUIDString() (string, error) {
+	return formatUUID(n.uuid), nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkCreate
+func (n *Network) Create() error {
+	_, err := n.call(procNetworkCreate)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkDestroy
+func (n *Network) Destroy() error {
+	_, err := n.call(procNetworkDestroy)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkUndefine
+func (n *Network) Undefine() error {
+	_, err := n.call(procNetworkUndefine)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetXMLDesc
+func (n *Network) GetXMLDesc(flags NetworkXMLFlags) (string, error) {
+	ret, err := n.call(procNetworkGetXMLDesc, uint32(flags))
+	if err != nil {
+		return "", err
+	}
+	dec := &xdrDecoder{buf: ret}
+	xml := dec.string()
+	if dec.err != nil {
+		return "", makeRPCError("%s", dec.err)
+	}
+	return xml, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetBridgeName
+func (n *Network) GetBridgeName() (string, error) {
+	ret, err := n.call(procNetworkGetBridgeName)
+	if err != nil {
+		return "", err
+	}
+	dec := &xdrDecoder{buf: ret}
+	name := dec.string()
+	if dec.err != nil {
+		return "", makeRPCError("%s", dec.err)
+	}
+	return name, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkGetAutostart
+func (n *Network) GetAutostart() (bool, error) {
+	ret, err := n.call(procNetworkGetAutostart)
+	if err != nil {
+		return false, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	autostart := dec.int32()
+	if dec.err != nil {
+		return false, makeRPCError("%s", dec.err)
+	}
+	return autostart != 0, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-network.html#virNetworkSetAutostart
+func (n *Network) SetAutostart(autostart bool) error {
+	var cAutostart uint32
+	if autostart {
+		cAutostart = 1
+	}
+	_, err := n.call(procNetworkSetAutostart, cAutostart)
+	return err
+}
@@ -0,0 +1,213 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Constants from libvirt's src/rpc/virnetprotocol.x and
+// src/remote/remote_protocol.x
+const (
+	remoteProgram         = 0x20008086
+	remoteProtocolVersion = 1
+
+	keepaliveProgram         = 0x6b656570
+	keepaliveProtocolVersion = 1
+
+	keepaliveProcPing = 1
+	keepaliveProcPong = 2
+
+	// Upper bound of a single packet, including the length word
+	messageMax = 32 * 1024 * 1024
+	// Largest data payload sent in one stream packet
+	streamChunkMax = 256 * 1024
+
+	// Limits on the size of arrays in replies
+	domainListMax      = 16384
+	networkListMax     = 16384
+	storagePoolListMax = 16384
+	storageVolListMax  = 16384
+)
+
+type packetType int32
+
+const (
+	packetCall         = packetType(0)
+	packetReply        = packetType(1)
+	packetMessage      = packetType(2)
+	packetStream       = packetType(3)
+	packetCallWithFDs  = packetType(4)
+	packetReplyWithFDs = packetType(5)
+	packetStreamHole   = packetType(6)
+)
+
+type packetStatus int32
+
+const (
+	statusOK       = packetStatus(0)
+	statusError    = packetStatus(1)
+	statusContinue = packetStatus(2)
+)
+
+type procedure int32
+
+const (
+	procConnectOpen                             = procedure(1)
+	procConnectClose                            = procedure(2)
+	procConnectGetType                          = procedure(3)
+	procConnectGetVersion                       = procedure(4)
+	procConnectGetCapabilities                  = procedure(7)
+	procDomainCreate                            = procedure(9)
+	procDomainCreateXML                         = procedure(10)
+	procDomainDefineXML                         = procedure(11)
+	procDomainDestroy                           = procedure(12)
+	procDomainGetXMLDesc                        = procedure(14)
+	procDomainGetAutostart                      = procedure(15)
+	procDomainGetInfo                           = procedure(16)
+	procDomainLookupByID                        = procedure(22)
+	procDomainLookupByName                      = procedure(23)
+	procDomainLookupByUUID                      = procedure(24)
+	procDomainReboot                            = procedure(27)
+	procDomainResume                            = procedure(28)
+	procDomainSetAutostart                      = procedure(29)
+	procDomainShutdown                          = procedure(33)
+	procDomainSuspend                           = procedure(34)
+	procDomainUndefine                          = procedure(35)
+	procNetworkCreate                           = procedure(39)
+	procNetworkCreateXML                        = procedure(40)
+	procNetworkDefineXML                        = procedure(41)
+	procNetworkDestroy                          = procedure(42)
+	procNetworkGetXMLDesc                       = procedure(43)
+	procNetworkGetAutostart                     = procedure(44)
+	procNetworkGetBridgeName                    = procedure(45)
+	procNetworkLookupByName                     = procedure(46)
+	procNetworkLookupByUUID                     = procedure(47)
+	procNetworkSetAutostart                     = procedure(48)
+	procNetworkUndefine                         = procedure(49)
+	procConnectGetHostname                      = procedure(59)
+	procStoragePoolCreateXML                    = procedure(76)
+	procStoragePoolDefineXML                    = procedure(77)
+	procStoragePoolCreate                       = procedure(78)
+	procStoragePoolBuild                        = procedure(79)
+	procStoragePoolDestroy                      = procedure(80)
+	procStoragePoolDelete                       = procedure(81)
+	procStoragePoolUndefine                     = procedure(82)
+	procStoragePoolRefresh                      = procedure(83)
+	procStoragePoolLookupByName                 = procedure(84)
+	procStoragePoolLookupByUUID                 = procedure(85)
+	procStoragePoolLookupByVolume               = procedure(86)
+	procStoragePoolGetInfo                      = procedure(87)
+	procStoragePoolGetXMLDesc                   = procedure(88)
+	procStoragePoolGetAutostart                 = procedure(89)
+	procStoragePoolSetAutostart                 = procedure(90)
+	procStorageVolCreateXML                     = procedure(93)
+	procStorageVolDelete                        = procedure(94)
+	procStorageVolLookupByName                  = procedure(95)
+	procStorageVolLookupByKey                   = procedure(96)
+	procStorageVolLookupByPath                  = procedure(97)
+	procStorageVolGetInfo                       = procedure(98)
+	procStorageVolGetXMLDesc                    = procedure(99)
+	procStorageVolGetPath                       = procedure(100)
+	procConnectGetURI                           = procedure(110)
+	procConnectGetLibVersion                    = procedure(157)
+	procDomainCreateWithFlags                   = procedure(196)
+	procStorageVolUpload                        = procedure(208)
+	procStorageVolDownload                      = procedure(209)
+	procDomainGetState                          = procedure(212)
+	procDomainUndefineFlags                     = procedure(231)
+	procDomainDestroyFlags                      = procedure(234)
+	procDomainShutdownFlags                     = procedure(258)
+	procConnectNetworkEventRegisterAny          = procedure(263)
+	procConnectNetworkEventDeregisterAny        = procedure(264)
+	procNetworkEventLifecycle                   = procedure(265)
+	procConnectListAllDomains                   = procedure(273)
+	procConnectListAllStoragePools              = procedure(281)
+	procStoragePoolListAllVolumes               = procedure(282)
+	procConnectListAllNetworks                  = procedure(283)
+	procConnectDomainEventCallbackRegisterAny   = procedure(316)
+	procConnectDomainEventCallbackDeregisterAny = procedure(317)
+	procDomainEventCallbackLifecycle            = procedure(318)
+	procDomainDefineXMLFlags                    = procedure(350)
+)
+
+// packetHeader is the fixed part that follows the length word
+// of every packet
+type packetHeader struct {
+	Program   uint32
+	Version   uint32
+	Procedure procedure
+	Type      packetType
+	Serial    uint32
+	Status    packetStatus
+}
+
+const packetHeaderLen = 4 + 6*4
+
+type packet struct {
+	header  packetHeader
+	payload []byte
+}
+
+func (p *packet) encode() []byte {
+	buf := make([]byte, packetHeaderLen, packetHeaderLen+len(p.payload))
+	binary.BigEndian.PutUint32(buf[0:], uint32(packetHeaderLen+len(p.payload)))
+	binary.BigEndian.PutUint32(buf[4:], p.header.Program)
+	binary.BigEndian.PutUint32(buf[8:], p.header.Version)
+	binary.BigEndian.PutUint32(buf[12:], uint32(p.header.Procedure))
+	binary.BigEndian.PutUint32(buf[16:], uint32(p.header.Type))
+	binary.BigEndian.PutUint32(buf[20:], p.header.Serial)
+	binary.BigEndian.PutUint32(buf[24:], uint32(p.header.Status))
+	return append(buf, p.payload...)
+}
+
+func readPacket(r io.Reader) (*packet, error) {
+	var lenbuf [4]byte
+	if _, err := io.ReadFull(r, lenbuf[:]); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(lenbuf[:])
+	if length < packetHeaderLen || length > messageMax {
+		return nil, fmt.Errorf("packet length %d out of range", length)
+	}
+	buf := make([]byte, length-4)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+	return &packet{
+		header: packetHeader{
+			Program:   binary.BigEndian.Uint32(buf[0:]),
+			Version:   binary.BigEndian.Uint32(buf[4:]),
+			Procedure: procedure(binary.BigEndian.Uint32(buf[8:])),
+			Type:      packetType(binary.BigEndian.Uint32(buf[12:])),
+			Serial:    binary.BigEndian.Uint32(buf[16:]),
+			Status:    packetStatus(binary.BigEndian.Uint32(buf[20:])),
+		},
+		payload: buf[packetHeaderLen-4:],
+	}, nil
+}
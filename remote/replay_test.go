@@ -0,0 +1,139 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// A replay script is a recording of the packets exchanged with a
+// daemon. Lines starting with 'C' hold a packet the client is
+// expected to send, and lines starting with 'S' a packet the server
+// sends back once all the preceding client packets have arrived.
+type replayStep struct {
+	line   int
+	client bool
+	data   []byte
+}
+
+func loadReplay(t *testing.T, name string) []replayStep {
+	fh, err := os.Open(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fh.Close()
+
+	var steps []replayStep
+	scanner := bufio.NewScanner(fh)
+	scanner.Buffer(nil, messageMax*2)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Fields(text)
+		if len(fields) != 2 || (fields[0] != "C" && fields[0] != "S") {
+			t.Fatalf("%s:%d: malformed line", name, line)
+		}
+		data, err := hex.DecodeString(fields[1])
+		if err != nil {
+			t.Fatalf("%s:%d: %s", name, line, err)
+		}
+		steps = append(steps, replayStep{
+			line:   line,
+			client: fields[0] == "C",
+			data:   data,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return steps
+}
+
+func describePacket(data []byte) string {
+	p, err := readPacket(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Sprintf("<invalid: %s>", err)
+	}
+	return fmt.Sprintf("proc=%d type=%d serial=%d status=%d payload=%x",
+		p.header.Procedure, p.header.Type, p.header.Serial, p.header.Status, p.payload)
+}
+
+func runReplay(conn net.Conn, name string, steps []replayStep) error {
+	defer conn.Close()
+	for _, step := range steps {
+		if step.client {
+			p, err := readPacket(conn)
+			if err != nil {
+				return fmt.Errorf("%s:%d: expected packet: %s", name, step.line, err)
+			}
+			got := p.encode()
+			if !bytes.Equal(got, step.data) {
+				return fmt.Errorf("%s:%d: packet mismatch\n  want %s\n  got  %s",
+					name, step.line, describePacket(step.data), describePacket(got))
+			}
+		} else {
+			if _, err := conn.Write(step.data); err != nil {
+				return fmt.Errorf("%s:%d: %s", name, step.line, err)
+			}
+		}
+	}
+	return nil
+}
+
+// replayConnect opens a connection to a stand-in daemon which plays
+// back the named script. The returned function waits for the script
+// to complete and reports any divergence from it.
+func replayConnect(t *testing.T, name string) (*Connect, func()) {
+	steps := loadReplay(t, name)
+	client, server := net.Pipe()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- runReplay(server, name, steps)
+	}()
+
+	conn, err := NewConnectFromNetConn(client, "test:///default", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn, func() {
+		if err := <-done; err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,263 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+type StoragePoolState int
+
+const (
+	STORAGE_POOL_INACTIVE     = StoragePoolState(0) // Not running
+	STORAGE_POOL_BUILDING     = StoragePoolState(1) // Initializing pool,not available
+	STORAGE_POOL_RUNNING      = StoragePoolState(2) // Running normally
+	STORAGE_POOL_DEGRADED     = StoragePoolState(3) // Running degraded
+	STORAGE_POOL_INACCESSIBLE = StoragePoolState(4) // Running,but not accessible
+)
+
+type StoragePoolBuildFlags int
+
+const (
+	STORAGE_POOL_BUILD_NEW          = StoragePoolBuildFlags(0)      // Regular build from scratch
+	STORAGE_POOL_BUILD_REPAIR       = StoragePoolBuildFlags(1 << 0) // Repair / reinitialize
+	STORAGE_POOL_BUILD_RESIZE       = StoragePoolBuildFlags(1 << 1) // Extend existing pool
+	STORAGE_POOL_BUILD_NO_OVERWRITE = StoragePoolBuildFlags(1 << 2) // Do not overwrite existing pool
+	STORAGE_POOL_BUILD_OVERWRITE    = StoragePoolBuildFlags(1 << 3) // Overwrite data
+)
+
+type StoragePoolCreateFlags int
+
+const (
+	STORAGE_POOL_CREATE_NORMAL                  = StoragePoolCreateFlags(0)
+	STORAGE_POOL_CREATE_WITH_BUILD              = StoragePoolCreateFlags(1 << 0)
+	STORAGE_POOL_CREATE_WITH_BUILD_OVERWRITE    = StoragePoolCreateFlags(1 << 1)
+	STORAGE_POOL_CREATE_WITH_BUILD_NO_OVERWRITE = StoragePoolCreateFlags(1 << 2)
+)
+
+type StoragePoolDeleteFlags int
+
+const (
+	STORAGE_POOL_DELETE_NORMAL = StoragePoolDeleteFlags(0)
+	STORAGE_POOL_DELETE_ZEROED = StoragePoolDeleteFlags(1 << 0)
+)
+
+type StorageXMLFlags int
+
+const (
+	STORAGE_XML_INACTIVE = StorageXMLFlags(1 << 0)
+)
+
+// StoragePool is a reference to a storage pool on the daemon
+type StoragePool struct {
+	conn *Connect
+	name string
+	uuid [uuidBuflen]byte
+}
+
+type StoragePoolInfo struct {
+	State      StoragePoolState
+	Capacity   uint64
+	Allocation uint64
+	Available  uint64
+}
+
+func (e *xdrEncoder) storagePool(p *StoragePool) {
+	e.string(p.name)
+	e.fixedOpaque(p.uuid[:])
+}
+
+func (d *xdrDecoder) storagePool(c *Connect) *StoragePool {
+	pool := &StoragePool{conn: c}
+	pool.name = d.string()
+	copy(pool.uuid[:], d.fixedOpaque(uuidBuflen))
+	return pool
+}
+
+func (p *StoragePool) call(proc procedure, flags ...uint32) ([]byte, error) {
+	enc := &xdrEncoder{}
+	enc.storagePool(p)
+	for _, flag := range flags {
+		enc.uint32(flag)
+	}
+	return p.conn.call(proc, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolFree
+func (p *StoragePool) Free() error {
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolRef
+func (p *StoragePool) Ref() error {
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetName
+func (p *StoragePool) GetName() (string, error) {
+	return p.name, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetUUID
+func (p *StoragePool) GetUUID() ([]byte, error) {
+	uuid := make([]byte, uuidBuflen)
+	copy(uuid, p.uuid[:])
+	return uuid, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetUUIDString
+func (p *StoragePool) GetUUIDString() (string, error) {
+	return formatUUID(p.uuid), nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolBuild
+func (p *StoragePool) Build(flags StoragePoolBuildFlags) error {
+	_, err := p.call(procStoragePoolBuild, uint32(flags))
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolCreate
+func (p *StoragePool) Create(flags StoragePoolCreateFlags) error {
+	_, err := p.call(procStoragePoolCreate, uint32(flags))
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolDelete
+func (p *StoragePool) Delete(flags StoragePoolDeleteFlags) error {
+	_, err := p.call(procStoragePoolDelete, uint32(flags))
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolDestroy
+func (p *StoragePool) Destroy() error {
+	_, err := p.call(procStoragePoolDestroy)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolUndefine
+func (p *StoragePool) Undefine() error {
+	_, err := p.call(procStoragePoolUndefine)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolRefresh
+func (p *StoragePool) Refresh(flags uint32) error {
+	_, err := p.call(procStoragePoolRefresh, flags)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetInfo
+func (p *StoragePool) GetInfo() (*StoragePoolInfo, error) {
+	ret, err := p.call(procStoragePoolGetInfo)
+	if err != nil {
+		return nil, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	info := &StoragePoolInfo{
+		State:      StoragePoolState(dec.uint32()),
+		Capacity:   dec.uint64(),
+		Allocation: dec.uint64(),
+		Available:  dec.uint64(),
+	}
+	if dec.err != nil {
+		return nil, makeRPCError("%s", dec.err)
+	}
+	return info, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetXMLDesc
+func (p *StoragePool) GetXMLDesc(flags StorageXMLFlags) (string, error) {
+	ret, err := p.call(procStoragePoolGetXMLDesc, uint32(flags))
+	if err != nil {
+		return "", err
+	}
+	dec := &xdrDecoder{buf: ret}
+	xml := dec.string()
+	if dec.err != nil {
+		return "", makeRPCError("%s", dec.err)
+	}
+	return xml, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetAutostart
+func (p *StoragePool) GetAutostart() (bool, error) {
+	ret, err := p.call(procStoragePoolGetAutostart)
+	if err != nil {
+		return false, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	autostart := dec.int32()
+	if dec.err != nil {
+		return false, makeRPCError("%s", dec.err)
+	}
+	return autostart != 0, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolSetAutostart
+func (p *StoragePool) SetAutostart(autostart bool) error {
+	var cAutostart uint32
+	if autostart {
+		cAutostart = 1
+	}
+	_, err := p.call(procStoragePoolSetAutostart, cAutostart)
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolLookupByName
+func (p *StoragePool) LookupStorageVolByName(name string) (*StorageVol, error) {
+	enc := &xdrEncoder{}
+	enc.storagePool(p)
+	enc.string(name)
+	return p.conn.callStorageVol(procStorageVolLookupByName, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolCreateXML
+func (p *StoragePool) StorageVolCreateXML(xmlConfig string, flags StorageVolCreateFlags) (*StorageVol, error) {
+	enc := &xdrEncoder{}
+	enc.storagePool(p)
+	enc.string(xmlConfig)
+	enc.uint32(uint32(flags))
+	return p.conn.callStorageVol(procStorageVolCreateXML, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolListAllVolumes
+func (p *StoragePool) ListAllStorageVolumes(flags uint32) ([]StorageVol, error) {
+	enc := &xdrEncoder{}
+	enc.storagePool(p)
+	enc.int32(1)
+	enc.uint32(flags)
+	ret, err := p.conn.call(procStoragePoolListAllVolumes, enc.buf)
+	if err != nil {
+		return []StorageVol{}, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	n := dec.arrayLen(storageVolListMax)
+	vols := make([]StorageVol, n)
+	for i := 0; i < n; i++ {
+		vols[i] = *dec.storageVol(p.conn)
+	}
+	dec.uint32()
+	if dec.err != nil {
+		return []StorageVol{}, makeRPCError("%s", dec.err)
+	}
+	return vols, nil
+}
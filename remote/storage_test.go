@@ -0,0 +1,156 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"testing"
+)
+
+func TestNetworkAndStorage(t *testing.T) {
+	conn, wait := replayConnect(t, "network.txt")
+
+	net, err := conn.LookupNetworkByName("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bridge, err := net.GetBridgeName()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bridge != "virbr0" {
+		t.Errorf("Unexpected bridge %s", bridge)
+	}
+	autostart, err := net.GetAutostart()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !autostart {
+		t.Error("Expected network to autostart")
+	}
+
+	pool, err := conn.LookupStoragePoolByName("default")
+	if err != nil {
+		t.Fatal(err)
+	}
+	info, err := pool.GetInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.State != STORAGE_POOL_RUNNING || info.Capacity != 107374182400 {
+		t.Errorf("Unexpected pool info %+v", info)
+	}
+
+	vols, err := pool.ListAllStorageVolumes(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vols) != 1 {
+		t.Fatalf("Expected 1 volume, got %d", len(vols))
+	}
+	key, _ := vols[0].GetKey()
+	if key != "/var/lib/libvirt/images/disk.img" {
+		t.Errorf("Unexpected volume key %s", key)
+	}
+	volInfo, err := vols[0].GetInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if volInfo.Type != STORAGE_VOL_FILE || volInfo.Capacity != 1048576 || volInfo.Allocation != 4096 {
+		t.Errorf("Unexpected volume info %+v", volInfo)
+	}
+
+	if _, err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wait()
+}
+
+func testVolume(conn *Connect) *StorageVol {
+	return &StorageVol{
+		conn: conn,
+		pool: "default",
+		name: "disk.img",
+		key:  "/var/lib/libvirt/images/disk.img",
+	}
+}
+
+func TestStorageVolDownload(t *testing.T) {
+	conn, wait := replayConnect(t, "download.txt")
+
+	st, err := conn.NewStream(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vol := testVolume(conn)
+	if err := vol.Download(st, 0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []byte
+	err = st.RecvAll(func(st *Stream, data []byte) (int, error) {
+		got = append(got, data...)
+		return len(data), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "hello world" {
+		t.Errorf("Unexpected data %q", got)
+	}
+	if err := st.Finish(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wait()
+}
+
+func TestStorageVolUpload(t *testing.T) {
+	conn, wait := replayConnect(t, "upload.txt")
+
+	st, err := conn.NewStream(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vol := testVolume(conn)
+	if err := vol.Upload(st, 0, 11, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := st.Send([]byte("hello world")); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.Finish(); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := conn.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wait()
+}
@@ -0,0 +1,219 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+type StorageVolCreateFlags int
+
+const (
+	STORAGE_VOL_CREATE_PREALLOC_METADATA = StorageVolCreateFlags(1 << 0)
+	STORAGE_VOL_CREATE_REFLINK           = StorageVolCreateFlags(1 << 1)
+)
+
+type StorageVolDeleteFlags int
+
+const (
+	STORAGE_VOL_DELETE_NORMAL         = StorageVolDeleteFlags(0)      // Delete metadata only (fast)
+	STORAGE_VOL_DELETE_ZEROED         = StorageVolDeleteFlags(1 << 0) // Clear all data to zeros (slow)
+	STORAGE_VOL_DELETE_WITH_SNAPSHOTS = StorageVolDeleteFlags(1 << 1) // Force removal of volume, even if in use
+)
+
+type StorageVolType int
+
+const (
+	STORAGE_VOL_FILE    = StorageVolType(0) // Regular file based volumes
+	STORAGE_VOL_BLOCK   = StorageVolType(1) // Block based volumes
+	STORAGE_VOL_DIR     = StorageVolType(2) // Directory-passthrough based volume
+	STORAGE_VOL_NETWORK = StorageVolType(3) //Network volumes like RBD (RADOS Block Device)
+	STORAGE_VOL_NETDIR  = StorageVolType(4) // Network accessible directory that can contain other network volumes
+	STORAGE_VOL_PLOOP   = StorageVolType(5) // Ploop directory based volumes
+)
+
+type StorageVolUploadFlags int
+
+const (
+	STORAGE_VOL_UPLOAD_SPARSE_STREAM = StorageVolUploadFlags(1 << 0)
+)
+
+type StorageVolDownloadFlags int
+
+const (
+	STORAGE_VOL_DOWNLOAD_SPARSE_STREAM = StorageVolDownloadFlags(1 << 0)
+)
+
+// StorageVol is a reference to a storage volume on the daemon
+type StorageVol struct {
+	conn *Connect
+	pool string
+	name string
+	key  string
+}
+
+type StorageVolInfo struct {
+	Type       StorageVolType
+	Capacity   uint64
+	Allocation uint64
+}
+
+func (e *xdrEncoder) storageVol(v *StorageVol) {
+	e.string(v.pool)
+	e.string(v.name)
+	e.string(v.key)
+}
+
+func (d *xdrDecoder) storageVol(c *Connect) *StorageVol {
+	vol := &StorageVol{conn: c}
+	vol.pool = d.string()
+	vol.name = d.string()
+	vol.key = d.string()
+	return vol
+}
+
+func (v *StorageVol) call(proc procedure, flags ...uint32) ([]byte, error) {
+	enc := &xdrEncoder{}
+	enc.storageVol(v)
+	for _, flag := range flags {
+		enc.uint32(flag)
+	}
+	return v.conn.call(proc, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolFree
+func (v *StorageVol) Free() error {
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolRef
+func (v *StorageVol) Ref() error {
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolGetName
+func (v *StorageVol) GetName() (string, error) {
+	return v.name, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolGetKey
+func (v *StorageVol) GetKey() (string, error) {
+	return v.key, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolLookupByVolume
+func (v *StorageVol) LookupPoolByVolume() (*StoragePool, error) {
+	enc := &xdrEncoder{}
+	enc.storageVol(v)
+	return v.conn.callStoragePool(procStoragePoolLookupByVolume, enc.buf)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolDelete
+func (v *StorageVol) Delete(flags StorageVolDeleteFlags) error {
+	_, err := v.call(procStorageVolDelete, uint32(flags))
+	return err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolGetInfo
+func (v *StorageVol) GetInfo() (*StorageVolInfo, error) {
+	ret, err := v.call(procStorageVolGetInfo)
+	if err != nil {
+		return nil, err
+	}
+	dec := &xdrDecoder{buf: ret}
+	info := &StorageVolInfo{
+		Type:       StorageVolType(dec.int32()),
+		Capacity:   dec.uint64(),
+		Allocation: dec.uint64(),
+	}
+	if dec.err != nil {
+		return nil, makeRPCError("%s", dec.err)
+	}
+	return info, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolGetXMLDesc
+func (v *StorageVol) GetXMLDesc(flags uint32) (string, error) {
+	ret, err := v.call(procStorageVolGetXMLDesc, flags)
+	if err != nil {
+		return "", err
+	}
+	dec := &xdrDecoder{buf: ret}
+	xml := dec.string()
+	if dec.err != nil {
+		return "", makeRPCError("%s", dec.err)
+	}
+	return xml, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolGetPath
+func (v *StorageVol) GetPath() (string, error) {
+	ret, err := v.call(procStorageVolGetPath)
+	if err != nil {
+		return "", err
+	}
+	dec := &xdrDecoder{buf: ret}
+	path := dec.string()
+	if dec.err != nil {
+		return "", makeRPCError("%s", dec.err)
+	}
+	return path, nil
+}
+
+// transfer starts an upload or download, attaching stream to the
+// serial of the call so that data packets are routed to it
+func (v *StorageVol) transfer(proc procedure, stream *Stream, offset, length uint64, flags uint32, incoming bool) error {
+	if stream.conn != v.conn {
+		return Error{
+			Code:    ERR_INVALID_ARG,
+			Domain:  FROM_NONE,
+			Message: "stream belongs to a different connection",
+			Level:   ERR_ERROR,
+		}
+	}
+
+	enc := &xdrEncoder{}
+	enc.storageVol(v)
+	enc.uint64(offset)
+	enc.uint64(length)
+	enc.uint32(flags)
+
+	serial := v.conn.nextSerial()
+	if err := stream.attach(proc, serial, incoming); err != nil {
+		return err
+	}
+	if _, err := v.conn.callSerial(serial, proc, enc.buf); err != nil {
+		stream.detach()
+		return err
+	}
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolUpload
+func (v *StorageVol) Upload(stream *Stream, offset, length uint64, flags StorageVolUploadFlags) error {
+	return v.transfer(procStorageVolUpload, stream, offset, length, uint32(flags), false)
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolDownload
+func (v *StorageVol) Download(stream *Stream, offset, length uint64, flags StorageVolDownloadFlags) error {
+	return v.transfer(procStorageVolDownload, stream, offset, length, uint32(flags), true)
+}
@@ -0,0 +1,321 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"sync"
+)
+
+type StreamFlags int
+
+const (
+	STREAM_NONBLOCK = StreamFlags(1 << 0)
+)
+
+// Stream carries the data of a storage volume upload or download.
+// Only blocking streams are supported, and sparse transfers are
+// rejected by the daemon since holes are never negotiated.
+type Stream struct {
+	conn *Connect
+
+	lock     sync.Mutex
+	cond     *sync.Cond
+	proc     procedure
+	serial   uint32
+	attached bool
+	incoming bool
+	data     [][]byte
+	eof      bool
+	done     bool
+	err      error
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamNew
+func (c *Connect) NewStream(flags StreamFlags) (*Stream, error) {
+	if flags&STREAM_NONBLOCK != 0 {
+		return nil, Error{
+			Code:    ERR_NO_SUPPORT,
+			Domain:  FROM_NONE,
+			Message: "non-blocking streams are not supported",
+			Level:   ERR_ERROR,
+		}
+	}
+	st := &Stream{conn: c}
+	st.cond = sync.NewCond(&st.lock)
+	return st, nil
+}
+
+func (v *Stream) attach(proc procedure, serial uint32, incoming bool) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if v.attached {
+		return Error{
+			Code:    ERR_INVALID_ARG,
+			Domain:  FROM_NONE,
+			Message: "stream is already in use",
+			Level:   ERR_ERROR,
+		}
+	}
+
+	v.conn.lock.Lock()
+	defer v.conn.lock.Unlock()
+	if v.conn.err != nil {
+		return v.conn.err
+	}
+	v.conn.streams[serial] = v
+
+	v.proc = proc
+	v.serial = serial
+	v.attached = true
+	v.incoming = incoming
+	return nil
+}
+
+func (v *Stream) detach() {
+	v.conn.lock.Lock()
+	delete(v.conn.streams, v.serial)
+	v.conn.lock.Unlock()
+}
+
+// push queues a packet received from the daemon
+func (v *Stream) push(p *packet) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	switch {
+	case p.header.Type == packetStreamHole:
+		if v.err == nil {
+			v.err = makeRPCError("unexpected hole in non-sparse stream")
+		}
+	case p.header.Status == statusContinue:
+		if len(p.payload) > 0 {
+			v.data = append(v.data, p.payload)
+		}
+	case p.header.Status == statusOK:
+		// For downloads the first OK marks the end of the
+		// data, and the second acknowledges Finish
+		if v.incoming && !v.eof {
+			v.eof = true
+		} else {
+			v.done = true
+		}
+	case p.header.Status == statusError:
+		if v.err == nil {
+			v.err = decodeError(p.payload)
+		}
+		v.done = true
+	}
+	v.cond.Broadcast()
+}
+
+// fail terminates the stream when the connection is lost
+func (v *Stream) fail(err error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if v.err == nil {
+		v.err = err
+	}
+	v.done = true
+	v.cond.Broadcast()
+}
+
+func (v *Stream) sendPacket(status packetStatus, payload []byte) error {
+	return v.conn.send(&packet{
+		header: packetHeader{
+			Program:   remoteProgram,
+			Version:   remoteProtocolVersion,
+			Procedure: v.proc,
+			Type:      packetStream,
+			Serial:    v.serial,
+			Status:    status,
+		},
+		payload: payload,
+	})
+}
+
+func (v *Stream) checkAttached() error {
+	if !v.attached {
+		return Error{
+			Code:    ERR_INVALID_ARG,
+			Domain:  FROM_NONE,
+			Message: "stream is not in use",
+			Level:   ERR_ERROR,
+		}
+	}
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamSend
+func (v *Stream) Send(p []byte) (int, error) {
+	v.lock.Lock()
+	err := v.checkAttached()
+	if err == nil {
+		err = v.err
+	}
+	v.lock.Unlock()
+	if err != nil {
+		return -1, err
+	}
+
+	for sent := 0; sent < len(p); {
+		n := len(p) - sent
+		if n > streamChunkMax {
+			n = streamChunkMax
+		}
+		if err := v.sendPacket(statusContinue, p[sent:sent+n]); err != nil {
+			return -1, err
+		}
+		sent += n
+	}
+	return len(p), nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamRecv
+func (v *Stream) Recv(p []byte) (int, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if err := v.checkAttached(); err != nil {
+		return -1, err
+	}
+
+	for len(v.data) == 0 && !v.eof && v.err == nil {
+		v.cond.Wait()
+	}
+	if len(v.data) == 0 {
+		if v.err != nil {
+			return -1, v.err
+		}
+		return 0, nil
+	}
+
+	n := copy(p, v.data[0])
+	if n == len(v.data[0]) {
+		v.data = v.data[1:]
+	} else {
+		v.data[0] = v.data[0][n:]
+	}
+	return n, nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamFinish
+func (v *Stream) Finish() error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if err := v.checkAttached(); err != nil {
+		return err
+	}
+	defer v.detach()
+
+	if v.incoming {
+		// Any data not yet read is discarded
+		for !v.eof && v.err == nil {
+			v.cond.Wait()
+		}
+		v.data = nil
+	}
+	if v.err != nil {
+		return v.err
+	}
+
+	if err := v.sendPacket(statusOK, nil); err != nil {
+		return err
+	}
+	for !v.done {
+		v.cond.Wait()
+	}
+	return v.err
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamAbort
+func (v *Stream) Abort() error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+	if err := v.checkAttached(); err != nil {
+		return err
+	}
+	defer v.detach()
+
+	if v.done {
+		return nil
+	}
+	if err := v.sendPacket(statusError, nil); err != nil {
+		return err
+	}
+	// The daemon acknowledges the abort with an error of its
+	// own, which is not interesting to the caller
+	for !v.done {
+		v.cond.Wait()
+	}
+	return nil
+}
+
+// See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamFree
+func (v *Stream) Free() error {
+	return nil
+}
+
+type StreamSinkFunc func(*Stream, []byte) (int, error)
+
+// See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamRecvAll
+func (v *Stream) RecvAll(handler StreamSinkFunc) error {
+	buf := make([]byte, streamChunkMax)
+	for {
+		n, err := v.Recv(buf)
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+		for got := 0; got < n; {
+			done, err := handler(v, buf[got:n])
+			if err != nil {
+				v.Abort()
+				return err
+			}
+			got += done
+		}
+	}
+}
+
+type StreamSourceFunc func(*Stream, int) ([]byte, error)
+
+// See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamSendAll
+func (v *Stream) SendAll(handler StreamSourceFunc) error {
+	for {
+		data, err := handler(v, streamChunkMax)
+		if err != nil {
+			v.Abort()
+			return err
+		}
+		if len(data) == 0 {
+			return nil
+		}
+		if _, err := v.Send(data); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,208 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// xdrEncoder serializes values using the subset of XDR (RFC 4506)
+// needed by the libvirt remote protocol.
+type xdrEncoder struct {
+	buf []byte
+}
+
+func (e *xdrEncoder) uint32(v uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	e.buf = append(e.buf, b[:]...)
+}
+
+func (e *xdrEncoder) int32(v int32) {
+	e.uint32(uint32(v))
+}
+
+func (e *xdrEncoder) uint64(v uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	e.buf = append(e.buf, b[:]...)
+}
+
+func (e *xdrEncoder) int64(v int64) {
+	e.uint64(uint64(v))
+}
+
+func (e *xdrEncoder) double(v float64) {
+	e.uint64(math.Float64bits(v))
+}
+
+func (e *xdrEncoder) bool(v bool) {
+	if v {
+		e.int32(1)
+	} else {
+		e.int32(0)
+	}
+}
+
+func (e *xdrEncoder) pad(n int) {
+	for n%4 != 0 {
+		e.buf = append(e.buf, 0)
+		n++
+	}
+}
+
+// fixedOpaque encodes data whose length is known to both sides,
+// such as a UUID
+func (e *xdrEncoder) fixedOpaque(v []byte) {
+	e.buf = append(e.buf, v...)
+	e.pad(len(v))
+}
+
+func (e *xdrEncoder) opaque(v []byte) {
+	e.uint32(uint32(len(v)))
+	e.fixedOpaque(v)
+}
+
+func (e *xdrEncoder) string(v string) {
+	e.opaque([]byte(v))
+}
+
+// optionalString encodes a 'remote_string', where the empty
+// string is sent as a NULL pointer
+func (e *xdrEncoder) optionalString(v string) {
+	if v == "" {
+		e.bool(false)
+		return
+	}
+	e.bool(true)
+	e.string(v)
+}
+
+// xdrDecoder deserializes values encoded with xdrEncoder. The
+// first error encountered is recorded and all later reads return
+// zero values, so callers only need to check err once at the end.
+type xdrDecoder struct {
+	buf []byte
+	err error
+}
+
+func (d *xdrDecoder) take(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(d.buf) {
+		d.err = fmt.Errorf("XDR data truncated, wanted %d bytes, have %d", n, len(d.buf))
+		return nil
+	}
+	v := d.buf[:n]
+	d.buf = d.buf[n:]
+	return v
+}
+
+func (d *xdrDecoder) uint32() uint32 {
+	b := d.take(4)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint32(b)
+}
+
+func (d *xdrDecoder) int32() int32 {
+	return int32(d.uint32())
+}
+
+func (d *xdrDecoder) uint64() uint64 {
+	b := d.take(8)
+	if b == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(b)
+}
+
+func (d *xdrDecoder) int64() int64 {
+	return int64(d.uint64())
+}
+
+func (d *xdrDecoder) double() float64 {
+	return math.Float64frombits(d.uint64())
+}
+
+func (d *xdrDecoder) bool() bool {
+	return d.int32() != 0
+}
+
+func (d *xdrDecoder) fixedOpaque(n int) []byte {
+	padded := n
+	if padded%4 != 0 {
+		padded += 4 - padded%4
+	}
+	b := d.take(padded)
+	if b == nil {
+		return nil
+	}
+	v := make([]byte, n)
+	copy(v, b)
+	return v
+}
+
+func (d *xdrDecoder) opaque() []byte {
+	n := d.uint32()
+	if d.err != nil {
+		return nil
+	}
+	if n > uint32(len(d.buf)) {
+		d.err = fmt.Errorf("XDR opaque length %d exceeds remaining %d bytes", n, len(d.buf))
+		return nil
+	}
+	return d.fixedOpaque(int(n))
+}
+
+func (d *xdrDecoder) string() string {
+	return string(d.opaque())
+}
+
+func (d *xdrDecoder) optionalString() string {
+	if !d.bool() {
+		return ""
+	}
+	return d.string()
+}
+
+// arrayLen reads the element count of a variable length array,
+// rejecting counts larger than max
+func (d *xdrDecoder) arrayLen(max uint32) int {
+	n := d.uint32()
+	if d.err != nil {
+		return 0
+	}
+	if n > max {
+		d.err = fmt.Errorf("XDR array length %d exceeds limit %d", n, max)
+		return 0
+	}
+	return int(n)
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2020 Red Hat, Inc.
+ *
+ */
+
+package remote
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestXDRRoundTrip(t *testing.T) {
+	enc := &xdrEncoder{}
+	enc.uint32(0xdeadbeef)
+	enc.int32(-5)
+	enc.uint64(1 << 40)
+	enc.int64(-1 << 40)
+	enc.bool(true)
+	enc.string("hello")
+	enc.optionalString("")
+	enc.optionalString("world")
+	enc.opaque([]byte{1, 2, 3})
+	enc.fixedOpaque([]byte{9, 8})
+
+	// Strings and opaque data are padded to a multiple of 4 bytes
+	if len(enc.buf)%4 != 0 {
+		t.Fatalf("Encoded length %d is not aligned", len(enc.buf))
+	}
+
+	dec := &xdrDecoder{buf: enc.buf}
+	if v := dec.uint32(); v != 0xdeadbeef {
+		t.Errorf("Unexpected uint32 %x", v)
+	}
+	if v := dec.int32(); v != -5 {
+		t.Errorf("Unexpected int32 %d", v)
+	}
+	if v := dec.uint64(); v != 1<<40 {
+		t.Errorf("Unexpected uint64 %d", v)
+	}
+	if v := dec.int64(); v != -1<<40 {
+		t.Errorf("Unexpected int64 %d", v)
+	}
+	if v := dec.bool(); !v {
+		t.Errorf("Unexpected bool %t", v)
+	}
+	if v := dec.string(); v != "hello" {
+		t.Errorf("Unexpected string %q", v)
+	}
+	if v := dec.optionalString(); v != "" {
+		t.Errorf("Unexpected optional string %q", v)
+	}
+	if v := dec.optionalString(); v != "world" {
+		t.Errorf("Unexpected optional string %q", v)
+	}
+	if v := dec.opaque(); !bytes.Equal(v, []byte{1, 2, 3}) {
+		t.Errorf("Unexpected opaque %v", v)
+	}
+	if v := dec.fixedOpaque(2); !bytes.Equal(v, []byte{9, 8}) {
+		t.Errorf("Unexpected fixed opaque %v", v)
+	}
+	if dec.err != nil {
+		t.Fatal(dec.err)
+	}
+
+	dec.uint32()
+	if dec.err == nil {
+		t.Fatal("Expected error reading past end of buffer")
+	}
+}
+
+func TestXDRArrayLimit(t *testing.T) {
+	enc := &xdrEncoder{}
+	enc.uint32(10)
+
+	dec := &xdrDecoder{buf: enc.buf}
+	dec.arrayLen(5)
+	if dec.err == nil {
+		t.Fatal("Expected error for oversized array")
+	}
+}
@@ -0,0 +1,214 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+)
+
+// ScreenshotEncoding selects the format of the images produced by
+// ScreenshotThumbnail.
+type ScreenshotEncoding int
+
+const (
+	SCREENSHOT_ENCODING_PNG = ScreenshotEncoding(iota)
+	SCREENSHOT_ENCODING_JPEG
+)
+
+// MIMEType returns the MIME type of images in the encoding
+func (e ScreenshotEncoding) MIMEType() string {
+	switch e {
+	case SCREENSHOT_ENCODING_JPEG:
+		return "image/jpeg"
+	default:
+		return "image/png"
+	}
+}
+
+// ScreenshotThumbnailOptions controls the size and encoding of the
+// images produced by ScreenshotThumbnail.
+type ScreenshotThumbnailOptions struct {
+	// The screenshot is scaled down, keeping its aspect ratio, to fit
+	// within these. Zero leaves that dimension unconstrained
+	MaxWidth  int
+	MaxHeight int
+
+	Encoding ScreenshotEncoding
+
+	// Quality of JPEG images, from 1 to 100. Defaults to 75
+	JPEGQuality int
+}
+
+// ScreenshotImage takes a screenshot of the given screen of the domain
+// and decodes it. QEMU returns PPM images, which are decoded here since
+// the Go standard library has no decoder for them; other hypervisors
+// return PNG images.
+func (d *Domain) doScreenshotImage(ctx context.Context, screen uint32) (image.Image, error) {
+	conn, err := d.DomainGetConnect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	stream, err := conn.NewStream(0)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.Free()
+
+	mimeType, err := d.Screenshot(stream, screen, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// The image is small enough to be read in full before decoding
+	var data bytes.Buffer
+	buf := make([]byte, 64*1024)
+	for {
+		if err := ctx.Err(); err != nil {
+			stream.Abort()
+			return nil, err
+		}
+		n, err := stream.Recv(buf)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			stream.Abort()
+			return nil, err
+		}
+		data.Write(buf[:n])
+	}
+	if err := stream.Finish(); err != nil {
+		return nil, err
+	}
+
+	switch mimeType {
+	case "image/x-portable-pixmap":
+		return decodePPM(data.Bytes())
+	case "image/png":
+		return png.Decode(&data)
+	}
+	img, _, err := image.Decode(&data)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to decode screenshot of type %s: %v", mimeType, err)
+	}
+	return img, nil
+}
+
+// ScreenshotThumbnail is ScreenshotImage followed by scaling and
+// encoding the image as described by opts, which may be nil for a full
+// size PNG image. The MIME type of the result is opts.Encoding.MIMEType().
+func (d *Domain) doScreenshotThumbnail(ctx context.Context, screen uint32, opts *ScreenshotThumbnailOptions) ([]byte, error) {
+	if opts == nil {
+		opts = &ScreenshotThumbnailOptions{}
+	}
+	img, err := d.ScreenshotImage(ctx, screen)
+	if err != nil {
+		return nil, err
+	}
+	img = scaleScreenshot(img, opts.MaxWidth, opts.MaxHeight)
+
+	var out bytes.Buffer
+	switch opts.Encoding {
+	case SCREENSHOT_ENCODING_JPEG:
+		quality := opts.JPEGQuality
+		if quality == 0 {
+			quality = jpeg.DefaultQuality
+		}
+		err = jpeg.Encode(&out, img, &jpeg.Options{Quality: quality})
+	default:
+		err = png.Encode(&out, img)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// Scales an image down to fit within maxWidth x maxHeight, averaging
+// the pixels which map to each pixel of the result. Images which
+// already fit are returned as they are.
+func scaleScreenshot(img image.Image, maxWidth, maxHeight int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width == 0 || height == 0 {
+		return img
+	}
+	newWidth, newHeight := width, height
+	if maxWidth > 0 && newWidth > maxWidth {
+		newHeight = newHeight * maxWidth / newWidth
+		newWidth = maxWidth
+	}
+	if maxHeight > 0 && newHeight > maxHeight {
+		newWidth = newWidth * maxHeight / newHeight
+		newHeight = maxHeight
+	}
+	if newWidth < 1 {
+		newWidth = 1
+	}
+	if newHeight < 1 {
+		newHeight = 1
+	}
+	if newWidth == width && newHeight == height {
+		return img
+	}
+
+	scaled := image.NewRGBA(image.Rect(0, 0, newWidth, newHeight))
+	for y := 0; y < newHeight; y++ {
+		y0 := bounds.Min.Y + y*height/newHeight
+		y1 := bounds.Min.Y + (y+1)*height/newHeight
+		for x := 0; x < newWidth; x++ {
+			x0 := bounds.Min.X + x*width/newWidth
+			x1 := bounds.Min.X + (x+1)*width/newWidth
+			var r, g, b, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					pr, pg, pb, pa := img.At(sx, sy).RGBA()
+					r += uint64(pr)
+					g += uint64(pg)
+					b += uint64(pb)
+					a += uint64(pa)
+					n++
+				}
+			}
+			scaled.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(b / n >> 8),
+				A: uint8(a / n >> 8),
+			})
+		}
+	}
+	return scaled
+}
@@ -0,0 +1,127 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestDecodePPM(t *testing.T) {
+	data := []byte("P6\n# CREATOR: test\n2 1\n255\n\xff\x00\x00\x00\x80\xff")
+	img, err := decodePPM(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.Bounds() != image.Rect(0, 0, 2, 1) {
+		t.Fatalf("Unexpected bounds %v", img.Bounds())
+	}
+	rgba := img.(*image.RGBA)
+	if c := rgba.RGBAAt(0, 0); c != (color.RGBA{0xff, 0, 0, 0xff}) {
+		t.Fatalf("Unexpected pixel %v", c)
+	}
+	if c := rgba.RGBAAt(1, 0); c != (color.RGBA{0, 0x80, 0xff, 0xff}) {
+		t.Fatalf("Unexpected pixel %v", c)
+	}
+}
+
+func TestDecodePPM16(t *testing.T) {
+	data := []byte("P6 1 1 65535 \xff\xff\x00\x00\x80\x00")
+	img, err := decodePPM(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, g, b, a := img.At(0, 0).RGBA()
+	if r != 0xffff || g != 0 || b != 0x8000 || a != 0xffff {
+		t.Fatalf("Unexpected pixel %x %x %x %x", r, g, b, a)
+	}
+}
+
+func TestDecodePPMInvalid(t *testing.T) {
+	for _, data := range []string{
+		"P3\n1 1\n255\n0 0 0\n",
+		"P6\n0 1\n255\n",
+		"P6\n1 1\n0\n\x00\x00\x00",
+		"P6\n2 2\n255\n\x00\x00\x00",
+		"P6\nx 1\n255\n",
+		"P6",
+		// Far larger than the data, or than any display
+		"P6\n8000 4000\n255\n\x00\x00\x00",
+		"P6\n100000 100000\n255\n\x00\x00\x00",
+	} {
+		if _, err := decodePPM([]byte(data)); err == nil {
+			t.Errorf("Expected an error decoding %q", data)
+		}
+	}
+}
+
+func TestScaleScreenshot(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 640, 480))
+	for x := 0; x < 640; x++ {
+		img.SetRGBA(x, 0, color.RGBA{0xff, 0xff, 0xff, 0xff})
+	}
+
+	scaled := scaleScreenshot(img, 160, 160)
+	if scaled.Bounds() != image.Rect(0, 0, 160, 120) {
+		t.Fatalf("Unexpected bounds %v", scaled.Bounds())
+	}
+	// Each pixel of the top row averages four rows of the original,
+	// one of which is white
+	if r, _, _, _ := scaled.At(0, 0).RGBA(); r>>8 != 0x3f {
+		t.Fatalf("Unexpected red value %x", r>>8)
+	}
+
+	if scaleScreenshot(img, 1024, 0) != image.Image(img) {
+		t.Fatal("Expected an image which fits to be returned as is")
+	}
+}
+
+func TestDomainScreenshotImage(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Destroy()
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+	if err := dom.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	img, err := dom.ScreenshotImage(context.Background(), 0)
+	if err != nil {
+		// The test driver can only take screenshots when the image it
+		// returns is installed
+		return
+	}
+	if img.Bounds().Empty() {
+		t.Fatal("Screenshot is empty")
+	}
+}
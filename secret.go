@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "secret_wrapper.h"
 */
@@ -67,7 +67,10 @@ type Secret struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretFree
-func (s *Secret) Free() error {
+func (s *Secret) doFree() error {
+	if err := trackHandleFree("Secret", unsafe.Pointer(s.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virSecretFreeWrapper(s.ptr, &err)
 	if ret == -1 {
@@ -77,17 +80,18 @@ func (s *Secret) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretRef
-func (c *Secret) Ref() error {
+func (c *Secret) doRef() error {
 	var err C.virError
 	ret := C.virSecretRefWrapper(c.ptr, &err)
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("Secret", unsafe.Pointer(c.ptr))
 	return nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretUndefine
-func (s *Secret) Undefine() error {
+func (s *Secret) doUndefine() error {
 	var err C.virError
 	result := C.virSecretUndefineWrapper(s.ptr, &err)
 	if result == -1 {
@@ -97,7 +101,7 @@ func (s *Secret) Undefine() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretGetUUID
-func (s *Secret) GetUUID() ([]byte, error) {
+func (s *Secret) doGetUUID() ([]byte, error) {
 	var cUuid [C.VIR_UUID_BUFLEN](byte)
 	cuidPtr := unsafe.Pointer(&cUuid)
 	var err C.virError
@@ -109,7 +113,7 @@ func (s *Secret) GetUUID() ([]byte, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretGetUUIDString
-func (s *Secret) GetUUIDString() (string, error) {
+func (s *Secret) doGetUUIDString() (string, error) {
 	var cUuid [C.VIR_UUID_STRING_BUFLEN](C.char)
 	cuidPtr := unsafe.Pointer(&cUuid)
 	var err C.virError
@@ -121,7 +125,7 @@ func (s *Secret) GetUUIDString() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretGetUsageID
-func (s *Secret) GetUsageID() (string, error) {
+func (s *Secret) doGetUsageID() (string, error) {
 	var err C.virError
 	result := C.virSecretGetUsageIDWrapper(s.ptr, &err)
 	if result == nil {
@@ -131,7 +135,7 @@ func (s *Secret) GetUsageID() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretGetUsageType
-func (s *Secret) GetUsageType() (SecretUsageType, error) {
+func (s *Secret) doGetUsageType() (SecretUsageType, error) {
 	var err C.virError
 	result := SecretUsageType(C.virSecretGetUsageTypeWrapper(s.ptr, &err))
 	if result == -1 {
@@ -141,7 +145,7 @@ func (s *Secret) GetUsageType() (SecretUsageType, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretGetXMLDesc
-func (s *Secret) GetXMLDesc(flags uint32) (string, error) {
+func (s *Secret) doGetXMLDesc(flags uint32) (string, error) {
 	var err C.virError
 	result := C.virSecretGetXMLDescWrapper(s.ptr, C.uint(flags), &err)
 	if result == nil {
@@ -153,7 +157,7 @@ func (s *Secret) GetXMLDesc(flags uint32) (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretGetValue
-func (s *Secret) GetValue(flags uint32) ([]byte, error) {
+func (s *Secret) doGetValue(flags uint32) ([]byte, error) {
 	var cvalue_size C.size_t
 
 	var err C.virError
@@ -167,7 +171,7 @@ func (s *Secret) GetValue(flags uint32) ([]byte, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-secret.html#virSecretSetValue
-func (s *Secret) SetValue(value []byte, flags uint32) error {
+func (s *Secret) doSetValue(value []byte, flags uint32) error {
 	cvalue := make([]C.uchar, len(value))
 
 	for i := 0; i < len(value); i++ {
@@ -32,7 +32,7 @@ import (
 )
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include "secret_events_wrapper.h"
 */
 import "C"
@@ -83,7 +83,7 @@ func secretEventGenericCallback(c C.virConnectPtr, n C.virSecretPtr,
 	callback(connection, secret)
 }
 
-func (c *Connect) SecretEventLifecycleRegister(secret *Secret, callback SecretEventLifecycleCallback) (int, error) {
+func (c *Connect) doSecretEventLifecycleRegister(secret *Secret, callback SecretEventLifecycleCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 	if C.LIBVIR_VERSION_NUMBER < 3000000 {
 		return 0, makeNotImplementedError("virConnectSecretEventRegisterAny")
@@ -106,7 +106,7 @@ func (c *Connect) SecretEventLifecycleRegister(secret *Secret, callback SecretEv
 	return int(ret), nil
 }
 
-func (c *Connect) SecretEventValueChangedRegister(secret *Secret, callback SecretEventGenericCallback) (int, error) {
+func (c *Connect) doSecretEventValueChangedRegister(secret *Secret, callback SecretEventGenericCallback) (int, error) {
 	goCallBackId := registerCallbackId(callback)
 	if C.LIBVIR_VERSION_NUMBER < 3000000 {
 		return 0, makeNotImplementedError("virConnectSecretEventRegisterAny")
@@ -129,7 +129,7 @@ func (c *Connect) SecretEventValueChangedRegister(secret *Secret, callback Secre
 	return int(ret), nil
 }
 
-func (c *Connect) SecretEventDeregister(callbackId int) error {
+func (c *Connect) doSecretEventDeregister(callbackId int) error {
 	if C.LIBVIR_VERSION_NUMBER < 3000000 {
 		return makeNotImplementedError("virConnectSecretEventDeregisterAny")
 	}
@@ -0,0 +1,244 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"time"
+)
+
+// KeyboardLayout names the keyboard layout used by a guest, which
+// decides the keys to press to type each character.
+type KeyboardLayout string
+
+const (
+	KEYBOARD_LAYOUT_US = KeyboardLayout("us")
+	KEYBOARD_LAYOUT_GB = KeyboardLayout("gb")
+	KEYBOARD_LAYOUT_DE = KeyboardLayout("de")
+	KEYBOARD_LAYOUT_FR = KeyboardLayout("fr")
+)
+
+// Linux keycodes of the keys SendText handles specially
+const (
+	keyBackspace = 14
+	keyTab       = 15
+	keyEnter     = 28
+	keyLeftShift = 42
+	keySpace     = 57
+	keyRightAlt  = 100
+)
+
+// Keys are pressed in order and released together, so several
+// characters can be typed by one call of SendKey. USB keyboards report
+// at most six keys held at once, which limits the keys per call below
+// DOMAIN_SEND_KEY_MAX_KEYS.
+const sendTextMaxKeys = 6
+
+// How long each group of keys is held, in milliseconds, and the time
+// from pressing one group to pressing the next. The hypervisor may
+// return before the keys are released, so the latter must be longer.
+const (
+	sendTextHoldTime = 20
+	sendTextDelay    = 50 * time.Millisecond
+)
+
+// A row of keys of a layout, starting from the given linux keycode,
+// with the characters typed by each key alone and with shift
+type keyboardRow struct {
+	key    uint
+	normal string
+	shift  string
+}
+
+type keyboardLayoutDef struct {
+	rows []keyboardRow
+	// Characters typed with AltGr, by linux keycode
+	altgr map[uint]rune
+}
+
+// Only characters which are not dead keys are listed, so some, such
+// as the circumflex of the German layout, cannot be typed
+var keyboardLayoutDefs = map[KeyboardLayout]*keyboardLayoutDef{
+	KEYBOARD_LAYOUT_US: {
+		rows: []keyboardRow{
+			{2, "1234567890-=", "!@#$%^&*()_+"},
+			{16, "qwertyuiop[]", "QWERTYUIOP{}"},
+			{30, "asdfghjkl;'`", "ASDFGHJKL:\"~"},
+			{43, "\\zxcvbnm,./", "|ZXCVBNM<>?"},
+		},
+	},
+	KEYBOARD_LAYOUT_GB: {
+		rows: []keyboardRow{
+			{2, "1234567890-=", "!\"£$%^&*()_+"},
+			{16, "qwertyuiop[]", "QWERTYUIOP{}"},
+			{30, "asdfghjkl;'`", "ASDFGHJKL:@¬"},
+			{43, "#zxcvbnm,./", "~ZXCVBNM<>?"},
+			{86, "\\", "|"},
+		},
+		altgr: map[uint]rune{5: '€'},
+	},
+	KEYBOARD_LAYOUT_DE: {
+		rows: []keyboardRow{
+			{2, "1234567890ß", "!\"§$%&/()=?"},
+			{16, "qwertzuiopü+", "QWERTZUIOPÜ*"},
+			{30, "asdfghjklöä", "ASDFGHJKLÖÄ"},
+			{43, "#yxcvbnm,.-", "'YXCVBNM;:_"},
+			{86, "<", ">"},
+		},
+		altgr: map[uint]rune{
+			3: '²', 4: '³', 8: '{', 9: '[', 10: ']', 11: '}', 12: '\\',
+			16: '@', 18: '€', 27: '~', 50: 'µ', 86: '|',
+		},
+	},
+	KEYBOARD_LAYOUT_FR: {
+		rows: []keyboardRow{
+			{2, "&é\"'(-è_çà)=", "1234567890°+"},
+			{16, "azertyuiop", "AZERTYUIOP"},
+			{27, "$", "£"},
+			{30, "qsdfghjklmù²", "QSDFGHJKLM%"},
+			{43, "*wxcvbn,;:!", "µWXCVBN?./§"},
+			{86, "<", ">"},
+		},
+		altgr: map[uint]rune{
+			3: '~', 4: '#', 5: '{', 6: '[', 7: '|', 8: '`', 9: '\\',
+			10: '^', 11: '@', 12: ']', 13: '}', 18: '€', 27: '¤',
+		},
+	},
+}
+
+// The keys typing a character: the key itself, and the modifier to
+// hold with it, if any
+type keystroke struct {
+	key      uint
+	modifier uint
+}
+
+// Keystrokes for the characters of each layout, built from the
+// definitions above
+var keyboardLayoutKeys = make(map[KeyboardLayout]map[rune]keystroke)
+
+func init() {
+	for layout, def := range keyboardLayoutDefs {
+		keys := map[rune]keystroke{
+			' ':  {key: keySpace},
+			'\n': {key: keyEnter},
+			'\t': {key: keyTab},
+			'\b': {key: keyBackspace},
+		}
+		add := func(r rune, stroke keystroke) {
+			if _, ok := keys[r]; !ok {
+				keys[r] = stroke
+			}
+		}
+		for _, row := range def.rows {
+			key := row.key
+			for _, r := range row.normal {
+				add(r, keystroke{key: key})
+				key++
+			}
+		}
+		for _, row := range def.rows {
+			key := row.key
+			for _, r := range row.shift {
+				add(r, keystroke{key: key, modifier: keyLeftShift})
+				key++
+			}
+		}
+		for key, r := range def.altgr {
+			add(r, keystroke{key: key, modifier: keyRightAlt})
+		}
+		keyboardLayoutKeys[layout] = keys
+	}
+}
+
+// Keycodes converts text to the linux keycodes which type it with the
+// layout, in groups to pass to one call of Domain.SendKey each. The
+// text may contain newlines, tabs and backspaces, but not characters
+// which need dead keys. Caps Lock is assumed to be off.
+func (l KeyboardLayout) Keycodes(text string) ([][]uint, error) {
+	keys, ok := keyboardLayoutKeys[l]
+	if !ok {
+		return nil, makeKeycodeError("Unknown keyboard layout '%s'", l)
+	}
+
+	var groups [][]uint
+	var group []uint
+	var modifier uint
+	pressed := make(map[uint]bool)
+	for _, r := range text {
+		stroke, ok := keys[r]
+		if !ok {
+			return nil, makeKeycodeError("Character %q cannot be typed with keyboard layout '%s'", r, l)
+		}
+		// A key cannot be pressed twice while held, and the modifier
+		// applies to every key pressed after it
+		if len(group) != 0 && (stroke.modifier != modifier ||
+			pressed[stroke.key] || len(group) == sendTextMaxKeys) {
+			groups = append(groups, group)
+			group = nil
+			pressed = make(map[uint]bool)
+		}
+		if len(group) == 0 {
+			modifier = stroke.modifier
+			if modifier != 0 {
+				group = append(group, modifier)
+			}
+		}
+		group = append(group, stroke.key)
+		pressed[stroke.key] = true
+	}
+	if len(group) != 0 {
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// SendText types text on the keyboard of the domain, with the given
+// keyboard layout, which must match the one configured in the guest.
+// The keys are sent with SendKey, a few at a time and with a short
+// pause between calls, so that the guest sees each key. Nothing is
+// sent unless the whole text can be typed.
+func (d *Domain) doSendText(ctx context.Context, text string, layout KeyboardLayout) error {
+	groups, err := layout.Keycodes(text)
+	if err != nil {
+		return err
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for _, group := range groups {
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if err := d.SendKey(uint(KEYCODE_SET_LINUX), sendTextHoldTime, group, 0); err != nil {
+			return err
+		}
+		timer.Reset(sendTextDelay)
+	}
+	return nil
+}
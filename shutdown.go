@@ -0,0 +1,196 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"time"
+)
+
+// DomainShutdownStage identifies the step of ShutdownAndWait which
+// stopped the domain.
+type DomainShutdownStage int
+
+const (
+	// The domain was already shut off, or stopped by itself before
+	// any stage was requested
+	DOMAIN_SHUTDOWN_STAGE_NONE = DomainShutdownStage(iota)
+	// The guest handled an ACPI power button press
+	DOMAIN_SHUTDOWN_STAGE_ACPI_POWER_BTN
+	// The guest agent shut down the guest
+	DOMAIN_SHUTDOWN_STAGE_GUEST_AGENT
+	// The hypervisor process was asked to terminate
+	DOMAIN_SHUTDOWN_STAGE_DESTROY_GRACEFUL
+	// The hypervisor process was killed
+	DOMAIN_SHUTDOWN_STAGE_DESTROY
+)
+
+func (s DomainShutdownStage) String() string {
+	switch s {
+	case DOMAIN_SHUTDOWN_STAGE_NONE:
+		return "none"
+	case DOMAIN_SHUTDOWN_STAGE_ACPI_POWER_BTN:
+		return "acpi-power-button"
+	case DOMAIN_SHUTDOWN_STAGE_GUEST_AGENT:
+		return "guest-agent"
+	case DOMAIN_SHUTDOWN_STAGE_DESTROY_GRACEFUL:
+		return "destroy-graceful"
+	case DOMAIN_SHUTDOWN_STAGE_DESTROY:
+		return "destroy"
+	default:
+		return "unknown"
+	}
+}
+
+// DomainShutdownPolicy gives the time allowed for each stage of
+// ShutdownAndWait. A stage with a zero timeout is skipped.
+type DomainShutdownPolicy struct {
+	ACPIPowerBtnTimeout    time.Duration
+	GuestAgentTimeout      time.Duration
+	DestroyGracefulTimeout time.Duration
+
+	// Do not destroy the domain if the earlier stages fail to stop it
+	NoDestroy bool
+
+	// How often the domain state is checked, in addition to checks
+	// prompted by lifecycle events. Defaults to one second
+	PollInterval time.Duration
+}
+
+// DefaultDomainShutdownPolicy is used by ShutdownAndWait when it is
+// passed a nil policy.
+var DefaultDomainShutdownPolicy = DomainShutdownPolicy{
+	ACPIPowerBtnTimeout:    60 * time.Second,
+	GuestAgentTimeout:      30 * time.Second,
+	DestroyGracefulTimeout: 10 * time.Second,
+}
+
+// ShutdownAndWait stops the domain, escalating through an ACPI power
+// button press, a shutdown by the guest agent, and a graceful destroy,
+// until the domain is seen to be shut off. If none of them succeed in
+// their time, the domain is destroyed. Stages which the domain does
+// not support are passed over. Returns the stage which stopped the
+// domain.
+//
+// Completion is detected with lifecycle events when an event loop is
+// running, and by polling the domain state otherwise. If ctx is done
+// before the domain stops, ctx.Err() is returned along with the stage
+// in progress.
+func (d *Domain) doShutdownAndWait(ctx context.Context, policy *DomainShutdownPolicy) (DomainShutdownStage, error) {
+	if policy == nil {
+		policy = &DefaultDomainShutdownPolicy
+	}
+	pollInterval := policy.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = time.Second
+	}
+
+	active, err := d.IsActive()
+	if err != nil {
+		return DOMAIN_SHUTDOWN_STAGE_NONE, err
+	}
+	if !active {
+		return DOMAIN_SHUTDOWN_STAGE_NONE, nil
+	}
+
+	conn, err := d.DomainGetConnect()
+	if err != nil {
+		return DOMAIN_SHUTDOWN_STAGE_NONE, err
+	}
+	defer conn.Close()
+	watcher := newLifecycleWatcher(conn)
+	defer watcher.close()
+
+	stages := []struct {
+		stage   DomainShutdownStage
+		timeout time.Duration
+		request func() error
+	}{
+		{DOMAIN_SHUTDOWN_STAGE_ACPI_POWER_BTN, policy.ACPIPowerBtnTimeout, func() error {
+			return d.ShutdownFlags(DOMAIN_SHUTDOWN_ACPI_POWER_BTN)
+		}},
+		{DOMAIN_SHUTDOWN_STAGE_GUEST_AGENT, policy.GuestAgentTimeout, func() error {
+			return d.ShutdownFlags(DOMAIN_SHUTDOWN_GUEST_AGENT)
+		}},
+		{DOMAIN_SHUTDOWN_STAGE_DESTROY_GRACEFUL, policy.DestroyGracefulTimeout, func() error {
+			return d.DestroyFlags(DOMAIN_DESTROY_GRACEFUL)
+		}},
+	}
+
+	shutoff := func(status *DomainStatus) bool {
+		return status.State == DOMAIN_SHUTOFF
+	}
+	// The last stage which was requested, and so may have stopped the
+	// domain if a later request finds it already shut off
+	issued := DOMAIN_SHUTDOWN_STAGE_NONE
+	for _, stage := range stages {
+		if stage.timeout <= 0 {
+			continue
+		}
+		if err := stage.request(); err != nil {
+			if ctx.Err() != nil {
+				return stage.stage, ctx.Err()
+			}
+			// The domain may have stopped during an earlier stage
+			// just as its time ran out
+			if state, _, stateErr := d.GetState(); stateErr == nil && state == DOMAIN_SHUTOFF {
+				return issued, nil
+			}
+			continue
+		}
+		issued = stage.stage
+
+		stageCtx, cancel := context.WithTimeout(ctx, stage.timeout)
+		_, err := watcher.waitStatus(stageCtx, d, pollInterval, shutoff)
+		cancel()
+		if err == nil {
+			return stage.stage, nil
+		}
+		if ctx.Err() != nil {
+			return stage.stage, ctx.Err()
+		}
+		if err != context.DeadlineExceeded {
+			return stage.stage, err
+		}
+	}
+
+	if policy.NoDestroy {
+		return DOMAIN_SHUTDOWN_STAGE_NONE, Error{
+			Code:    ERR_OPERATION_TIMEOUT,
+			Domain:  FROM_DOMAIN,
+			Message: "Domain did not shut down in time",
+			Level:   ERR_ERROR,
+		}
+	}
+
+	if err := d.Destroy(); err != nil {
+		if state, _, stateErr := d.GetState(); stateErr == nil && state == DOMAIN_SHUTOFF {
+			return issued, nil
+		}
+		return DOMAIN_SHUTDOWN_STAGE_DESTROY, err
+	}
+	return DOMAIN_SHUTDOWN_STAGE_DESTROY, nil
+}
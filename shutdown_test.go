@@ -0,0 +1,158 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDomainShutdownAndWait(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	policy := &DomainShutdownPolicy{
+		ACPIPowerBtnTimeout:    time.Second,
+		GuestAgentTimeout:      time.Second,
+		DestroyGracefulTimeout: time.Second,
+		PollInterval:           10 * time.Millisecond,
+	}
+
+	stage, err := dom.ShutdownAndWait(context.Background(), policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stage != DOMAIN_SHUTDOWN_STAGE_NONE {
+		t.Fatalf("Expected stage %s for inactive domain, got %s", DOMAIN_SHUTDOWN_STAGE_NONE, stage)
+	}
+
+	if err := dom.Create(); err != nil {
+		t.Fatal(err)
+	}
+	stage, err = dom.ShutdownAndWait(context.Background(), policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stage == DOMAIN_SHUTDOWN_STAGE_NONE {
+		t.Fatal("Expected a shutdown stage for active domain")
+	}
+	state, _, err := dom.GetState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != DOMAIN_SHUTOFF {
+		t.Fatalf("Domain state %d, expected shut off", state)
+	}
+}
+
+// The domain stops by itself just as the guest agent is asked to shut
+// it down, so the request fails
+func TestDomainShutdownAndWaitRace(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		SetInterceptors()
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+	if err := dom.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := DOMAIN_SHUTDOWN_STAGE_NONE
+	SetInterceptors(func(call *Call, invoke func() error) error {
+		if call.Method != "Domain.ShutdownFlags" {
+			return invoke()
+		}
+		if call.Arg("flags") == DOMAIN_SHUTDOWN_ACPI_POWER_BTN {
+			err := invoke()
+			if err == nil {
+				expected = DOMAIN_SHUTDOWN_STAGE_ACPI_POWER_BTN
+			}
+			return err
+		}
+		if err := dom.DestroyFlags(0); err != nil {
+			return err
+		}
+		return Error{Code: ERR_OPERATION_INVALID, Message: "Domain is not running"}
+	})
+
+	policy := &DomainShutdownPolicy{
+		ACPIPowerBtnTimeout: 50 * time.Millisecond,
+		GuestAgentTimeout:   time.Second,
+		PollInterval:        10 * time.Millisecond,
+	}
+	stage, err := dom.ShutdownAndWait(context.Background(), policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stage != expected {
+		t.Fatalf("Expected stage %s, got %s", expected, stage)
+	}
+}
+
+// The domain stops by itself just as it is destroyed, so Destroy fails
+func TestDomainShutdownAndWaitDestroyRace(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		SetInterceptors()
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+	if err := dom.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	SetInterceptors(func(call *Call, invoke func() error) error {
+		if call.Method != "Domain.Destroy" {
+			return invoke()
+		}
+		if err := dom.DestroyFlags(0); err != nil {
+			return err
+		}
+		return Error{Code: ERR_OPERATION_INVALID, Message: "Domain is not running"}
+	})
+
+	stage, err := dom.ShutdownAndWait(context.Background(), &DomainShutdownPolicy{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stage != DOMAIN_SHUTDOWN_STAGE_NONE {
+		t.Fatalf("Expected stage %s, got %s", DOMAIN_SHUTDOWN_STAGE_NONE, stage)
+	}
+}
@@ -0,0 +1,182 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"libvirt.org/libvirt-go/libvirtxml"
+)
+
+// The XML documents accepted by the define, create and attach methods
+// can be given as structs from the libvirtxml package, instead of as
+// strings. These are the names used for them in this package.
+type DomainSpec = libvirtxml.Domain
+type DomainDeviceSpec = libvirtxml.DomainDevice
+type DomainSnapshotSpec = libvirtxml.DomainSnapshot
+type DomainCheckpointSpec = libvirtxml.DomainCheckpoint
+type DomainBackupSpec = libvirtxml.DomainBackup
+type NetworkSpec = libvirtxml.Network
+
+// DefineDomain is DomainDefineXML taking a DomainSpec
+func (c *Connect) doDefineDomain(spec *DomainSpec) (*Domain, error) {
+	xml, err := spec.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return c.doDomainDefineXML(xml)
+}
+
+// DefineDomainFlags is DomainDefineXMLFlags taking a DomainSpec
+func (c *Connect) doDefineDomainFlags(spec *DomainSpec, flags DomainDefineFlags) (*Domain, error) {
+	xml, err := spec.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return c.doDomainDefineXMLFlags(xml, flags)
+}
+
+// CreateDomain is DomainCreateXML taking a DomainSpec
+func (c *Connect) doCreateDomain(spec *DomainSpec, flags DomainCreateFlags) (*Domain, error) {
+	xml, err := spec.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return c.doDomainCreateXML(xml, flags)
+}
+
+// GetSpec is GetXMLDesc returning a DomainSpec
+func (d *Domain) doGetSpec(flags DomainXMLFlags) (*DomainSpec, error) {
+	xml, err := d.doGetXMLDesc(flags)
+	if err != nil {
+		return nil, err
+	}
+	spec := &DomainSpec{}
+	if err := spec.Unmarshal(xml); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// AttachDeviceSpec is AttachDeviceFlags taking a DomainDeviceSpec,
+// such as a *libvirtxml.DomainDisk
+func (d *Domain) doAttachDeviceSpec(dev DomainDeviceSpec, flags DomainDeviceModifyFlags) error {
+	xml, err := dev.Marshal()
+	if err != nil {
+		return err
+	}
+	return d.doAttachDeviceFlags(xml, flags)
+}
+
+// DetachDeviceSpec is DetachDeviceFlags taking a DomainDeviceSpec
+func (d *Domain) doDetachDeviceSpec(dev DomainDeviceSpec, flags DomainDeviceModifyFlags) error {
+	xml, err := dev.Marshal()
+	if err != nil {
+		return err
+	}
+	return d.doDetachDeviceFlags(xml, flags)
+}
+
+// UpdateDeviceSpec is UpdateDeviceFlags taking a DomainDeviceSpec
+func (d *Domain) doUpdateDeviceSpec(dev DomainDeviceSpec, flags DomainDeviceModifyFlags) error {
+	xml, err := dev.Marshal()
+	if err != nil {
+		return err
+	}
+	return d.doUpdateDeviceFlags(xml, flags)
+}
+
+// CreateSnapshotSpec is CreateSnapshotXML taking a DomainSnapshotSpec
+func (d *Domain) doCreateSnapshotSpec(spec *DomainSnapshotSpec, flags DomainSnapshotCreateFlags) (*DomainSnapshot, error) {
+	xml, err := spec.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return d.doCreateSnapshotXML(xml, flags)
+}
+
+// CreateCheckpointSpec is CreateCheckpointXML taking a DomainCheckpointSpec
+func (d *Domain) doCreateCheckpointSpec(spec *DomainCheckpointSpec, flags DomainCheckpointCreateFlags) (*DomainCheckpoint, error) {
+	xml, err := spec.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return d.doCreateCheckpointXML(xml, flags)
+}
+
+// BackupBeginSpec is BackupBegin taking a DomainBackupSpec. The
+// checkpoint may be nil if no checkpoint is to be created.
+func (d *Domain) doBackupBeginSpec(backup *DomainBackupSpec, checkpoint *DomainCheckpointSpec, flags DomainBackupBeginFlags) error {
+	backupXML, err := backup.Marshal()
+	if err != nil {
+		return err
+	}
+	checkpointXML := ""
+	if checkpoint != nil {
+		checkpointXML, err = checkpoint.Marshal()
+		if err != nil {
+			return err
+		}
+	}
+	return d.doBackupBegin(backupXML, checkpointXML, flags)
+}
+
+// GetSpec is GetXMLDesc returning a DomainSnapshotSpec
+func (s *DomainSnapshot) doGetSpec(flags DomainSnapshotXMLFlags) (*DomainSnapshotSpec, error) {
+	xml, err := s.doGetXMLDesc(flags)
+	if err != nil {
+		return nil, err
+	}
+	spec := &DomainSnapshotSpec{}
+	if err := spec.Unmarshal(xml); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// GetSpec is GetXMLDesc returning a DomainCheckpointSpec
+func (s *DomainCheckpoint) doGetSpec(flags DomainCheckpointXMLFlags) (*DomainCheckpointSpec, error) {
+	xml, err := s.doGetXMLDesc(flags)
+	if err != nil {
+		return nil, err
+	}
+	spec := &DomainCheckpointSpec{}
+	if err := spec.Unmarshal(xml); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// GetSpec is GetXMLDesc returning a NetworkSpec
+func (n *Network) doGetSpec(flags NetworkXMLFlags) (*NetworkSpec, error) {
+	xml, err := n.doGetXMLDesc(flags)
+	if err != nil {
+		return nil, err
+	}
+	spec := &NetworkSpec{}
+	if err := spec.Unmarshal(xml); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
@@ -0,0 +1,97 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"testing"
+	"time"
+
+	"libvirt.org/libvirt-go/libvirtxml"
+)
+
+func TestDefineDomainSpec(t *testing.T) {
+	conn := buildTestConnection()
+	defer func() {
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	name := time.Now().String()
+	dom, err := conn.DefineDomain(&DomainSpec{
+		Type:   "test",
+		Name:   name,
+		Memory: &libvirtxml.DomainMemory{Value: 8192, Unit: "KiB"},
+		OS: &libvirtxml.DomainOS{
+			Type: &libvirtxml.DomainOSType{Type: "hvm"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		dom.Undefine()
+		dom.Free()
+	}()
+
+	spec, err := dom.GetSpec(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.Name != name {
+		t.Fatalf("Name %q, expected %q", spec.Name, name)
+	}
+	if spec.Memory == nil || spec.Memory.Value != 8192 {
+		t.Fatalf("Unexpected memory %v", spec.Memory)
+	}
+}
+
+func TestCreateDomainSnapshotSpec(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	ss, err := dom.CreateSnapshotSpec(&DomainSnapshotSpec{
+		Name:        "spec",
+		Description: "Snapshot from a spec",
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ss.Free()
+
+	spec, err := ss.GetSpec(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if spec.Name != "spec" || spec.Description != "Snapshot from a spec" {
+		t.Fatalf("Unexpected snapshot %q/%q", spec.Name, spec.Description)
+	}
+}
@@ -27,9 +27,10 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "storage_pool_wrapper.h"
+#include "connect_wrapper.h"
 */
 import "C"
 
@@ -104,7 +105,7 @@ type StoragePoolInfo struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolBuild
-func (p *StoragePool) Build(flags StoragePoolBuildFlags) error {
+func (p *StoragePool) doBuild(flags StoragePoolBuildFlags) error {
 	var err C.virError
 	result := C.virStoragePoolBuildWrapper(p.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -114,7 +115,7 @@ func (p *StoragePool) Build(flags StoragePoolBuildFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolCreate
-func (p *StoragePool) Create(flags StoragePoolCreateFlags) error {
+func (p *StoragePool) doCreate(flags StoragePoolCreateFlags) error {
 	var err C.virError
 	result := C.virStoragePoolCreateWrapper(p.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -124,7 +125,7 @@ func (p *StoragePool) Create(flags StoragePoolCreateFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolDelete
-func (p *StoragePool) Delete(flags StoragePoolDeleteFlags) error {
+func (p *StoragePool) doDelete(flags StoragePoolDeleteFlags) error {
 	var err C.virError
 	result := C.virStoragePoolDeleteWrapper(p.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -134,7 +135,7 @@ func (p *StoragePool) Delete(flags StoragePoolDeleteFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolDestroy
-func (p *StoragePool) Destroy() error {
+func (p *StoragePool) doDestroy() error {
 	var err C.virError
 	result := C.virStoragePoolDestroyWrapper(p.ptr, &err)
 	if result == -1 {
@@ -144,7 +145,10 @@ func (p *StoragePool) Destroy() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolFree
-func (p *StoragePool) Free() error {
+func (p *StoragePool) doFree() error {
+	if err := trackHandleFree("StoragePool", unsafe.Pointer(p.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virStoragePoolFreeWrapper(p.ptr, &err)
 	if ret == -1 {
@@ -154,17 +158,38 @@ func (p *StoragePool) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolRef
-func (c *StoragePool) Ref() error {
+func (c *StoragePool) doRef() error {
 	var err C.virError
 	ret := C.virStoragePoolRefWrapper(c.ptr, &err)
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("StoragePool", unsafe.Pointer(c.ptr))
 	return nil
 }
 
+// See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetConnect
+//
+// Contrary to the native C API behaviour, the Go API will
+// acquire a reference on the returned Connect, which must
+// be released by calling Close()
+func (p *StoragePool) doStoragePoolGetConnect() (*Connect, error) {
+	var err C.virError
+	ptr := C.virStoragePoolGetConnectWrapper(p.ptr, &err)
+	if ptr == nil {
+		return nil, makeError(&err)
+	}
+
+	ret := C.virConnectRefWrapper(ptr, &err)
+	if ret == -1 {
+		return nil, makeError(&err)
+	}
+
+	return &Connect{ptr: ptr}, nil
+}
+
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetAutostart
-func (p *StoragePool) GetAutostart() (bool, error) {
+func (p *StoragePool) doGetAutostart() (bool, error) {
 	var out C.int
 	var err C.virError
 	result := C.virStoragePoolGetAutostartWrapper(p.ptr, (*C.int)(unsafe.Pointer(&out)), &err)
@@ -180,7 +205,7 @@ func (p *StoragePool) GetAutostart() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetInfo
-func (p *StoragePool) GetInfo() (*StoragePoolInfo, error) {
+func (p *StoragePool) doGetInfo() (*StoragePoolInfo, error) {
 	var cinfo C.virStoragePoolInfo
 	var err C.virError
 	result := C.virStoragePoolGetInfoWrapper(p.ptr, &cinfo, &err)
@@ -196,7 +221,7 @@ func (p *StoragePool) GetInfo() (*StoragePoolInfo, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetName
-func (p *StoragePool) GetName() (string, error) {
+func (p *StoragePool) doGetName() (string, error) {
 	var err C.virError
 	name := C.virStoragePoolGetNameWrapper(p.ptr, &err)
 	if name == nil {
@@ -206,7 +231,7 @@ func (p *StoragePool) GetName() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetUUID
-func (p *StoragePool) GetUUID() ([]byte, error) {
+func (p *StoragePool) doGetUUID() ([]byte, error) {
 	var cUuid [C.VIR_UUID_BUFLEN](byte)
 	cuidPtr := unsafe.Pointer(&cUuid)
 	var err C.virError
@@ -218,7 +243,7 @@ func (p *StoragePool) GetUUID() ([]byte, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetUUIDString
-func (p *StoragePool) GetUUIDString() (string, error) {
+func (p *StoragePool) doGetUUIDString() (string, error) {
 	var cUuid [C.VIR_UUID_STRING_BUFLEN](C.char)
 	cuidPtr := unsafe.Pointer(&cUuid)
 	var err C.virError
@@ -230,7 +255,7 @@ func (p *StoragePool) GetUUIDString() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolGetXMLDesc
-func (p *StoragePool) GetXMLDesc(flags StorageXMLFlags) (string, error) {
+func (p *StoragePool) doGetXMLDesc(flags StorageXMLFlags) (string, error) {
 	var err C.virError
 	result := C.virStoragePoolGetXMLDescWrapper(p.ptr, C.uint(flags), &err)
 	if result == nil {
@@ -242,7 +267,7 @@ func (p *StoragePool) GetXMLDesc(flags StorageXMLFlags) (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolIsActive
-func (p *StoragePool) IsActive() (bool, error) {
+func (p *StoragePool) doIsActive() (bool, error) {
 	var err C.virError
 	result := C.virStoragePoolIsActiveWrapper(p.ptr, &err)
 	if result == -1 {
@@ -255,7 +280,7 @@ func (p *StoragePool) IsActive() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolIsPersistent
-func (p *StoragePool) IsPersistent() (bool, error) {
+func (p *StoragePool) doIsPersistent() (bool, error) {
 	var err C.virError
 	result := C.virStoragePoolIsPersistentWrapper(p.ptr, &err)
 	if result == -1 {
@@ -268,7 +293,7 @@ func (p *StoragePool) IsPersistent() (bool, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolSetAutostart
-func (p *StoragePool) SetAutostart(autostart bool) error {
+func (p *StoragePool) doSetAutostart(autostart bool) error {
 	var cAutostart C.int
 	switch autostart {
 	case true:
@@ -285,7 +310,7 @@ func (p *StoragePool) SetAutostart(autostart bool) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolRefresh
-func (p *StoragePool) Refresh(flags uint32) error {
+func (p *StoragePool) doRefresh(flags uint32) error {
 	var err C.virError
 	result := C.virStoragePoolRefreshWrapper(p.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -295,7 +320,7 @@ func (p *StoragePool) Refresh(flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolUndefine
-func (p *StoragePool) Undefine() error {
+func (p *StoragePool) doUndefine() error {
 	var err C.virError
 	result := C.virStoragePoolUndefineWrapper(p.ptr, &err)
 	if result == -1 {
@@ -305,7 +330,7 @@ func (p *StoragePool) Undefine() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolCreateXML
-func (p *StoragePool) StorageVolCreateXML(xmlConfig string, flags StorageVolCreateFlags) (*StorageVol, error) {
+func (p *StoragePool) doStorageVolCreateXML(xmlConfig string, flags StorageVolCreateFlags) (*StorageVol, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -313,11 +338,12 @@ func (p *StoragePool) StorageVolCreateXML(xmlConfig string, flags StorageVolCrea
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StorageVol", unsafe.Pointer(ptr))
 	return &StorageVol{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolCreateXMLFrom
-func (p *StoragePool) StorageVolCreateXMLFrom(xmlConfig string, clonevol *StorageVol, flags StorageVolCreateFlags) (*StorageVol, error) {
+func (p *StoragePool) doStorageVolCreateXMLFrom(xmlConfig string, clonevol *StorageVol, flags StorageVolCreateFlags) (*StorageVol, error) {
 	cXml := C.CString(string(xmlConfig))
 	defer C.free(unsafe.Pointer(cXml))
 	var err C.virError
@@ -325,11 +351,12 @@ func (p *StoragePool) StorageVolCreateXMLFrom(xmlConfig string, clonevol *Storag
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StorageVol", unsafe.Pointer(ptr))
 	return &StorageVol{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolLookupByName
-func (p *StoragePool) LookupStorageVolByName(name string) (*StorageVol, error) {
+func (p *StoragePool) doLookupStorageVolByName(name string) (*StorageVol, error) {
 	cName := C.CString(name)
 	defer C.free(unsafe.Pointer(cName))
 	var err C.virError
@@ -337,11 +364,12 @@ func (p *StoragePool) LookupStorageVolByName(name string) (*StorageVol, error) {
 	if ptr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StorageVol", unsafe.Pointer(ptr))
 	return &StorageVol{ptr: ptr}, nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolNumOfVolumes
-func (p *StoragePool) NumOfStorageVolumes() (int, error) {
+func (p *StoragePool) doNumOfStorageVolumes() (int, error) {
 	var err C.virError
 	result := int(C.virStoragePoolNumOfVolumesWrapper(p.ptr, &err))
 	if result == -1 {
@@ -351,7 +379,7 @@ func (p *StoragePool) NumOfStorageVolumes() (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolListVolumes
-func (p *StoragePool) ListStorageVolumes() ([]string, error) {
+func (p *StoragePool) doListStorageVolumes() ([]string, error) {
 	const maxVols = 1024
 	var names [maxVols](*C.char)
 	namesPtr := unsafe.Pointer(&names)
@@ -372,7 +400,7 @@ func (p *StoragePool) ListStorageVolumes() ([]string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolListAllVolumes
-func (p *StoragePool) ListAllStorageVolumes(flags uint32) ([]StorageVol, error) {
+func (p *StoragePool) doListAllStorageVolumes(flags uint32) ([]StorageVol, error) {
 	var cList *C.virStorageVolPtr
 	var err C.virError
 	numVols := C.virStoragePoolListAllVolumesWrapper(p.ptr, (**C.virStorageVolPtr)(&cList), C.uint(flags), &err)
@@ -387,6 +415,7 @@ func (p *StoragePool) ListAllStorageVolumes(flags uint32) ([]StorageVol, error)
 	var pools []StorageVol
 	slice := *(*[]C.virStorageVolPtr)(unsafe.Pointer(&hdr))
 	for _, ptr := range slice {
+		trackHandle("StorageVol", unsafe.Pointer(ptr))
 		pools = append(pools, StorageVol{ptr})
 	}
 	C.free(unsafe.Pointer(cList))
@@ -32,7 +32,7 @@ import (
 )
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include "storage_pool_events_wrapper.h"
 */
 import "C"
@@ -83,7 +83,7 @@ func storagePoolEventGenericCallback(c C.virConnectPtr, s C.virStoragePoolPtr,
 	callback(connection, storage_pool)
 }
 
-func (c *Connect) StoragePoolEventLifecycleRegister(pool *StoragePool, callback StoragePoolEventLifecycleCallback) (int, error) {
+func (c *Connect) doStoragePoolEventLifecycleRegister(pool *StoragePool, callback StoragePoolEventLifecycleCallback) (int, error) {
 	if C.LIBVIR_VERSION_NUMBER < 2000000 {
 		return 0, makeNotImplementedError("virConnectStoragePoolEventRegisterAny")
 	}
@@ -107,7 +107,7 @@ func (c *Connect) StoragePoolEventLifecycleRegister(pool *StoragePool, callback
 	return int(ret), nil
 }
 
-func (c *Connect) StoragePoolEventRefreshRegister(pool *StoragePool, callback StoragePoolEventGenericCallback) (int, error) {
+func (c *Connect) doStoragePoolEventRefreshRegister(pool *StoragePool, callback StoragePoolEventGenericCallback) (int, error) {
 	if C.LIBVIR_VERSION_NUMBER < 2000000 {
 		return 0, makeNotImplementedError("virConnectStoragePoolEventRegisterAny")
 	}
@@ -131,7 +131,7 @@ func (c *Connect) StoragePoolEventRefreshRegister(pool *StoragePool, callback St
 	return int(ret), nil
 }
 
-func (c *Connect) StoragePoolEventDeregister(callbackId int) error {
+func (c *Connect) doStoragePoolEventDeregister(callbackId int) error {
 	if C.LIBVIR_VERSION_NUMBER < 2000000 {
 		return makeNotImplementedError("virConnectStoragePoolEventDeregisterAny")
 	}
@@ -26,7 +26,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <assert.h>
 #include "storage_pool_wrapper.h"
 
@@ -106,6 +106,18 @@ virStoragePoolGetAutostartWrapper(virStoragePoolPtr pool,
 }
 
 
+virConnectPtr
+virStoragePoolGetConnectWrapper(virStoragePoolPtr pool,
+                                virErrorPtr err)
+{
+    virConnectPtr ret = virStoragePoolGetConnect(pool);
+    if (!ret) {
+        virCopyLastError(err);
+    }
+    return ret;
+}
+
+
 int
 virStoragePoolGetInfoWrapper(virStoragePoolPtr pool,
                              virStoragePoolInfoPtr info,
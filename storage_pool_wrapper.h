@@ -28,6 +28,7 @@
 
 #include <libvirt/libvirt.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 #include "storage_pool_compat.h"
 
 int
@@ -58,6 +59,10 @@ virStoragePoolGetAutostartWrapper(virStoragePoolPtr pool,
                                   int *autostart,
                                   virErrorPtr err);
 
+virConnectPtr
+virStoragePoolGetConnectWrapper(virStoragePoolPtr pool,
+                                virErrorPtr err);
+
 int
 virStoragePoolGetInfoWrapper(virStoragePoolPtr pool,
                              virStoragePoolInfoPtr info,
@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "storage_volume_wrapper.h"
 */
@@ -122,7 +122,7 @@ type StorageVolInfo struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolDelete
-func (v *StorageVol) Delete(flags StorageVolDeleteFlags) error {
+func (v *StorageVol) doDelete(flags StorageVolDeleteFlags) error {
 	var err C.virError
 	result := C.virStorageVolDeleteWrapper(v.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -132,7 +132,10 @@ func (v *StorageVol) Delete(flags StorageVolDeleteFlags) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolFree
-func (v *StorageVol) Free() error {
+func (v *StorageVol) doFree() error {
+	if err := trackHandleFree("StorageVol", unsafe.Pointer(v.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virStorageVolFreeWrapper(v.ptr, &err)
 	if ret == -1 {
@@ -142,17 +145,18 @@ func (v *StorageVol) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolRef
-func (c *StorageVol) Ref() error {
+func (c *StorageVol) doRef() error {
 	var err C.virError
 	ret := C.virStorageVolRefWrapper(c.ptr, &err)
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("StorageVol", unsafe.Pointer(c.ptr))
 	return nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolGetInfo
-func (v *StorageVol) GetInfo() (*StorageVolInfo, error) {
+func (v *StorageVol) doGetInfo() (*StorageVolInfo, error) {
 	var cinfo C.virStorageVolInfo
 	var err C.virError
 	result := C.virStorageVolGetInfoWrapper(v.ptr, &cinfo, &err)
@@ -167,7 +171,7 @@ func (v *StorageVol) GetInfo() (*StorageVolInfo, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolGetInfoFlags
-func (v *StorageVol) GetInfoFlags(flags StorageVolInfoFlags) (*StorageVolInfo, error) {
+func (v *StorageVol) doGetInfoFlags(flags StorageVolInfoFlags) (*StorageVolInfo, error) {
 	if C.LIBVIR_VERSION_NUMBER < 3000000 {
 		return nil, makeNotImplementedError("virStorageVolGetInfoFlags")
 	}
@@ -186,7 +190,7 @@ func (v *StorageVol) GetInfoFlags(flags StorageVolInfoFlags) (*StorageVolInfo, e
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolGetKey
-func (v *StorageVol) GetKey() (string, error) {
+func (v *StorageVol) doGetKey() (string, error) {
 	var err C.virError
 	key := C.virStorageVolGetKeyWrapper(v.ptr, &err)
 	if key == nil {
@@ -196,7 +200,7 @@ func (v *StorageVol) GetKey() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolGetName
-func (v *StorageVol) GetName() (string, error) {
+func (v *StorageVol) doGetName() (string, error) {
 	var err C.virError
 	name := C.virStorageVolGetNameWrapper(v.ptr, &err)
 	if name == nil {
@@ -206,7 +210,7 @@ func (v *StorageVol) GetName() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolGetPath
-func (v *StorageVol) GetPath() (string, error) {
+func (v *StorageVol) doGetPath() (string, error) {
 	var err C.virError
 	result := C.virStorageVolGetPathWrapper(v.ptr, &err)
 	if result == nil {
@@ -218,7 +222,7 @@ func (v *StorageVol) GetPath() (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolGetXMLDesc
-func (v *StorageVol) GetXMLDesc(flags uint32) (string, error) {
+func (v *StorageVol) doGetXMLDesc(flags uint32) (string, error) {
 	var err C.virError
 	result := C.virStorageVolGetXMLDescWrapper(v.ptr, C.uint(flags), &err)
 	if result == nil {
@@ -230,7 +234,7 @@ func (v *StorageVol) GetXMLDesc(flags uint32) (string, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolResize
-func (v *StorageVol) Resize(capacity uint64, flags StorageVolResizeFlags) error {
+func (v *StorageVol) doResize(capacity uint64, flags StorageVolResizeFlags) error {
 	var err C.virError
 	result := C.virStorageVolResizeWrapper(v.ptr, C.ulonglong(capacity), C.uint(flags), &err)
 	if result == -1 {
@@ -240,7 +244,7 @@ func (v *StorageVol) Resize(capacity uint64, flags StorageVolResizeFlags) error
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolWipe
-func (v *StorageVol) Wipe(flags uint32) error {
+func (v *StorageVol) doWipe(flags uint32) error {
 	var err C.virError
 	result := C.virStorageVolWipeWrapper(v.ptr, C.uint(flags), &err)
 	if result == -1 {
@@ -250,7 +254,7 @@ func (v *StorageVol) Wipe(flags uint32) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolWipePattern
-func (v *StorageVol) WipePattern(algorithm StorageVolWipeAlgorithm, flags uint32) error {
+func (v *StorageVol) doWipePattern(algorithm StorageVolWipeAlgorithm, flags uint32) error {
 	var err C.virError
 	result := C.virStorageVolWipePatternWrapper(v.ptr, C.uint(algorithm), C.uint(flags), &err)
 	if result == -1 {
@@ -260,7 +264,7 @@ func (v *StorageVol) WipePattern(algorithm StorageVolWipeAlgorithm, flags uint32
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolUpload
-func (v *StorageVol) Upload(stream *Stream, offset, length uint64, flags StorageVolUploadFlags) error {
+func (v *StorageVol) doUpload(stream *Stream, offset, length uint64, flags StorageVolUploadFlags) error {
 	var err C.virError
 	if C.virStorageVolUploadWrapper(v.ptr, stream.ptr, C.ulonglong(offset),
 		C.ulonglong(length), C.uint(flags), &err) == -1 {
@@ -270,7 +274,7 @@ func (v *StorageVol) Upload(stream *Stream, offset, length uint64, flags Storage
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStorageVolDownload
-func (v *StorageVol) Download(stream *Stream, offset, length uint64, flags StorageVolDownloadFlags) error {
+func (v *StorageVol) doDownload(stream *Stream, offset, length uint64, flags StorageVolDownloadFlags) error {
 	var err C.virError
 	if C.virStorageVolDownloadWrapper(v.ptr, stream.ptr, C.ulonglong(offset),
 		C.ulonglong(length), C.uint(flags), &err) == -1 {
@@ -280,11 +284,12 @@ func (v *StorageVol) Download(stream *Stream, offset, length uint64, flags Stora
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-storage.html#virStoragePoolLookupByVolume
-func (v *StorageVol) LookupPoolByVolume() (*StoragePool, error) {
+func (v *StorageVol) doLookupPoolByVolume() (*StoragePool, error) {
 	var err C.virError
 	poolPtr := C.virStoragePoolLookupByVolumeWrapper(v.ptr, &err)
 	if poolPtr == nil {
 		return nil, makeError(&err)
 	}
+	trackHandle("StoragePool", unsafe.Pointer(poolPtr))
 	return &StoragePool{ptr: poolPtr}, nil
 }
@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <stdlib.h>
 #include "stream_wrapper.h"
 */
@@ -63,7 +63,7 @@ type Stream struct {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamAbort
-func (v *Stream) Abort() error {
+func (v *Stream) doAbort() error {
 	var err C.virError
 	result := C.virStreamAbortWrapper(v.ptr, &err)
 	if result == -1 {
@@ -74,7 +74,7 @@ func (v *Stream) Abort() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamFinish
-func (v *Stream) Finish() error {
+func (v *Stream) doFinish() error {
 	var err C.virError
 	result := C.virStreamFinishWrapper(v.ptr, &err)
 	if result == -1 {
@@ -85,7 +85,10 @@ func (v *Stream) Finish() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamFree
-func (v *Stream) Free() error {
+func (v *Stream) doFree() error {
+	if err := trackHandleFree("Stream", unsafe.Pointer(v.ptr)); err != nil {
+		return err
+	}
 	var err C.virError
 	ret := C.virStreamFreeWrapper(v.ptr, &err)
 	if ret == -1 {
@@ -95,17 +98,18 @@ func (v *Stream) Free() error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamRef
-func (c *Stream) Ref() error {
+func (c *Stream) doRef() error {
 	var err C.virError
 	ret := C.virStreamRefWrapper(c.ptr, &err)
 	if ret == -1 {
 		return makeError(&err)
 	}
+	trackHandleRef("Stream", unsafe.Pointer(c.ptr))
 	return nil
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamRecv
-func (v *Stream) Recv(p []byte) (int, error) {
+func (v *Stream) doRecv(p []byte) (int, error) {
 	var err C.virError
 	n := C.virStreamRecvWrapper(v.ptr, (*C.char)(unsafe.Pointer(&p[0])), C.size_t(len(p)), &err)
 	if n < 0 {
@@ -119,7 +123,7 @@ func (v *Stream) Recv(p []byte) (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamRecvFlags
-func (v *Stream) RecvFlags(p []byte, flags StreamRecvFlagsValues) (int, error) {
+func (v *Stream) doRecvFlags(p []byte, flags StreamRecvFlagsValues) (int, error) {
 	if C.LIBVIR_VERSION_NUMBER < 3004000 {
 		return 0, makeNotImplementedError("virStreamRecvFlags")
 	}
@@ -137,7 +141,7 @@ func (v *Stream) RecvFlags(p []byte, flags StreamRecvFlagsValues) (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamRecvHole
-func (v *Stream) RecvHole(flags uint) (int64, error) {
+func (v *Stream) doRecvHole(flags uint) (int64, error) {
 	if C.LIBVIR_VERSION_NUMBER < 3004000 {
 		return 0, makeNotImplementedError("virStreamSparseRecvHole")
 	}
@@ -153,7 +157,7 @@ func (v *Stream) RecvHole(flags uint) (int64, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamSend
-func (v *Stream) Send(p []byte) (int, error) {
+func (v *Stream) doSend(p []byte) (int, error) {
 	var err C.virError
 	n := C.virStreamSendWrapper(v.ptr, (*C.char)(unsafe.Pointer(&p[0])), C.size_t(len(p)), &err)
 	if n < 0 {
@@ -167,7 +171,7 @@ func (v *Stream) Send(p []byte) (int, error) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamSendHole
-func (v *Stream) SendHole(len int64, flags uint32) error {
+func (v *Stream) doSendHole(len int64, flags uint32) error {
 	if C.LIBVIR_VERSION_NUMBER < 3004000 {
 		return makeNotImplementedError("virStreamSendHole")
 	}
@@ -225,7 +229,7 @@ func streamSinkHoleCallback(stream C.virStreamPtr, length C.longlong, callbackID
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamRecvAll
-func (v *Stream) RecvAll(handler StreamSinkFunc) error {
+func (v *Stream) doRecvAll(handler StreamSinkFunc) error {
 
 	callbackID := registerCallbackId(handler)
 
@@ -240,7 +244,7 @@ func (v *Stream) RecvAll(handler StreamSinkFunc) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamSparseRecvAll
-func (v *Stream) SparseRecvAll(handler StreamSinkFunc, holeHandler StreamSinkHoleFunc) error {
+func (v *Stream) doSparseRecvAll(handler StreamSinkFunc, holeHandler StreamSinkHoleFunc) error {
 	if C.LIBVIR_VERSION_NUMBER < 3004000 {
 		return makeNotImplementedError("virStreamSparseSendAll")
 	}
@@ -332,7 +336,7 @@ func streamSourceSkipCallback(stream C.virStreamPtr, length C.longlong, callback
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamSendAll
-func (v *Stream) SendAll(handler StreamSourceFunc) error {
+func (v *Stream) doSendAll(handler StreamSourceFunc) error {
 
 	callbackID := registerCallbackId(handler)
 
@@ -347,7 +351,7 @@ func (v *Stream) SendAll(handler StreamSourceFunc) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamSparseSendAll
-func (v *Stream) SparseSendAll(handler StreamSourceFunc, holeHandler StreamSourceHoleFunc, skipHandler StreamSourceSkipFunc) error {
+func (v *Stream) doSparseSendAll(handler StreamSourceFunc, holeHandler StreamSourceHoleFunc, skipHandler StreamSourceSkipFunc) error {
 	if C.LIBVIR_VERSION_NUMBER < 3004000 {
 		return makeNotImplementedError("virStreamSparseSendAll")
 	}
@@ -371,7 +375,7 @@ func (v *Stream) SparseSendAll(handler StreamSourceFunc, holeHandler StreamSourc
 type StreamEventCallback func(*Stream, StreamEventType)
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamEventAddCallback
-func (v *Stream) EventAddCallback(events StreamEventType, callback StreamEventCallback) error {
+func (v *Stream) doEventAddCallback(events StreamEventType, callback StreamEventCallback) error {
 	callbackID := registerCallbackId(callback)
 
 	var err C.virError
@@ -396,7 +400,7 @@ func streamEventCallback(st C.virStreamPtr, events int, callbackID int) {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamEventUpdateCallback
-func (v *Stream) EventUpdateCallback(events StreamEventType) error {
+func (v *Stream) doEventUpdateCallback(events StreamEventType) error {
 	var err C.virError
 	ret := C.virStreamEventUpdateCallbackWrapper(v.ptr, (C.int)(events), &err)
 	if ret == -1 {
@@ -407,7 +411,7 @@ func (v *Stream) EventUpdateCallback(events StreamEventType) error {
 }
 
 // See also https://libvirt.org/html/libvirt-libvirt-stream.html#virStreamEventRemoveCallback
-func (v *Stream) EventRemoveCallback() error {
+func (v *Stream) doEventRemoveCallback() error {
 	var err C.virError
 	ret := C.virStreamEventRemoveCallbackWrapper(v.ptr, &err)
 	if ret == -1 {
@@ -27,7 +27,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <libvirt/libvirt.h>
 #include <libvirt/virterror.h>
 #include <stdlib.h>
@@ -147,7 +147,7 @@ func typedParamsPackNew(infomap map[string]typedParamsFieldInfo) (*C.virTypedPar
 	var nparams C.int
 	var maxparams C.int
 
-	defer C.virTypedParamsFree(cparams, nparams)
+	defer C.virTypedParamsFreeWrapper(cparams, nparams)
 
 	for name, value := range infomap {
 		if !*value.set {
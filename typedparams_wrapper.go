@@ -26,7 +26,7 @@
 package libvirt
 
 /*
-#cgo pkg-config: libvirt
+#cgo !libvirt_dlopen pkg-config: libvirt
 #include <assert.h>
 #include "typedparams_wrapper.h"
 
@@ -234,6 +234,13 @@ virTypedParamsGetStringWrapper(virTypedParameterPtr params,
     return ret;
 }
 
+void
+virTypedParamsFreeWrapper(virTypedParameterPtr params,
+			  int nparams)
+{
+    virTypedParamsFree(params, nparams);
+}
+
 
 
 */
@@ -28,6 +28,7 @@
 
 #include <libvirt/libvirt.h>
 #include <libvirt/virterror.h>
+#include "libvirt_dlopen.h"
 
 int
 virTypedParamsAddIntWrapper(virTypedParameterPtr *params,
@@ -121,6 +122,9 @@ virTypedParamsGetStringWrapper(virTypedParameterPtr params,
 			       const char *name,
 			       const char **value,
 			       virErrorPtr err);
+void
+virTypedParamsFreeWrapper(virTypedParameterPtr params,
+			  int nparams);
 
 
 #endif /* LIBVIRT_GO_TYPEDPARAMS_WRAPPER_H__ */
@@ -0,0 +1,327 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+// StorageVolCopyOptions controls how CopyVolume creates and fills
+// the new volume.
+type StorageVolCopyOptions struct {
+	// Name of the new volume. Defaults to the name of the source
+	Name string
+
+	// Format of the new volume, eg "qcow2". Defaults to the format of
+	// the source. Since the data is copied as is, this should only be
+	// set where the pool reports formats differently
+	Format string
+
+	// Copy holes as zeros, rather than preserving them. This is needed
+	// with libvirt older than 3.4.0, and with storage drivers which
+	// do not support sparse streams
+	NoSparse bool
+
+	// Called as the copy progresses, with the number of bytes copied,
+	// counting holes, and the capacity of the source
+	Progress func(copied, total uint64)
+}
+
+type volumeCopySize struct {
+	Unit  string `xml:"unit,attr,omitempty"`
+	Value uint64 `xml:",chardata"`
+}
+
+type volumeCopyFormat struct {
+	Type string `xml:"type,attr"`
+}
+
+type volumeCopyXML struct {
+	XMLName    xml.Name          `xml:"volume"`
+	Name       string            `xml:"name"`
+	Capacity   volumeCopySize    `xml:"capacity"`
+	Allocation *volumeCopySize   `xml:"allocation"`
+	Format     *volumeCopyFormat `xml:"target>format"`
+}
+
+// A piece of the volume passed from download to upload: either data,
+// or a hole of the given length
+type volumeChunk struct {
+	data []byte
+	hole int64
+}
+
+type volumeCopier struct {
+	ctx      context.Context
+	chunks   chan volumeChunk
+	stop     chan struct{}
+	progress func(copied, total uint64)
+
+	// Written by the download before chunks is closed
+	recvErr error
+
+	sendErr error
+	cur     volumeChunk
+	copied  uint64
+	total   uint64
+}
+
+func (c *volumeCopier) put(chunk volumeChunk) error {
+	select {
+	case c.chunks <- chunk:
+		return nil
+	case <-c.stop:
+		return context.Canceled
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	}
+}
+
+func (c *volumeCopier) sink(stream *Stream, data []byte) (int, error) {
+	if err := c.put(volumeChunk{data: data}); err != nil {
+		c.recvErr = err
+		return 0, err
+	}
+	return len(data), nil
+}
+
+func (c *volumeCopier) sinkHole(stream *Stream, length int64) error {
+	if err := c.put(volumeChunk{hole: length}); err != nil {
+		c.recvErr = err
+		return err
+	}
+	return nil
+}
+
+// Makes sure there is a current chunk, returning false at the end
+func (c *volumeCopier) next() (bool, error) {
+	if len(c.cur.data) != 0 || c.cur.hole != 0 {
+		return true, nil
+	}
+	select {
+	case chunk, ok := <-c.chunks:
+		if !ok {
+			if c.recvErr != nil {
+				c.sendErr = c.recvErr
+				return false, c.recvErr
+			}
+			return false, nil
+		}
+		c.cur = chunk
+		return true, nil
+	case <-c.ctx.Done():
+		c.sendErr = c.ctx.Err()
+		return false, c.sendErr
+	}
+}
+
+func (c *volumeCopier) advance(n uint64) {
+	c.copied += n
+	if c.progress != nil {
+		c.progress(c.copied, c.total)
+	}
+}
+
+func (c *volumeCopier) source(stream *Stream, nbytes int) ([]byte, error) {
+	more, err := c.next()
+	if err != nil || !more {
+		return nil, err
+	}
+	// Holes only arrive on sparse streams, where the hole callback
+	// has already dealt with them
+	data := c.cur.data
+	if len(data) > nbytes {
+		data = data[:nbytes]
+	}
+	c.cur.data = c.cur.data[len(data):]
+	c.advance(uint64(len(data)))
+	return data, nil
+}
+
+func (c *volumeCopier) sourceHole(stream *Stream) (bool, int64, error) {
+	more, err := c.next()
+	if err != nil || !more {
+		return false, 0, err
+	}
+	if c.cur.hole != 0 {
+		return false, c.cur.hole, nil
+	}
+	return true, int64(len(c.cur.data)), nil
+}
+
+func (c *volumeCopier) sourceSkip(stream *Stream, length int64) error {
+	c.cur.hole -= length
+	c.advance(uint64(length))
+	return nil
+}
+
+// CopyVolume creates a volume in dstPool with the same capacity and
+// format as src, and copies the contents of src into it. The pool may
+// be on a different connection from src, eg on another host.
+//
+// Holes in src are preserved in the new volume, unless opts.NoSparse
+// is set. If the copy fails, or ctx is done before it finishes, the
+// new volume is deleted again. A nil opts uses the defaults.
+func CopyVolume(ctx context.Context, src *StorageVol, dstPool *StoragePool, opts *StorageVolCopyOptions) (*StorageVol, error) {
+	if opts == nil {
+		opts = &StorageVolCopyOptions{}
+	}
+
+	srcXML, err := src.GetXMLDesc(0)
+	if err != nil {
+		return nil, err
+	}
+	var desc volumeCopyXML
+	if err := xml.Unmarshal([]byte(srcXML), &desc); err != nil {
+		return nil, err
+	}
+	info, err := src.GetInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	dstDesc := volumeCopyXML{
+		Name:       desc.Name,
+		Capacity:   volumeCopySize{Unit: "bytes", Value: info.Capacity},
+		Allocation: &volumeCopySize{Unit: "bytes", Value: 0},
+		Format:     desc.Format,
+	}
+	if opts.Name != "" {
+		dstDesc.Name = opts.Name
+	}
+	if opts.Format != "" {
+		dstDesc.Format = &volumeCopyFormat{Type: opts.Format}
+	}
+	dstXML, err := xml.Marshal(&dstDesc)
+	if err != nil {
+		return nil, err
+	}
+
+	srcPool, err := src.LookupPoolByVolume()
+	if err != nil {
+		return nil, err
+	}
+	srcConn, err := srcPool.StoragePoolGetConnect()
+	srcPool.Free()
+	if err != nil {
+		return nil, err
+	}
+	defer srcConn.Close()
+	dstConn, err := dstPool.StoragePoolGetConnect()
+	if err != nil {
+		return nil, err
+	}
+	defer dstConn.Close()
+
+	dst, err := dstPool.StorageVolCreateXML(string(dstXML), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := copyVolumeData(ctx, src, srcConn, dst, dstConn, info.Capacity, opts); err != nil {
+		dst.Delete(0)
+		dst.Free()
+		return nil, err
+	}
+	return dst, nil
+}
+
+func copyVolumeData(ctx context.Context, src *StorageVol, srcConn *Connect, dst *StorageVol, dstConn *Connect, total uint64, opts *StorageVolCopyOptions) error {
+	var downloadFlags StorageVolDownloadFlags
+	var uploadFlags StorageVolUploadFlags
+	if !opts.NoSparse {
+		downloadFlags = STORAGE_VOL_DOWNLOAD_SPARSE_STREAM
+		uploadFlags = STORAGE_VOL_UPLOAD_SPARSE_STREAM
+	}
+
+	srcStream, err := srcConn.NewStream(0)
+	if err != nil {
+		return err
+	}
+	defer srcStream.Free()
+	dstStream, err := dstConn.NewStream(0)
+	if err != nil {
+		return err
+	}
+	defer dstStream.Free()
+
+	if err := src.Download(srcStream, 0, 0, downloadFlags); err != nil {
+		return err
+	}
+	if err := dst.Upload(dstStream, 0, 0, uploadFlags); err != nil {
+		srcStream.Abort()
+		return err
+	}
+
+	c := &volumeCopier{
+		ctx:      ctx,
+		chunks:   make(chan volumeChunk, 16),
+		stop:     make(chan struct{}),
+		progress: opts.Progress,
+		total:    total,
+	}
+
+	downloaded := make(chan struct{})
+	go func() {
+		defer close(downloaded)
+		var err error
+		if opts.NoSparse {
+			err = srcStream.RecvAll(c.sink)
+		} else {
+			err = srcStream.SparseRecvAll(c.sink, c.sinkHole)
+		}
+		if err == nil {
+			err = srcStream.Finish()
+		}
+		if c.recvErr == nil {
+			c.recvErr = err
+		}
+		close(c.chunks)
+	}()
+
+	if opts.NoSparse {
+		err = dstStream.SendAll(c.source)
+	} else {
+		err = dstStream.SparseSendAll(c.source, c.sourceHole, c.sourceSkip)
+	}
+	// Report the cause of the failure, rather than the stream
+	// being aborted as a result
+	if c.sendErr != nil {
+		err = c.sendErr
+	}
+	if err == nil {
+		err = dstStream.Finish()
+	}
+
+	if err != nil {
+		close(c.stop)
+		<-downloaded
+		return err
+	}
+	<-downloaded
+	return c.recvErr
+}
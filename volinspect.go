@@ -0,0 +1,222 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"libvirt.org/libvirt-go/imageinfo"
+)
+
+// The image details found by StorageVol.InspectImage
+type ImageInfo = imageinfo.Info
+
+// Reads ranges of a volume, each with its own download stream
+type storageVolReader struct {
+	vol  *StorageVol
+	conn *Connect
+	size int64
+}
+
+func newStorageVolReader(vol *StorageVol) (*storageVolReader, error) {
+	pool, err := vol.LookupPoolByVolume()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := pool.StoragePoolGetConnect()
+	pool.Free()
+	if err != nil {
+		return nil, err
+	}
+
+	// The capacity of a volume is its virtual size, which may be far
+	// larger than the image itself
+	info, err := vol.GetInfoFlags(STORAGE_VOL_GET_PHYSICAL)
+	size := int64(0)
+	if err == nil {
+		size = int64(info.Allocation)
+	} else if info, err = vol.GetInfo(); err == nil {
+		size = int64(info.Capacity)
+	} else {
+		conn.Close()
+		return nil, err
+	}
+	return &storageVolReader{vol: vol, conn: conn, size: size}, nil
+}
+
+func (r *storageVolReader) close() {
+	r.conn.Close()
+}
+
+func (r *storageVolReader) ReadAt(p []byte, off int64) (int, error) {
+	if off >= r.size {
+		return 0, io.EOF
+	}
+	want := p
+	if int64(len(want)) > r.size-off {
+		want = want[:r.size-off]
+	}
+
+	stream, err := r.conn.NewStream(0)
+	if err != nil {
+		return 0, err
+	}
+	defer stream.Free()
+	if err := r.vol.Download(stream, uint64(off), uint64(len(want)), 0); err != nil {
+		return 0, err
+	}
+	n := 0
+	for n < len(want) {
+		got, err := stream.Recv(want[n:])
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			stream.Abort()
+			return n, err
+		}
+		n += got
+	}
+	if err := stream.Finish(); err != nil {
+		return n, err
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// InspectImage reads the header of the volume to find its real format,
+// virtual size, backing file and encryption, rather than trusting what
+// the storage pool reports. Only the ranges of the volume holding the
+// header are downloaded.
+func (v *StorageVol) doInspectImage() (*ImageInfo, error) {
+	reader, err := newStorageVolReader(v)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.close()
+	return imageinfo.Inspect(reader, reader.size)
+}
+
+// InspectImageChain is InspectImage which also inspects the backing
+// chain of the volume, linking each image to its backing image with
+// the Backing field. Each backing file must be a volume of a storage
+// pool on the same connection. The chain stops at a backing file which
+// is not a local file, such as an NBD URI.
+func (v *StorageVol) doInspectImageChain() (*ImageInfo, error) {
+	path, err := v.GetPath()
+	if err != nil {
+		return nil, err
+	}
+	top, err := v.InspectImage()
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := v.LookupPoolByVolume()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := pool.StoragePoolGetConnect()
+	pool.Free()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	seen := map[string]bool{path: true}
+	for info := top; ; info = info.Backing {
+		backing := imageinfo.ResolveBackingFile(path, info.BackingFile)
+		if backing == "" {
+			return top, nil
+		}
+		if seen[backing] {
+			return nil, fmt.Errorf("Backing chain loops back to %s", backing)
+		}
+		if len(seen) == imageinfo.MaxChainDepth {
+			return nil, fmt.Errorf("Backing chain of %s is longer than %d images",
+				top.BackingFile, imageinfo.MaxChainDepth)
+		}
+		seen[backing] = true
+
+		vol, err := conn.LookupStorageVolByPath(backing)
+		if err != nil {
+			return nil, err
+		}
+		info.Backing, err = vol.InspectImage()
+		vol.Free()
+		if err != nil {
+			return nil, err
+		}
+		path = backing
+	}
+}
+
+type storageVolFormatXML struct {
+	XMLName xml.Name `xml:"volume"`
+	Format  *struct {
+		Type string `xml:"type,attr"`
+	} `xml:"target>format"`
+	Encryption *struct {
+		Format string `xml:"format,attr"`
+	} `xml:"target>encryption"`
+}
+
+// CheckFormat compares the format declared in the XML of the volume
+// with the format found by InspectImage. If they differ, the error is
+// an *imageinfo.FormatMismatchError, and the image details are also
+// returned. A raw volume with LUKS encryption is expected to hold a
+// LUKS image.
+func (v *StorageVol) doCheckFormat() (*ImageInfo, error) {
+	xmlDesc, err := v.GetXMLDesc(0)
+	if err != nil {
+		return nil, err
+	}
+	var desc storageVolFormatXML
+	if err := xml.Unmarshal([]byte(xmlDesc), &desc); err != nil {
+		return nil, err
+	}
+	declared := ""
+	if desc.Format != nil {
+		declared = desc.Format.Type
+	}
+	if desc.Encryption != nil && desc.Encryption.Format == "luks" &&
+		(declared == "" || declared == string(imageinfo.FORMAT_RAW)) {
+		declared = string(imageinfo.FORMAT_LUKS)
+	}
+
+	info, err := v.InspectImage()
+	if err != nil {
+		return nil, err
+	}
+	if !info.MatchesFormat(declared) {
+		return info, &imageinfo.FormatMismatchError{Declared: declared, Detected: info.Format}
+	}
+	return info, nil
+}
@@ -0,0 +1,68 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"testing"
+
+	"libvirt.org/libvirt-go/imageinfo"
+)
+
+func TestStorageVolCheckFormat(t *testing.T) {
+	pool, conn := buildTestStoragePool("")
+	defer func() {
+		pool.Undefine()
+		pool.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+	if err := pool.Create(0); err != nil {
+		t.Fatal(err)
+	}
+	defer pool.Destroy()
+	vol, err := pool.StorageVolCreateXML(testStorageVolXML("", "default-pool"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		vol.Delete(STORAGE_VOL_DELETE_NORMAL)
+		vol.Free()
+	}()
+
+	info, err := vol.CheckFormat()
+	if err != nil {
+		// Not every test driver version supports volume streams
+		if _, ok := err.(*imageinfo.FormatMismatchError); ok {
+			t.Fatal(err)
+		}
+		return
+	}
+	// A new volume is empty, so holds a raw image
+	if info.Format != imageinfo.FORMAT_RAW {
+		t.Fatalf("Format is %s, expected raw", info.Format)
+	}
+}
@@ -0,0 +1,139 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Wakes up goroutines waiting for a domain to change state, when a
+// lifecycle event arrives for it. Events are only delivered when an
+// event loop implementation was registered before the connection was
+// opened, and is being run, so the waiters also poll the state.
+type lifecycleWatcher struct {
+	conn       *Connect
+	callbackID int
+	registered bool
+
+	lock    sync.Mutex
+	waiters map[string]map[chan struct{}]bool
+}
+
+func newLifecycleWatcher(conn *Connect) *lifecycleWatcher {
+	w := &lifecycleWatcher{
+		conn:    conn,
+		waiters: make(map[string]map[chan struct{}]bool),
+	}
+	if id, err := conn.DomainEventLifecycleRegister(nil, w.event); err == nil {
+		w.callbackID = id
+		w.registered = true
+	}
+	return w
+}
+
+func (w *lifecycleWatcher) event(c *Connect, d *Domain, event *DomainEventLifecycle) {
+	uuid, err := d.doGetUUIDString()
+	if err != nil {
+		return
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	for wake := range w.waiters[uuid] {
+		select {
+		case wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Returns a channel which receives a value after each lifecycle event
+// for the domain, until the returned function is called
+func (w *lifecycleWatcher) watch(uuid string) (<-chan struct{}, func()) {
+	wake := make(chan struct{}, 1)
+
+	w.lock.Lock()
+	if w.waiters[uuid] == nil {
+		w.waiters[uuid] = make(map[chan struct{}]bool)
+	}
+	w.waiters[uuid][wake] = true
+	w.lock.Unlock()
+
+	return wake, func() {
+		w.lock.Lock()
+		delete(w.waiters[uuid], wake)
+		if len(w.waiters[uuid]) == 0 {
+			delete(w.waiters, uuid)
+		}
+		w.lock.Unlock()
+	}
+}
+
+func (w *lifecycleWatcher) close() {
+	if w.registered {
+		w.conn.DomainEventDeregister(w.callbackID)
+		w.registered = false
+	}
+}
+
+// Waits until done accepts the status of the domain, checking it
+// after every lifecycle event, and every pollInterval. A domain which
+// no longer exists is treated as shut off.
+func (w *lifecycleWatcher) waitStatus(ctx context.Context, dom *Domain, pollInterval time.Duration, done func(status *DomainStatus) bool) (*DomainStatus, error) {
+	uuid, err := dom.GetUUIDString()
+	if err != nil {
+		return nil, err
+	}
+
+	// Watch before checking the status, so that no event is missed
+	wake, stop := w.watch(uuid)
+	defer stop()
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := dom.GetStatus()
+		if err != nil {
+			if virErr, ok := err.(Error); !ok || virErr.Code != ERR_NO_DOMAIN {
+				return nil, err
+			}
+			status = newDomainStatus(DOMAIN_SHUTOFF, int(DOMAIN_SHUTOFF_UNKNOWN))
+		}
+		if done(status) {
+			return status, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return status, ctx.Err()
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,117 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"time"
+)
+
+// DomainStatus is the state of a domain, along with the reason it
+// entered that state.
+type DomainStatus struct {
+	State DomainState
+
+	// The reason for the state, with the type matching the State:
+	// DomainRunningReason for DOMAIN_RUNNING, DomainPausedReason for
+	// DOMAIN_PAUSED, DomainShutoffReason for DOMAIN_SHUTOFF, and so
+	// on. For an unknown state, the plain int reported by libvirt.
+	Reason interface{}
+}
+
+func newDomainStatus(state DomainState, reason int) *DomainStatus {
+	status := &DomainStatus{State: state}
+	switch state {
+	case DOMAIN_NOSTATE:
+		status.Reason = DomainNostateReason(reason)
+	case DOMAIN_RUNNING:
+		status.Reason = DomainRunningReason(reason)
+	case DOMAIN_BLOCKED:
+		status.Reason = DomainBlockedReason(reason)
+	case DOMAIN_PAUSED:
+		status.Reason = DomainPausedReason(reason)
+	case DOMAIN_SHUTDOWN:
+		status.Reason = DomainShutdownReason(reason)
+	case DOMAIN_CRASHED:
+		status.Reason = DomainCrashedReason(reason)
+	case DOMAIN_PMSUSPENDED:
+		status.Reason = DomainPMSuspendedReason(reason)
+	case DOMAIN_SHUTOFF:
+		status.Reason = DomainShutoffReason(reason)
+	default:
+		status.Reason = reason
+	}
+	return status
+}
+
+// GetStatus is GetState, with the reason converted to its type.
+func (d *Domain) doGetStatus() (*DomainStatus, error) {
+	state, reason, err := d.doGetState()
+	if err != nil {
+		return nil, err
+	}
+	return newDomainStatus(state, reason), nil
+}
+
+// How often WaitFor checks the domain status, in addition to the
+// checks prompted by lifecycle events
+const waitForPollInterval = time.Second
+
+// WaitFor waits until predicate accepts the status of the domain, and
+// returns that status. A domain which no longer exists is reported as
+// shut off.
+//
+// The status is checked on each lifecycle event for the domain, which
+// is only delivered if an event loop implementation was registered
+// before the connection was opened, and is being run. Without one, or
+// if no events arrive, the status is checked every second instead.
+//
+// If ctx is done first, the last status seen is returned along with
+// ctx.Err().
+func (d *Domain) doWaitFor(ctx context.Context, predicate func(status *DomainStatus) bool) (*DomainStatus, error) {
+	conn, err := d.DomainGetConnect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	watcher := newLifecycleWatcher(conn)
+	defer watcher.close()
+
+	return watcher.waitStatus(ctx, d, waitForPollInterval, predicate)
+}
+
+// WaitForState waits until the domain is in one of the given states,
+// as described for WaitFor.
+func (d *Domain) doWaitForState(ctx context.Context, states ...DomainState) (*DomainStatus, error) {
+	return d.doWaitFor(ctx, func(status *DomainStatus) bool {
+		for _, state := range states {
+			if status.State == state {
+				return true
+			}
+		}
+		return false
+	})
+}
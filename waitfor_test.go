@@ -0,0 +1,116 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDomainGetStatus(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	status, err := dom.GetStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.State != DOMAIN_SHUTOFF {
+		t.Fatalf("Domain state %d, expected shut off", status.State)
+	}
+	if _, ok := status.Reason.(DomainShutoffReason); !ok {
+		t.Fatalf("Reason %T, expected DomainShutoffReason", status.Reason)
+	}
+
+	if err := dom.Create(); err != nil {
+		t.Fatal(err)
+	}
+	defer dom.Destroy()
+	status, err = dom.GetStatus()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reason, ok := status.Reason.(DomainRunningReason); !ok || reason != DOMAIN_RUNNING_BOOTED {
+		t.Fatalf("Reason %v, expected DOMAIN_RUNNING_BOOTED", status.Reason)
+	}
+}
+
+func TestDomainWaitForState(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Destroy()
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		dom.Create()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	status, err := dom.WaitForState(ctx, DOMAIN_RUNNING, DOMAIN_PAUSED)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.State != DOMAIN_RUNNING {
+		t.Fatalf("Domain state %d, expected running", status.State)
+	}
+}
+
+func TestDomainWaitForTimeout(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	status, err := dom.WaitFor(ctx, func(status *DomainStatus) bool {
+		return status.State == DOMAIN_RUNNING
+	})
+	if err != context.DeadlineExceeded {
+		t.Fatalf("Expected deadline exceeded, got %v", err)
+	}
+	if status == nil || status.State != DOMAIN_SHUTOFF {
+		t.Fatalf("Expected last status to be shut off, got %v", status)
+	}
+}
@@ -0,0 +1,351 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sort"
+	"strings"
+	"time"
+)
+
+// WaitForIPsOptions configures Domain.WaitForIPs.
+type WaitForIPsOptions struct {
+	// The sources to ask for addresses, in order of preference. An
+	// interface takes its addresses from the first source which
+	// reports any. Defaults to DOMAIN_INTERFACE_ADDRESSES_SRC_LEASE,
+	// DOMAIN_INTERFACE_ADDRESSES_SRC_AGENT and
+	// DOMAIN_INTERFACE_ADDRESSES_SRC_ARP
+	Sources []DomainInterfaceAddressesSource
+
+	// The address types wanted, such as IP_ADDR_TYPE_IPV4. All types
+	// are wanted if empty
+	Types []IPAddrType
+
+	// Wait until every interface of the domain has an address, rather
+	// than any one of them
+	AllInterfaces bool
+
+	// How often the sources are asked again. Defaults to two seconds
+	PollInterval time.Duration
+}
+
+// DefaultWaitForIPsSources are the sources used by WaitForIPs when
+// none are given.
+var DefaultWaitForIPsSources = []DomainInterfaceAddressesSource{
+	DOMAIN_INTERFACE_ADDRESSES_SRC_LEASE,
+	DOMAIN_INTERFACE_ADDRESSES_SRC_AGENT,
+	DOMAIN_INTERFACE_ADDRESSES_SRC_ARP,
+}
+
+// DomainNICAddresses are the addresses found by WaitForIPs for one
+// network interface of a domain.
+type DomainNICAddresses struct {
+	Alias string
+	MAC   string
+	// The libvirt network the interface is connected to, if any
+	Network string
+	// The source which reported the addresses
+	Source DomainInterfaceAddressesSource
+	// Sorted with IPv4 addresses first
+	Addrs []DomainIPAddress
+}
+
+// An interface from the domain XML, with the addresses found for it
+type waitIPsNIC struct {
+	key   string
+	addrs DomainNICAddresses
+}
+
+// WaitForIPs waits until the guest has an IP address, and returns the
+// addresses of the network interfaces of the domain, keyed by their
+// device alias, or by their MAC address if they have no alias.
+//
+// Addresses are matched to the interfaces in the domain XML by MAC
+// address, so that the guest's loopback and any interfaces it creates
+// itself are left out. For interfaces connected to a libvirt network,
+// the lease source also looks in the DHCP leases of the network.
+// Loopback, link-local and unspecified addresses are left out too.
+//
+// The guest agent is asked again as soon as it connects, if an event
+// loop is running, as well as every opts.PollInterval. If ctx is done
+// first, the addresses found so far are returned along with ctx.Err().
+// An error is returned if the domain is not running, or has no
+// network interfaces.
+func (d *Domain) doWaitForIPs(ctx context.Context, opts *WaitForIPsOptions) (map[string]DomainNICAddresses, error) {
+	if opts == nil {
+		opts = &WaitForIPsOptions{}
+	}
+	sources := opts.Sources
+	if len(sources) == 0 {
+		sources = DefaultWaitForIPsSources
+	}
+	pollInterval := opts.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = 2 * time.Second
+	}
+
+	conn, err := d.DomainGetConnect()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	wake := make(chan struct{}, 1)
+	for _, source := range sources {
+		if source != DOMAIN_INTERFACE_ADDRESSES_SRC_AGENT {
+			continue
+		}
+		callbackID, err := conn.DomainEventAgentLifecycleRegister(d, func(c *Connect, dom *Domain, event *DomainEventAgentLifecycle) {
+			if event.State != CONNECT_DOMAIN_EVENT_AGENT_LIFECYCLE_STATE_CONNECTED {
+				return
+			}
+			select {
+			case wake <- struct{}{}:
+			default:
+			}
+		})
+		if err == nil {
+			defer conn.DomainEventDeregister(callbackID)
+		}
+		break
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var found map[string]DomainNICAddresses
+	for {
+		active, err := d.IsActive()
+		if err != nil {
+			return found, err
+		}
+		if !active {
+			return found, Error{
+				Code:    ERR_OPERATION_INVALID,
+				Domain:  FROM_DOMAIN,
+				Message: "Domain is not running",
+				Level:   ERR_ERROR,
+			}
+		}
+
+		nics, err := waitIPsNICs(d)
+		if err != nil {
+			return found, err
+		}
+		if len(nics) == 0 {
+			// No address could ever be found
+			return found, Error{
+				Code:    ERR_OPERATION_INVALID,
+				Domain:  FROM_DOMAIN,
+				Message: "Domain has no network interfaces",
+				Level:   ERR_ERROR,
+			}
+		}
+		for _, source := range sources {
+			if waitIPsDone(nics, true) {
+				break
+			}
+			waitIPsQuery(conn, d, source, nics, opts.Types)
+		}
+
+		found = make(map[string]DomainNICAddresses)
+		for _, nic := range nics {
+			if len(nic.addrs.Addrs) > 0 {
+				found[nic.key] = nic.addrs
+			}
+		}
+		if waitIPsDone(nics, opts.AllInterfaces) {
+			return found, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return found, ctx.Err()
+		case <-wake:
+		case <-ticker.C:
+		}
+	}
+}
+
+// Lists the interfaces in the live domain XML
+func waitIPsNICs(d *Domain) ([]*waitIPsNIC, error) {
+	spec, err := d.GetSpec(0)
+	if err != nil {
+		return nil, err
+	}
+	var nics []*waitIPsNIC
+	if spec.Devices == nil {
+		return nics, nil
+	}
+	for _, iface := range spec.Devices.Interfaces {
+		if iface.MAC == nil || iface.MAC.Address == "" {
+			continue
+		}
+		nic := &waitIPsNIC{
+			key: strings.ToLower(iface.MAC.Address),
+			addrs: DomainNICAddresses{
+				MAC: strings.ToLower(iface.MAC.Address),
+			},
+		}
+		if iface.Alias != nil && iface.Alias.Name != "" {
+			nic.key = iface.Alias.Name
+			nic.addrs.Alias = iface.Alias.Name
+		}
+		if iface.Type == "network" && iface.Source != nil {
+			nic.addrs.Network = iface.Source.Network
+		}
+		nics = append(nics, nic)
+	}
+	return nics, nil
+}
+
+// Reports whether all the interfaces, or any of them, have addresses
+func waitIPsDone(nics []*waitIPsNIC, all bool) bool {
+	for _, nic := range nics {
+		if all && len(nic.addrs.Addrs) == 0 {
+			return false
+		}
+		if !all && len(nic.addrs.Addrs) > 0 {
+			return true
+		}
+	}
+	return all
+}
+
+// Gives the interfaces without addresses those reported by source.
+// A source which fails, such as the guest agent before it is running,
+// is passed over.
+func waitIPsQuery(conn *Connect, d *Domain, source DomainInterfaceAddressesSource, nics []*waitIPsNIC, types []IPAddrType) {
+	byMAC := make(map[string][]DomainIPAddress)
+	if ifaces, err := d.ListAllInterfaceAddresses(source); err == nil {
+		for _, iface := range ifaces {
+			mac := strings.ToLower(iface.Hwaddr)
+			byMAC[mac] = append(byMAC[mac], iface.Addrs...)
+		}
+	}
+
+	if source == DOMAIN_INTERFACE_ADDRESSES_SRC_LEASE {
+		leases := make(map[string][]NetworkDHCPLease)
+		for _, nic := range nics {
+			if nic.addrs.Network == "" || len(nic.addrs.Addrs) > 0 {
+				continue
+			}
+			if _, ok := leases[nic.addrs.Network]; !ok {
+				leases[nic.addrs.Network] = networkDHCPLeases(conn, nic.addrs.Network)
+			}
+			for _, lease := range leases[nic.addrs.Network] {
+				if strings.ToLower(lease.Mac) != nic.addrs.MAC {
+					continue
+				}
+				if lease.ExpiryTime.Unix() > 0 && lease.ExpiryTime.Before(time.Now()) {
+					continue
+				}
+				byMAC[nic.addrs.MAC] = append(byMAC[nic.addrs.MAC], DomainIPAddress{
+					Type:   int(lease.Type),
+					Addr:   lease.IPaddr,
+					Prefix: lease.Prefix,
+				})
+			}
+		}
+	}
+
+	for _, nic := range nics {
+		if len(nic.addrs.Addrs) > 0 {
+			continue
+		}
+		if addrs := filterIPAddresses(byMAC[nic.addrs.MAC], types); len(addrs) > 0 {
+			nic.addrs.Addrs = addrs
+			nic.addrs.Source = source
+		}
+	}
+}
+
+// Returns the DHCP leases of the named network, or none if they
+// cannot be read
+func networkDHCPLeases(conn *Connect, name string) []NetworkDHCPLease {
+	network, err := conn.LookupNetworkByName(name)
+	if err != nil {
+		return nil
+	}
+	defer network.Free()
+	leases, err := network.GetDHCPLeases()
+	if err != nil {
+		return nil
+	}
+	return leases
+}
+
+// Drops duplicate, loopback, link-local and unspecified addresses, and
+// those not of the given types, and sorts the rest with IPv4 first
+func filterIPAddresses(addrs []DomainIPAddress, types []IPAddrType) []DomainIPAddress {
+	type parsedAddr struct {
+		addr DomainIPAddress
+		ip   net.IP
+	}
+	seen := make(map[string]bool)
+	var parsed []parsedAddr
+	for _, addr := range addrs {
+		ip := net.ParseIP(addr.Addr)
+		if ip == nil || ip.IsLoopback() || ip.IsUnspecified() ||
+			ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+			continue
+		}
+		if len(types) > 0 {
+			wanted := false
+			for _, t := range types {
+				if IPAddrType(addr.Type) == t {
+					wanted = true
+					break
+				}
+			}
+			if !wanted {
+				continue
+			}
+		}
+		if seen[ip.String()] {
+			continue
+		}
+		seen[ip.String()] = true
+		parsed = append(parsed, parsedAddr{addr, ip})
+	}
+
+	sort.Slice(parsed, func(i, j int) bool {
+		iv4, jv4 := parsed[i].ip.To4() != nil, parsed[j].ip.To4() != nil
+		if iv4 != jv4 {
+			return iv4
+		}
+		return bytes.Compare(parsed[i].ip.To16(), parsed[j].ip.To16()) < 0
+	})
+
+	var result []DomainIPAddress
+	for _, p := range parsed {
+		result = append(result, p.addr)
+	}
+	return result
+}
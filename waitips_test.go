@@ -0,0 +1,153 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFilterIPAddresses(t *testing.T) {
+	v4 := int(IP_ADDR_TYPE_IPV4)
+	v6 := int(IP_ADDR_TYPE_IPV6)
+	addrs := []DomainIPAddress{
+		{Type: v6, Addr: "fe80::5054:ff:fe00:1", Prefix: 64},
+		{Type: v6, Addr: "2001:db8::10", Prefix: 64},
+		{Type: v4, Addr: "127.0.0.1", Prefix: 8},
+		{Type: v4, Addr: "192.168.122.20", Prefix: 24},
+		{Type: v4, Addr: "169.254.3.4", Prefix: 16},
+		{Type: v4, Addr: "192.168.122.3", Prefix: 24},
+		{Type: v4, Addr: "192.168.122.20", Prefix: 24},
+		{Type: v6, Addr: "::1", Prefix: 128},
+	}
+
+	got := filterIPAddresses(addrs, nil)
+	expected := []DomainIPAddress{
+		{Type: v4, Addr: "192.168.122.3", Prefix: 24},
+		{Type: v4, Addr: "192.168.122.20", Prefix: 24},
+		{Type: v6, Addr: "2001:db8::10", Prefix: 64},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Fatalf("Got %v, expected %v", got, expected)
+	}
+
+	got = filterIPAddresses(addrs, []IPAddrType{IP_ADDR_TYPE_IPV6})
+	if !reflect.DeepEqual(got, expected[2:]) {
+		t.Fatalf("Got %v, expected %v", got, expected[2:])
+	}
+}
+
+func TestDomainWaitForIPs(t *testing.T) {
+	conn := buildTestConnection()
+	dom, err := conn.DomainDefineXML(`<domain type="test">
+		<name>` + time.Now().String() + `</name>
+		<memory unit="KiB">8192</memory>
+		<os>
+			<type>hvm</type>
+		</os>
+		<devices>
+			<interface type="network">
+				<mac address="52:54:00:12:34:56"/>
+				<source network="default"/>
+			</interface>
+		</devices>
+	</domain>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		dom.Destroy()
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	opts := &WaitForIPsOptions{PollInterval: 100 * time.Millisecond}
+
+	_, err = dom.WaitForIPs(ctx, opts)
+	if virErr, ok := err.(Error); !ok || virErr.Code != ERR_OPERATION_INVALID {
+		t.Fatalf("Expected an error for an inactive domain, got %v", err)
+	}
+
+	if err := dom.Create(); err != nil {
+		t.Fatal(err)
+	}
+	found, err := dom.WaitForIPs(ctx, opts)
+	if err == context.DeadlineExceeded {
+		t.Skip("The test driver reported no addresses")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 {
+		t.Fatalf("Found %d interfaces, expected 1", len(found))
+	}
+	for key, nic := range found {
+		if nic.MAC != "52:54:00:12:34:56" {
+			t.Errorf("Interface %s has MAC %s", key, nic.MAC)
+		}
+		if nic.Network != "default" {
+			t.Errorf("Interface %s has network %q", key, nic.Network)
+		}
+		for _, addr := range nic.Addrs {
+			ip := net.ParseIP(addr.Addr)
+			if ip == nil || ip.IsLoopback() || ip.IsLinkLocalUnicast() {
+				t.Errorf("Interface %s has unexpected address %s", key, addr.Addr)
+			}
+		}
+	}
+}
+
+func TestDomainWaitForIPsNoInterfaces(t *testing.T) {
+	dom, conn := buildTestDomain()
+	defer func() {
+		dom.Destroy()
+		dom.Undefine()
+		dom.Free()
+		if res, _ := conn.Close(); res != 0 {
+			t.Errorf("Close() == %d, expected 0", res)
+		}
+	}()
+	if err := dom.Create(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Returns at once rather than waiting for the context
+	found, err := dom.WaitForIPs(context.Background(), nil)
+	if virErr, ok := err.(Error); !ok || virErr.Code != ERR_OPERATION_INVALID {
+		t.Fatalf("Expected an error for a domain without interfaces, got %v", err)
+	}
+	if len(found) != 0 {
+		t.Fatalf("Found %d interfaces, expected none", len(found))
+	}
+}
@@ -0,0 +1,385 @@
+/*
+ * This file is part of the libvirt-go project
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy
+ * of this software and associated documentation files (the "Software"), to deal
+ * in the Software without restriction, including without limitation the rights
+ * to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+ * copies of the Software, and to permit persons to whom the Software is
+ * furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+ * OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+ * THE SOFTWARE.
+ *
+ * Copyright (C) 2026 Red Hat, Inc.
+ *
+ */
+
+package libvirt
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// A minimal server side implementation of the WebSocket protocol of
+// RFC 6455, enough to carry a byte stream to and from a browser. Data
+// received in text or binary messages is read as one stream, and data
+// written is sent in binary messages. Extensions are not supported.
+
+const wsAcceptGUID = "258EAFA5-E914-47DA-95CA-C5AB0DC85B11"
+
+const (
+	wsOpContinuation = 0x0
+	wsOpText         = 0x1
+	wsOpBinary       = 0x2
+	wsOpClose        = 0x8
+	wsOpPing         = 0x9
+	wsOpPong         = 0xa
+)
+
+const (
+	wsCloseNormal        = 1000
+	wsCloseGoingAway     = 1001
+	wsCloseProtocolError = 1002
+	wsCloseNoStatus      = 1005
+	wsCloseTooBig        = 1009
+)
+
+const wsMaxControlPayload = 125
+
+var errWebSocketProtocol = errors.New("WebSocket protocol error")
+var errWebSocketClosed = errors.New("WebSocket connection is closed")
+var errWebSocketTooBig = errors.New("WebSocket message is too big")
+
+type wsConn struct {
+	conn     net.Conn
+	reader   *bufio.Reader
+	protocol string
+
+	// State of the data frame being read
+	remaining uint64
+	mask      [4]byte
+	maskPos   int
+	inMessage bool
+	opcode    byte
+
+	writeLock sync.Mutex
+	closeSent bool
+}
+
+// Returns whether the comma separated header contains the token
+func wsHeaderHasToken(header http.Header, name, token string) bool {
+	for _, value := range header.Values(name) {
+		for _, field := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(field), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Returns whether the request asks for a WebSocket connection
+func wsIsUpgrade(r *http.Request) bool {
+	return r.Method == http.MethodGet &&
+		wsHeaderHasToken(r.Header, "Connection", "upgrade") &&
+		wsHeaderHasToken(r.Header, "Upgrade", "websocket")
+}
+
+// Completes the handshake of a WebSocket request, choosing the first
+// of the subprotocols offered by the client which is in protocols.
+// On failure, an HTTP error has been sent to the client.
+func wsUpgrade(w http.ResponseWriter, r *http.Request, protocols []string) (*wsConn, error) {
+	if !wsIsUpgrade(r) {
+		http.Error(w, "Expected a WebSocket request", http.StatusBadRequest)
+		return nil, errWebSocketProtocol
+	}
+	if r.Header.Get("Sec-WebSocket-Version") != "13" {
+		w.Header().Set("Sec-WebSocket-Version", "13")
+		http.Error(w, "Unsupported WebSocket version", http.StatusUpgradeRequired)
+		return nil, errWebSocketProtocol
+	}
+	key := r.Header.Get("Sec-WebSocket-Key")
+	if decoded, err := base64.StdEncoding.DecodeString(key); err != nil || len(decoded) != 16 {
+		http.Error(w, "Invalid WebSocket key", http.StatusBadRequest)
+		return nil, errWebSocketProtocol
+	}
+
+	protocol := ""
+	for _, offered := range strings.Split(r.Header.Get("Sec-WebSocket-Protocol"), ",") {
+		offered = strings.TrimSpace(offered)
+		for _, supported := range protocols {
+			if protocol == "" && offered == supported {
+				protocol = offered
+			}
+		}
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "Connection cannot be upgraded", http.StatusInternalServerError)
+		return nil, errors.New("HTTP server does not support hijacking connections")
+	}
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha1.Sum([]byte(key + wsAcceptGUID))
+	response := "HTTP/1.1 101 Switching Protocols\r\n" +
+		"Upgrade: websocket\r\n" +
+		"Connection: Upgrade\r\n" +
+		"Sec-WebSocket-Accept: " + base64.StdEncoding.EncodeToString(hash[:]) + "\r\n"
+	if protocol != "" {
+		response += "Sec-WebSocket-Protocol: " + protocol + "\r\n"
+	}
+	response += "\r\n"
+	if _, err := rw.WriteString(response); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if err := rw.Flush(); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &wsConn{conn: conn, reader: rw.Reader, protocol: protocol}, nil
+}
+
+func (c *wsConn) writeFrame(opcode byte, payload []byte) error {
+	var header [10]byte
+	header[0] = 0x80 | opcode
+	n := 2
+	switch {
+	case len(payload) < 126:
+		header[1] = byte(len(payload))
+	case len(payload) <= 0xffff:
+		header[1] = 126
+		binary.BigEndian.PutUint16(header[2:], uint16(len(payload)))
+		n = 4
+	default:
+		header[1] = 127
+		binary.BigEndian.PutUint64(header[2:], uint64(len(payload)))
+		n = 10
+	}
+
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	if c.closeSent {
+		return errWebSocketClosed
+	}
+	if opcode == wsOpClose {
+		c.closeSent = true
+	}
+	if _, err := c.conn.Write(header[:n]); err != nil {
+		return err
+	}
+	_, err := c.conn.Write(payload)
+	return err
+}
+
+// Write sends p in a single binary message
+func (c *wsConn) Write(p []byte) (int, error) {
+	if err := c.writeFrame(wsOpBinary, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Sends a close message, unless one was already sent
+func (c *wsConn) writeClose(code uint16, reason string) error {
+	payload := make([]byte, 2, 2+len(reason))
+	binary.BigEndian.PutUint16(payload, code)
+	payload = append(payload, reason...)
+	if len(payload) > wsMaxControlPayload {
+		payload = payload[:wsMaxControlPayload]
+	}
+	return c.writeFrame(wsOpClose, payload)
+}
+
+func (c *wsConn) unmask(p []byte) {
+	for i := range p {
+		p[i] ^= c.mask[c.maskPos]
+		c.maskPos = (c.maskPos + 1) & 3
+	}
+}
+
+// Reads the header of the next frame, and handles it if it is a control
+// frame. Returns true for a data frame, and io.EOF once the client
+// closes the connection.
+func (c *wsConn) nextFrame() (bool, error) {
+	var header [2]byte
+	if _, err := io.ReadFull(c.reader, header[:]); err != nil {
+		return false, err
+	}
+	final := header[0]&0x80 != 0
+	opcode := header[0] & 0x0f
+	if header[0]&0x70 != 0 || header[1]&0x80 == 0 {
+		// Extensions are not negotiated, and clients must mask
+		c.writeClose(wsCloseProtocolError, "")
+		return false, errWebSocketProtocol
+	}
+
+	length := uint64(header[1] & 0x7f)
+	switch length {
+	case 126:
+		var ext [2]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return false, err
+		}
+		length = uint64(binary.BigEndian.Uint16(ext[:]))
+	case 127:
+		var ext [8]byte
+		if _, err := io.ReadFull(c.reader, ext[:]); err != nil {
+			return false, err
+		}
+		length = binary.BigEndian.Uint64(ext[:])
+	}
+	if _, err := io.ReadFull(c.reader, c.mask[:]); err != nil {
+		return false, err
+	}
+	c.maskPos = 0
+
+	if opcode >= wsOpClose {
+		if !final || length > wsMaxControlPayload {
+			c.writeClose(wsCloseProtocolError, "")
+			return false, errWebSocketProtocol
+		}
+		payload := make([]byte, length)
+		if _, err := io.ReadFull(c.reader, payload); err != nil {
+			return false, err
+		}
+		c.unmask(payload)
+
+		switch opcode {
+		case wsOpPing:
+			return false, c.writeFrame(wsOpPong, payload)
+		case wsOpPong:
+			return false, nil
+		case wsOpClose:
+			code := uint16(wsCloseNormal)
+			if len(payload) >= 2 {
+				code = binary.BigEndian.Uint16(payload)
+			}
+			if code == wsCloseNoStatus {
+				code = wsCloseNormal
+			}
+			c.writeClose(code, "")
+			return false, io.EOF
+		}
+		c.writeClose(wsCloseProtocolError, "")
+		return false, errWebSocketProtocol
+	}
+
+	switch {
+	case opcode == wsOpContinuation && c.inMessage:
+	case (opcode == wsOpText || opcode == wsOpBinary) && !c.inMessage:
+		c.opcode = opcode
+	default:
+		c.writeClose(wsCloseProtocolError, "")
+		return false, errWebSocketProtocol
+	}
+	c.inMessage = !final
+	c.remaining = length
+	return true, nil
+}
+
+// Read reads the data of the messages sent by the client
+func (c *wsConn) Read(p []byte) (int, error) {
+	for c.remaining == 0 {
+		if _, err := c.nextFrame(); err != nil {
+			return 0, err
+		}
+	}
+	if uint64(len(p)) > c.remaining {
+		p = p[:c.remaining]
+	}
+	n, err := c.reader.Read(p)
+	c.unmask(p[:n])
+	c.remaining -= uint64(n)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+// Reads a whole message of at most limit bytes, returning its opcode,
+// which is wsOpText or wsOpBinary. This must not be mixed with Read.
+func (c *wsConn) readMessage(limit int) (byte, []byte, error) {
+	var data []byte
+	for {
+		isData, err := c.nextFrame()
+		if err != nil {
+			return 0, nil, err
+		}
+		if !isData {
+			continue
+		}
+		if uint64(len(data))+c.remaining > uint64(limit) {
+			c.writeClose(wsCloseTooBig, "")
+			return 0, nil, errWebSocketTooBig
+		}
+		start := len(data)
+		data = append(data, make([]byte, c.remaining)...)
+		if _, err := io.ReadFull(c.reader, data[start:]); err != nil {
+			return 0, nil, err
+		}
+		c.unmask(data[start:])
+		c.remaining = 0
+		if !c.inMessage {
+			return c.opcode, data, nil
+		}
+	}
+}
+
+// Closes the underlying connection, after sending a close message if
+// none was sent yet
+func (c *wsConn) close(code uint16, reason string) {
+	c.writeClose(code, reason)
+	c.conn.Close()
+}
+
+// Copies data both ways between the WebSocket and peer, until either
+// side closes or ctx is done. The WebSocket is then closed, giving the
+// reason if ctx is done, and so is the peer, with closePeer.
+func (c *wsConn) bridge(ctx context.Context, peer io.ReadWriter, closePeer func(), reason string) {
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(peer, c)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(c, peer)
+		done <- struct{}{}
+	}()
+
+	pending := 2
+	select {
+	case <-done:
+		pending--
+		c.close(wsCloseNormal, "")
+	case <-ctx.Done():
+		c.close(wsCloseGoingAway, reason)
+	}
+	closePeer()
+	for ; pending > 0; pending-- {
+		<-done
+	}
+}